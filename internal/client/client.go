@@ -2,17 +2,29 @@ package client
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 )
 
@@ -22,8 +34,96 @@ const (
 	// Red Hat SSO endpoint for token refresh
 	TokenEndpoint = "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/token"
 	ClientID      = "cloud-services"
+	// DefaultMaxRetries is the number of retries the provider configures for
+	// transient failures when max_retries is not set
+	DefaultMaxRetries   = 3
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+
+	// DefaultDownloadTimeout bounds file and log downloads whose context has
+	// no deadline. Downloads are not subject to DefaultTimeout since large
+	// log bundles routinely take longer than that to transfer.
+	DefaultDownloadTimeout = 30 * time.Minute
+
+	// tokenExpiryBuffer is subtracted from the lifetime of an access token,
+	// so that it is refreshed before it expires during a request
+	tokenExpiryBuffer = 5 * time.Minute
+
+	// OrgIDHeader carries ClientConfig.OrgID on multi-tenant deployments
+	OrgIDHeader = "X-Organization-Id"
 )
 
+// Version is the provider version reported in the User-Agent header. It is
+// set at build time with -ldflags "-X <module>/internal/client.Version=<version>".
+var Version = "dev"
+
+// userAgent identifies the provider to the API
+func userAgent() string {
+	return "terraform-provider-openshift-assisted-installer/" + Version
+}
+
+// ErrNotFound is matched by errors.Is when the API responds with 404 Not Found
+var ErrNotFound = errors.New("resource not found")
+
+// ErrConflict is matched by errors.Is when the API responds with 409 Conflict,
+// typically because the resource is not in a state that allows the request
+var ErrConflict = errors.New("resource conflict")
+
+// ErrDownloadInterrupted marks a DownloadInfraEnvImage failure that happened
+// while the image was streaming, so the partial file can be resumed later
+var ErrDownloadInterrupted = errors.New("download interrupted")
+
+// APIError is returned when the API responds with an error status code. Code
+// and Reason are parsed from the error the service returns, and are empty
+// when the body is not a service error.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Reason     string
+	Body       string
+}
+
+// newAPIError builds the error for a response with an error status code
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+
+	// The service returns code as a string, while some gateways return a number
+	var payload struct {
+		Code    json.RawMessage `json:"code"`
+		Reason  string          `json:"reason"`
+		Message string          `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return apiErr
+	}
+
+	apiErr.Code = strings.Trim(string(payload.Code), `"`)
+	apiErr.Reason = payload.Reason
+	if apiErr.Reason == "" {
+		apiErr.Reason = payload.Message
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Reason)
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Is reports whether the error matches target, so callers can use
+// errors.Is(err, ErrNotFound) instead of inspecting the status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
 // TokenResponse represents the OAuth2 token response
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -33,25 +133,125 @@ type TokenResponse struct {
 }
 
 type Client struct {
-	httpClient   *http.Client
-	baseURL      string
-	offlineToken string
-	accessToken  string
-	tokenExpiry  time.Time
-	tokenMutex   sync.RWMutex
+	httpClient    *http.Client
+	baseURL       string
+	offlineToken  string
+	accessToken   string
+	tokenExpiry   time.Time
+	tokenMutex    sync.RWMutex
+	tokenRefresh  singleflight.Group
+	maxRetries    int
+	retryWaitMin  time.Duration
+	retryWaitMax  time.Duration
+	extraHeaders  map[string]string
+	limiter       *rate.Limiter
+	tokenEndpoint string
+	tokenClientID string
+	staticToken   string
+	offline       bool
+	dryRun        bool
+	tokenCacheDir string
+
+	// dryRunIDs numbers the IDs synthesized for objects created in dry-run mode
+	dryRunIDs atomic.Uint64
+
+	// downloadClient shares the transport of httpClient without its overall
+	// timeout, downloads are bounded by their context instead
+	downloadClient *http.Client
+
+	// Deprecation notices reported by the API, each logged once
+	deprecationMutex sync.Mutex
+	seenDeprecations map[string]bool
 }
 
 type ClientConfig struct {
 	BaseURL      string
 	OfflineToken string // Changed from Token to OfflineToken
-	HTTPClient   *http.Client
-	Timeout      time.Duration
+	// AccessToken is used directly as the Bearer token when set, skipping the
+	// offline token refresh flow. Use it for deployments without Red Hat SSO.
+	AccessToken string
+	// TokenEndpoint and TokenClientID override the SSO endpoint and client
+	// used to exchange the offline token. Default to TokenEndpoint and ClientID.
+	TokenEndpoint string
+	TokenClientID string
+	HTTPClient    *http.Client
+	Timeout       time.Duration
+	// MaxRetries is the number of times a request that failed with a network
+	// error or a 429/5xx response is retried. Zero disables retries.
+	MaxRetries int
+	// OrgID is sent in the OrgIDHeader on every request when set
+	OrgID string
+	// ExtraHeaders are added to every API request
+	ExtraHeaders map[string]string
+	// RequestsPerSecond limits the rate of outgoing requests. Zero means unlimited.
+	RequestsPerSecond float64
+	// Offline asks callers to skip optional API calls made while planning
+	Offline bool
+	// CACertPEM holds PEM encoded CA certificates trusted in addition to the
+	// system roots, for deployments behind a private or self-signed CA
+	CACertPEM string
+	// InsecureSkipVerify disables TLS certificate verification. Only use it
+	// for testing.
+	InsecureSkipVerify bool
+	// ProxyURL is the proxy requests are sent through. The HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables apply when it is nil.
+	ProxyURL *url.URL
+	// TokenCacheDir is the directory access tokens exchanged for the offline
+	// token are cached in, so that separate provider runs reuse them until
+	// they expire. Tokens are not cached when it is empty.
+	TokenCacheDir string
+	// DryRun logs API requests that would change objects instead of sending
+	// them, and answers them with a synthesized success. Reads are still sent.
+	DryRun bool
+}
+
+// CACertPool returns the system roots extended with the PEM encoded CA
+// certificates, or an error when the PEM holds no certificate
+func CACertPool(caCertPEM string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(caCertPEM)) {
+		return nil, errors.New("no valid PEM encoded certificate found")
+	}
+	return pool, nil
+}
+
+// newTransport returns a copy of the default transport with the TLS and proxy
+// settings of the config applied. Requests go through the proxy given by the
+// environment unless ProxyURL is set. A CA bundle that cannot be parsed leaves
+// the system roots in place rather than relaxing verification.
+func newTransport(config ClientConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if config.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(config.ProxyURL)
+	}
+
+	if config.CACertPEM != "" || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+		if config.CACertPEM != "" {
+			if pool, err := CACertPool(config.CACertPEM); err == nil {
+				tlsConfig.RootCAs = pool
+			}
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport
 }
 
 func NewClient(config ClientConfig) *Client {
+	// The TLS and proxy settings only apply to the client built here, a
+	// configured HTTPClient brings its own transport
 	if config.HTTPClient == nil {
 		config.HTTPClient = &http.Client{
-			Timeout: config.Timeout,
+			Transport: newTransport(config),
+			Timeout:   config.Timeout,
 		}
 		if config.HTTPClient.Timeout == 0 {
 			config.HTTPClient.Timeout = DefaultTimeout
@@ -63,10 +263,67 @@ func NewClient(config ClientConfig) *Client {
 		baseURL = "https://api.openshift.com/api/assisted-install"
 	}
 
+	extraHeaders := make(map[string]string, len(config.ExtraHeaders)+1)
+	for name, value := range config.ExtraHeaders {
+		// The bearer token is the only credential sent as Authorization
+		if http.CanonicalHeaderKey(name) == "Authorization" {
+			continue
+		}
+		extraHeaders[http.CanonicalHeaderKey(name)] = value
+	}
+	if config.OrgID != "" {
+		extraHeaders[OrgIDHeader] = config.OrgID
+	}
+	extraHeaderNames := make([]string, 0, len(extraHeaders))
+	for name := range extraHeaders {
+		extraHeaderNames = append(extraHeaderNames, name)
+	}
+
+	var limiter *rate.Limiter
+	if config.RequestsPerSecond > 0 {
+		burst := int(config.RequestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), burst)
+	}
+
+	tokenEndpoint := config.TokenEndpoint
+	if tokenEndpoint == "" {
+		tokenEndpoint = TokenEndpoint
+	}
+
+	tokenClientID := config.TokenClientID
+	if tokenClientID == "" {
+		tokenClientID = ClientID
+	}
+
+	// Copy the configured client so API requests and downloads reuse its
+	// transport without changing its redirect policy
+	httpClient := *config.HTTPClient
+	httpClient.CheckRedirect = dropHeadersOnCrossHostRedirect(config.HTTPClient.CheckRedirect, extraHeaderNames...)
+
+	downloadClient := *config.HTTPClient
+	downloadClient.Timeout = 0
+	downloadClient.CheckRedirect = dropHeadersOnCrossHostRedirect(config.HTTPClient.CheckRedirect, append([]string{"Authorization"}, extraHeaderNames...)...)
+
 	return &Client{
-		httpClient:   config.HTTPClient,
-		baseURL:      baseURL,
-		offlineToken: config.OfflineToken,
+		httpClient:    &httpClient,
+		baseURL:       baseURL,
+		offlineToken:  config.OfflineToken,
+		maxRetries:    config.MaxRetries,
+		retryWaitMin:  defaultRetryWaitMin,
+		retryWaitMax:  defaultRetryWaitMax,
+		extraHeaders:  extraHeaders,
+		limiter:       limiter,
+		tokenEndpoint: tokenEndpoint,
+		tokenClientID: tokenClientID,
+		staticToken:   config.AccessToken,
+		offline:       config.Offline,
+		dryRun:        config.DryRun,
+		tokenCacheDir: config.TokenCacheDir,
+
+		downloadClient: &downloadClient,
 	}
 }
 
@@ -78,10 +335,10 @@ func (c *Client) refreshAccessToken(ctx context.Context) error {
 
 	data := url.Values{}
 	data.Set("grant_type", "refresh_token")
-	data.Set("client_id", ClientID)
+	data.Set("client_id", c.tokenClientID)
 	data.Set("refresh_token", c.offlineToken)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, TokenEndpoint, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenEndpoint, strings.NewReader(data.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create token refresh request: %w", err)
 	}
@@ -89,7 +346,7 @@ func (c *Client) refreshAccessToken(ctx context.Context) error {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(c.httpClient, req)
 	if err != nil {
 		return fmt.Errorf("failed to refresh token: %w", err)
 	}
@@ -107,40 +364,94 @@ func (c *Client) refreshAccessToken(ctx context.Context) error {
 		return fmt.Errorf("failed to decode token response: %w", err)
 	}
 
+	// Set expiry with a buffer to avoid edge cases
+	expiry := time.Now().Add(time.Duration(tokenResp.ExpiresIn)*time.Second - tokenExpiryBuffer)
+
 	c.tokenMutex.Lock()
 	c.accessToken = tokenResp.AccessToken
-	// Set expiry with a 5-minute buffer to avoid edge cases
-	c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn)*time.Second - 5*time.Minute)
+	c.tokenExpiry = expiry
 	c.tokenMutex.Unlock()
 
+	if c.tokenCacheDir != "" {
+		if err := c.storeCachedToken(tokenResp.AccessToken, expiry); err != nil {
+			tflog.Warn(ctx, "Could not write the access token cache", map[string]interface{}{
+				"token_cache_dir": c.tokenCacheDir,
+				"error":           err.Error(),
+			})
+		}
+	}
+
 	return nil
 }
 
-// getAccessToken returns a valid access token, refreshing if necessary
+// getAccessToken returns a valid access token, refreshing if necessary.
+// Concurrent callers share a single refresh, each waiting for its result
+// until its own context is done.
 func (c *Client) getAccessToken(ctx context.Context) (string, error) {
+	if c.staticToken != "" {
+		return c.staticToken, nil
+	}
+
 	// For testing purposes, if offline token starts with "test-", use it directly
 	if strings.HasPrefix(c.offlineToken, "test-") {
 		return c.offlineToken, nil
 	}
 
-	c.tokenMutex.RLock()
-	if c.accessToken != "" && time.Now().Before(c.tokenExpiry) {
-		token := c.accessToken
-		c.tokenMutex.RUnlock()
+	if token, ok := c.validAccessToken(); ok {
 		return token, nil
 	}
-	c.tokenMutex.RUnlock()
 
-	// Token is expired or doesn't exist, refresh it
-	if err := c.refreshAccessToken(ctx); err != nil {
-		return "", err
+	// The refresh outlives the caller that started it, as other callers may
+	// be waiting for it
+	refreshCtx := context.WithoutCancel(ctx)
+	result := c.tokenRefresh.DoChan("access-token", func() (interface{}, error) {
+		// A refresh that completed while this one was queued already
+		// provides a valid token
+		if token, ok := c.validAccessToken(); ok {
+			return token, nil
+		}
+
+		// Reuse a token exchanged by an earlier provider run
+		if c.tokenCacheDir != "" {
+			if token, expiry, ok := c.loadCachedToken(); ok {
+				c.tokenMutex.Lock()
+				c.accessToken = token
+				c.tokenExpiry = expiry
+				c.tokenMutex.Unlock()
+				return token, nil
+			}
+		}
+
+		// Token is expired or doesn't exist, refresh it
+		if err := c.refreshAccessToken(refreshCtx); err != nil {
+			return "", err
+		}
+
+		c.tokenMutex.RLock()
+		defer c.tokenMutex.RUnlock()
+		return c.accessToken, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
 	}
+}
 
+// validAccessToken returns the current access token when it has not expired
+func (c *Client) validAccessToken() (string, bool) {
 	c.tokenMutex.RLock()
-	token := c.accessToken
-	c.tokenMutex.RUnlock()
+	defer c.tokenMutex.RUnlock()
 
-	return token, nil
+	if c.accessToken != "" && time.Now().Before(c.tokenExpiry) {
+		return c.accessToken, true
+	}
+	return "", false
 }
 
 func (c *Client) buildURL(endpoint string) string {
@@ -181,7 +492,7 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body in
 
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -191,12 +502,468 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body in
 			_ = resp.Body.Close()
 		}()
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	return resp, nil
 }
 
+// do executes an API request with the configured extra headers
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	return c.doWith(c.httpClient, req)
+}
+
+// download executes a file download like do, but without the fixed client
+// timeout so that large downloads are only bounded by the request context.
+// Use downloadContext to give the request a deadline.
+func (c *Client) download(req *http.Request) (*http.Response, error) {
+	return c.doWith(c.downloadClient, req)
+}
+
+// downloadContext returns ctx, or ctx bounded by DefaultDownloadTimeout when
+// it has no deadline of its own
+func downloadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, DefaultDownloadTimeout)
+}
+
+// maxRedirects matches the redirect limit of the default http.Client policy
+const maxRedirects = 10
+
+// dropHeadersOnCrossHostRedirect returns a redirect policy that removes the
+// given headers when a redirect leaves the host of the original request.
+// Download endpoints may redirect to presigned object storage URLs, which
+// reject requests carrying a second credential, and extra headers may carry
+// tenant or gateway credentials of their own. The default policy only drops
+// the Authorization header, and only for other domains, keeping it for
+// subdomains and other ports of the same host. next, when set, is the
+// configured policy and is applied afterwards.
+func dropHeadersOnCrossHostRedirect(next func(req *http.Request, via []*http.Request) error, headers ...string) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+
+		if !strings.EqualFold(req.URL.Host, via[0].URL.Host) {
+			for _, name := range headers {
+				req.Header.Del(name)
+			}
+		}
+
+		if next != nil {
+			return next(req, via)
+		}
+		return nil
+	}
+}
+
+func (c *Client) doWith(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	if c.dryRun && isMutation(req.Method) {
+		return c.dryRunResponse(req)
+	}
+
+	// Headers set for the request itself, such as Authorization, take
+	// precedence over the configured extra headers
+	for name, value := range c.extraHeaders {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	resp, err := c.send(httpClient, req)
+	if resp != nil {
+		c.recordDeprecations(req, resp)
+		if err == nil {
+			if err := decodeResponseBody(resp); err != nil {
+				_ = resp.Body.Close()
+				return nil, err
+			}
+		}
+	}
+	return resp, err
+}
+
+// decodeResponseBody replaces a gzip encoded response body with its decoded
+// content. Setting Accept-Encoding disables the transport's own decompression,
+// so the client has to undo the encoding it asked for.
+func decodeResponseBody(resp *http.Response) error {
+	if !strings.EqualFold(strings.TrimSpace(resp.Header.Get("Content-Encoding")), "gzip") {
+		return nil
+	}
+
+	body := &gzipReadCloser{body: resp.Body}
+	if resp.Request == nil || resp.Request.Method != http.MethodHead {
+		reader, err := gzip.NewReader(resp.Body)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("failed to decode gzip response: %w", err)
+		}
+		body.reader = reader
+	}
+
+	resp.Body = body
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipReadCloser reads a decoded gzip body and closes the underlying one. An
+// empty body has no gzip header and reads as empty.
+type gzipReadCloser struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+}
+
+func (g *gzipReadCloser) Read(p []byte) (int, error) {
+	if g.reader == nil {
+		return 0, io.EOF
+	}
+	return g.reader.Read(p)
+}
+
+func (g *gzipReadCloser) Close() error {
+	if g.reader != nil {
+		_ = g.reader.Close()
+	}
+	return g.body.Close()
+}
+
+// recordDeprecations logs the Deprecation, Sunset and Warning headers of a
+// response as warnings, each distinct notice once per client, and adds them
+// to the collector of the request context, if any.
+func (c *Client) recordDeprecations(req *http.Request, resp *http.Response) {
+	notices := deprecationNotices(req, resp)
+	if len(notices) == 0 {
+		return
+	}
+
+	if collector, ok := req.Context().Value(deprecationCollectorKey{}).(*deprecationCollector); ok {
+		collector.add(notices)
+	}
+
+	c.deprecationMutex.Lock()
+	defer c.deprecationMutex.Unlock()
+
+	if c.seenDeprecations == nil {
+		c.seenDeprecations = make(map[string]bool)
+	}
+	for _, notice := range notices {
+		if c.seenDeprecations[notice] {
+			continue
+		}
+		c.seenDeprecations[notice] = true
+
+		tflog.Warn(req.Context(), "Assisted Service API deprecation", map[string]interface{}{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"notice": notice,
+		})
+	}
+}
+
+type deprecationCollectorKey struct{}
+
+// deprecationCollector gathers the distinct deprecation notices reported for
+// the requests made with one context
+type deprecationCollector struct {
+	mu      sync.Mutex
+	notices []string
+}
+
+func (d *deprecationCollector) add(notices []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, notice := range notices {
+		if !slices.Contains(d.notices, notice) {
+			d.notices = append(d.notices, notice)
+		}
+	}
+}
+
+// CollectDeprecations returns a context that collects the deprecation notices
+// the API reports for requests made with it, and a function returning them.
+// Scoping collection to the requests of one operation lets callers attach the
+// notices to the resource that caused them.
+func CollectDeprecations(ctx context.Context) (context.Context, func() []string) {
+	collector := &deprecationCollector{}
+	return context.WithValue(ctx, deprecationCollectorKey{}, collector), func() []string {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+		return slices.Clone(collector.notices)
+	}
+}
+
+// Offline reports whether the provider was configured for offline planning.
+// Requests are still sent when made, callers check it to skip optional calls
+// such as plan-time comparisons with the API.
+func (c *Client) Offline() bool {
+	return c.offline
+}
+
+// DryRun reports whether the provider was configured to only log the API
+// requests that would change objects.
+func (c *Client) DryRun() bool {
+	return c.dryRun
+}
+
+// isMutation reports whether a request with the given method changes objects
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// dryRunResponse logs a request that would change objects and answers it
+// without contacting the API. The response echoes the JSON request body so
+// callers decode the values they sent. Its id is a new synthetic ID when an
+// object is created in a collection, such as POST clusters, and the ID in the
+// path when an object is updated, such as PATCH clusters/{id}.
+func (c *Client) dryRunResponse(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	var object map[string]interface{}
+	if json.Unmarshal(body, &object) != nil {
+		object = nil
+	}
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.String(),
+	}
+	if len(body) > 0 {
+		fields["body"] = redactedBody(object, body)
+	}
+	tflog.Info(req.Context(), "Dry run, skipping API request", fields)
+
+	status := http.StatusOK
+	if object == nil {
+		object = map[string]interface{}{}
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, c.apiPath()), "/"), "/")
+	if _, ok := object["id"]; !ok {
+		switch {
+		case req.Method == http.MethodPost && len(segments)%2 == 1:
+			status = http.StatusCreated
+			object["id"] = fmt.Sprintf("00000000-0000-0000-0000-%012d", c.dryRunIDs.Add(1))
+		case req.Method != http.MethodPost && len(segments)%2 == 0:
+			object["id"] = segments[len(segments)-1]
+		}
+	}
+
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to synthesize dry-run response: %w", err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(encoded)),
+		ContentLength: int64(len(encoded)),
+		Request:       req,
+	}, nil
+}
+
+// apiPath returns the path of the versioned API under the base URL
+func (c *Client) apiPath() string {
+	u, _ := url.Parse(c.buildURL(""))
+	return u.Path
+}
+
+// redactedBody returns the request body to log, with the pull secret hidden
+func redactedBody(object map[string]interface{}, body []byte) string {
+	if _, ok := object["pull_secret"]; !ok {
+		return string(body)
+	}
+
+	redacted := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		redacted[key] = value
+	}
+	redacted["pull_secret"] = "(sensitive)"
+
+	encoded, err := json.Marshal(redacted)
+	if err != nil {
+		return "(sensitive)"
+	}
+	return string(encoded)
+}
+
+// deprecationNotices describes the deprecation related headers of a response.
+// Deprecation and Sunset mark the endpoint itself, while Warning headers carry
+// free-form notices such as deprecated request fields.
+func deprecationNotices(req *http.Request, resp *http.Response) []string {
+	var notices []string
+
+	deprecation := resp.Header.Get("Deprecation")
+	sunset := resp.Header.Get("Sunset")
+	if deprecation != "" || sunset != "" {
+		notice := fmt.Sprintf("%s %s is deprecated", req.Method, req.URL.Path)
+		if sunset != "" {
+			notice += fmt.Sprintf(" and will be removed after %s", sunset)
+		}
+		notices = append(notices, notice)
+	}
+
+	for _, warning := range resp.Header.Values("Warning") {
+		if text := warningText(warning); text != "" {
+			notices = append(notices, text)
+		}
+	}
+
+	return notices
+}
+
+// warningText extracts the text of a Warning header in the RFC 7234 form
+// `299 - "text"`, falling back to the raw value.
+func warningText(value string) string {
+	start := strings.Index(value, `"`)
+	end := strings.LastIndex(value, `"`)
+	if start >= 0 && end > start {
+		return value[start+1 : end]
+	}
+	return strings.TrimSpace(value)
+}
+
+// send executes req subject to the rate limiter, retrying idempotent requests
+// on network errors and retryable status codes with exponential backoff and
+// jitter, or after the delay given by a Retry-After header. POST requests are
+// only retried on 429 since actions such as install are not idempotent.
+// Requests without a User-Agent are sent with the provider's.
+func (c *Client) send(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", userAgent())
+	}
+	replayable := req.Body == nil || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("rate limiter: %w", err)
+			}
+		}
+
+		resp, err := httpClient.Do(req)
+		if attempt >= c.maxRetries || !replayable || !shouldRetry(ctx, req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := c.retryBackoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				wait = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to reset request body for retry: %w", err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP-date relative to now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// shouldRetry reports whether a request failed transiently. A 429 means the
+// request was not processed, so it is safe to retry for any method.
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if method == http.MethodPost {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// retryBackoff returns the wait before the given retry attempt: exponential
+// growth from retryWaitMin capped at retryWaitMax, with up to 50% jitter.
+func (c *Client) retryBackoff(attempt int) time.Duration {
+	wait := c.retryWaitMin << uint(attempt)
+	if wait <= 0 || wait > c.retryWaitMax {
+		wait = c.retryWaitMax
+	}
+
+	if half := int64(wait / 2); half > 0 {
+		wait = wait/2 + time.Duration(rand.Int63n(half+1))
+	}
+
+	return wait
+}
+
 func (c *Client) unmarshalResponse(resp *http.Response, target interface{}) error {
 	defer func() {
 		_ = resp.Body.Close()
@@ -238,6 +1005,35 @@ func (c *Client) GetCluster(ctx context.Context, clusterID string) (*models.Clus
 	return &cluster, nil
 }
 
+// UpdateInstallConfig replaces the install-config overrides of a cluster with
+// overrides, a JSON document
+func (c *Client) UpdateInstallConfig(ctx context.Context, clusterID, overrides string) error {
+	// The API takes the JSON document as a JSON string
+	resp, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("clusters/%s/install-config", clusterID), overrides)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// GetPreflightRequirements returns the hardware requirements of a cluster
+// and of each of its operators, per host role
+func (c *Client) GetPreflightRequirements(ctx context.Context, clusterID string) (*models.PreflightHardwareRequirements, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("clusters/%s/preflight-requirements", clusterID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var requirements models.PreflightHardwareRequirements
+	if err := c.unmarshalResponse(resp, &requirements); err != nil {
+		return nil, err
+	}
+
+	return &requirements, nil
+}
+
 func (c *Client) UpdateCluster(ctx context.Context, clusterID string, params models.ClusterUpdateParams) (*models.Cluster, error) {
 	resp, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("clusters/%s", clusterID), params)
 	if err != nil {
@@ -252,6 +1048,25 @@ func (c *Client) UpdateCluster(ctx context.Context, clusterID string, params mod
 	return &cluster, nil
 }
 
+// UpdateClusterNetworking sets the VIPs and machine networks of a cluster.
+// With cluster-managed networking these can often only be chosen once hosts
+// are discovered, as they must lie within a network the hosts are on. Empty
+// lists are left unchanged.
+func (c *Client) UpdateClusterNetworking(ctx context.Context, clusterID string, apiVips, ingressVips, machineNetworks []string) (*models.Cluster, error) {
+	params := models.ClusterUpdateParams{}
+	for _, ip := range apiVips {
+		params.APIVips = append(params.APIVips, models.APIVip{IP: ip})
+	}
+	for _, ip := range ingressVips {
+		params.IngressVips = append(params.IngressVips, models.IngressVip{IP: ip})
+	}
+	for _, cidr := range machineNetworks {
+		params.MachineNetworks = append(params.MachineNetworks, models.MachineNetwork{CIDR: cidr})
+	}
+
+	return c.UpdateCluster(ctx, clusterID, params)
+}
+
 func (c *Client) DeleteCluster(ctx context.Context, clusterID string) error {
 	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("clusters/%s", clusterID), nil)
 	return err
@@ -262,6 +1077,28 @@ func (c *Client) InstallCluster(ctx context.Context, clusterID string) error {
 	return err
 }
 
+// CancelClusterInstallation cancels an ongoing installation, leaving the
+// cluster in the cancelled status
+func (c *Client) CancelClusterInstallation(ctx context.Context, clusterID string) error {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("clusters/%s/actions/cancel", clusterID), nil)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// ResetCluster resets a cancelled or failed installation, so the cluster can
+// be installed again
+func (c *Client) ResetCluster(ctx context.Context, clusterID string) error {
+	resp, err := c.doRequest(ctx, "POST", fmt.Sprintf("clusters/%s/actions/reset", clusterID), nil)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
 func (c *Client) ListClusters(ctx context.Context) ([]models.Cluster, error) {
 	resp, err := c.doRequest(ctx, "GET", "clusters", nil)
 	if err != nil {
@@ -345,8 +1182,13 @@ func (c *Client) CreateManifest(ctx context.Context, clusterID string, params mo
 }
 
 func (c *Client) UpdateManifest(ctx context.Context, clusterID string, params models.UpdateManifestParams) error {
-	_, err := c.doRequest(ctx, "PUT", fmt.Sprintf("clusters/%s/manifests", clusterID), params)
-	return err
+	resp, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("clusters/%s/manifests", clusterID), params)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	return nil
 }
 
 func (c *Client) DeleteManifest(ctx context.Context, clusterID string, folder, fileName string) error {
@@ -372,7 +1214,7 @@ func (c *Client) DeleteManifest(ctx context.Context, clusterID string, folder, f
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -382,7 +1224,7 @@ func (c *Client) DeleteManifest(ctx context.Context, clusterID string, folder, f
 
 	if resp.StatusCode >= 400 {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	return nil
@@ -404,6 +1246,9 @@ func (c *Client) ListManifests(ctx context.Context, clusterID string) ([]models.
 
 // DownloadManifestContent downloads the content of a specific manifest file
 func (c *Client) DownloadManifestContent(ctx context.Context, clusterID, fileName, folder string) (string, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
 	if folder == "" {
 		folder = "manifests"
 	}
@@ -429,7 +1274,7 @@ func (c *Client) DownloadManifestContent(ctx context.Context, clusterID, fileNam
 	}
 	req.Header.Set("Accept", "application/octet-stream")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.download(req)
 	if err != nil {
 		return "", fmt.Errorf("error making request: %w", err)
 	}
@@ -439,7 +1284,7 @@ func (c *Client) DownloadManifestContent(ctx context.Context, clusterID, fileNam
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("error response %d: %s", resp.StatusCode, string(body))
+		return "", newAPIError(resp.StatusCode, body)
 	}
 
 	content, err := io.ReadAll(resp.Body)
@@ -480,7 +1325,7 @@ func (c *Client) GetOpenShiftVersions(ctx context.Context, version string, onlyL
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -490,7 +1335,7 @@ func (c *Client) GetOpenShiftVersions(ctx context.Context, version string, onlyL
 			_ = resp.Body.Close()
 		}()
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	var versions models.OpenshiftVersions
@@ -571,6 +1416,40 @@ func (c *Client) UpdateHost(ctx context.Context, infraEnvID, hostID string, para
 	return &host, nil
 }
 
+// UpdateHostInstallerArgs replaces the extra coreos-installer arguments of a
+// host. An empty args list clears them.
+func (c *Client) UpdateHostInstallerArgs(ctx context.Context, infraEnvID, hostID string, args []string) (*models.Host, error) {
+	params := models.InstallerArgsParams{Args: args}
+	if params.Args == nil {
+		params.Args = []string{}
+	}
+
+	resp, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("infra-envs/%s/hosts/%s/installer-args", infraEnvID, hostID), params)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var host models.Host
+	if err := json.NewDecoder(resp.Body).Decode(&host); err != nil {
+		return nil, fmt.Errorf("failed to decode host response: %w", err)
+	}
+	return &host, nil
+}
+
+// UpdateHostIgnition replaces the ignition config overrides of a host. An
+// empty config clears them.
+func (c *Client) UpdateHostIgnition(ctx context.Context, infraEnvID, hostID, config string) error {
+	resp, err := c.doRequest(ctx, "PATCH", fmt.Sprintf("infra-envs/%s/hosts/%s/ignition", infraEnvID, hostID), models.HostIgnitionParams{Config: config})
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
 // Operator bundles
 func (c *Client) GetOperatorBundles(ctx context.Context) (*models.Bundles, error) {
 	resp, err := c.doRequest(ctx, "GET", "operators/bundles", nil)
@@ -607,6 +1486,21 @@ func (c *Client) GetOperatorBundle(ctx context.Context, bundleID string) (*model
 	return &bundle, nil
 }
 
+// ResolveOperatorBundle expands a bundle into the OLM operators it installs
+func (c *Client) ResolveOperatorBundle(ctx context.Context, bundleID string) ([]models.OLMOperator, error) {
+	bundle, err := c.GetOperatorBundle(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	operators := make([]models.OLMOperator, len(bundle.Operators))
+	for i, name := range bundle.Operators {
+		operators[i] = models.OLMOperator{Name: name}
+	}
+
+	return operators, nil
+}
+
 // Support levels
 func (c *Client) GetSupportedFeatures(ctx context.Context, openshiftVersion, cpuArchitecture, platformType string) (*models.SupportedFeatures, error) {
 	u, _ := url.Parse(c.buildURL("support-levels/features"))
@@ -636,7 +1530,7 @@ func (c *Client) GetSupportedFeatures(ctx context.Context, openshiftVersion, cpu
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -646,7 +1540,7 @@ func (c *Client) GetSupportedFeatures(ctx context.Context, openshiftVersion, cpu
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	var response models.SupportedFeaturesResponse
@@ -679,7 +1573,7 @@ func (c *Client) GetSupportedArchitectures(ctx context.Context, openshiftVersion
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -689,7 +1583,7 @@ func (c *Client) GetSupportedArchitectures(ctx context.Context, openshiftVersion
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	var response models.SupportedArchitecturesResponse
@@ -729,7 +1623,7 @@ func (c *Client) GetDetailedSupportedFeatures(ctx context.Context, openshiftVers
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -739,7 +1633,7 @@ func (c *Client) GetDetailedSupportedFeatures(ctx context.Context, openshiftVers
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// The detailed endpoint returns a different structure based on swagger:
@@ -794,7 +1688,7 @@ func (c *Client) GetClusterCredentials(ctx context.Context, clusterID string) (*
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -804,7 +1698,7 @@ func (c *Client) GetClusterCredentials(ctx context.Context, clusterID string) (*
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	var credentials models.Credentials
@@ -854,7 +1748,7 @@ func (c *Client) GetClusterEvents(ctx context.Context, clusterID string, params
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -864,7 +1758,7 @@ func (c *Client) GetClusterEvents(ctx context.Context, clusterID string, params
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	var events models.EventsResponse
@@ -875,8 +1769,23 @@ func (c *Client) GetClusterEvents(ctx context.Context, clusterID string, params
 	return &events, nil
 }
 
+// GetInfraEnvEvents retrieves the events of an infrastructure environment,
+// such as discovery image generation and host registration, with optional filtering
+func (c *Client) GetInfraEnvEvents(ctx context.Context, infraEnvID string, params map[string]string) (*models.EventsResponse, error) {
+	query := make(map[string]string, len(params)+1)
+	for key, value := range params {
+		query[key] = value
+	}
+	query["infra_env_id"] = infraEnvID
+
+	return c.GetClusterEvents(ctx, "", query)
+}
+
 // DownloadClusterCredentialFile downloads a specific credential file (kubeconfig, kubeadmin-password, etc.)
 func (c *Client) DownloadClusterCredentialFile(ctx context.Context, clusterID, fileName string) ([]byte, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
 	url := fmt.Sprintf("%s/%s/clusters/%s/downloads/credentials?file_name=%s", c.baseURL, APIVersion, clusterID, fileName)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -894,7 +1803,7 @@ func (c *Client) DownloadClusterCredentialFile(ctx context.Context, clusterID, f
 		req.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.download(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -904,7 +1813,7 @@ func (c *Client) DownloadClusterCredentialFile(ctx context.Context, clusterID, f
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Read the file content
@@ -936,7 +1845,7 @@ func (c *Client) GetClusterValidations(ctx context.Context, clusterID string) (*
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -946,12 +1855,12 @@ func (c *Client) GetClusterValidations(ctx context.Context, clusterID string) (*
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Parse the cluster response to extract validations_info
 	var clusterResp struct {
-		ValidationsInfo map[string][]models.ValidationInfo `json:"validations_info"`
+		ValidationsInfo models.ValidationGroups `json:"validations_info"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&clusterResp); err != nil {
 		return nil, fmt.Errorf("failed to decode cluster validation response: %w", err)
@@ -982,7 +1891,7 @@ func (c *Client) GetHostValidations(ctx context.Context, clusterID string) (*mod
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -992,13 +1901,13 @@ func (c *Client) GetHostValidations(ctx context.Context, clusterID string) (*mod
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Parse the hosts response to extract validations_info from each host
 	var hostsResp []struct {
-		ID              string                             `json:"id"`
-		ValidationsInfo map[string][]models.ValidationInfo `json:"validations_info"`
+		ID              string                  `json:"id"`
+		ValidationsInfo models.ValidationGroups `json:"validations_info"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&hostsResp); err != nil {
 		return nil, fmt.Errorf("failed to decode host validations response: %w", err)
@@ -1038,7 +1947,7 @@ func (c *Client) GetSingleHostValidations(ctx context.Context, infraEnvID, hostI
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -1048,13 +1957,13 @@ func (c *Client) GetSingleHostValidations(ctx context.Context, infraEnvID, hostI
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Parse the host response to extract validations_info
 	var hostResp struct {
-		ID              string                             `json:"id"`
-		ValidationsInfo map[string][]models.ValidationInfo `json:"validations_info"`
+		ID              string                  `json:"id"`
+		ValidationsInfo models.ValidationGroups `json:"validations_info"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&hostResp); err != nil {
 		return nil, fmt.Errorf("failed to decode host validation response: %w", err)
@@ -1068,6 +1977,9 @@ func (c *Client) GetSingleHostValidations(ctx context.Context, infraEnvID, hostI
 
 // DownloadClusterLogs downloads cluster logs with optional filtering
 func (c *Client) DownloadClusterLogs(ctx context.Context, clusterID string, params map[string]string) ([]byte, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
 	baseURL := fmt.Sprintf("%s/%s/clusters/%s/logs", c.baseURL, APIVersion, clusterID)
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -1098,7 +2010,7 @@ func (c *Client) DownloadClusterLogs(ctx context.Context, clusterID string, para
 		req.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.download(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -1108,7 +2020,7 @@ func (c *Client) DownloadClusterLogs(ctx context.Context, clusterID string, para
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Read the log content
@@ -1120,8 +2032,260 @@ func (c *Client) DownloadClusterLogs(ctx context.Context, clusterID string, para
 	return content, nil
 }
 
+// DownloadInfraEnvFile downloads a file of an infra-env, such as its
+// discovery ignition or iPXE script
+func (c *Client) DownloadInfraEnvFile(ctx context.Context, infraEnvID, fileName string) ([]byte, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
+	u, err := url.Parse(c.buildURL(fmt.Sprintf("infra-envs/%s/downloads/files", infraEnvID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+	query := u.Query()
+	query.Set("file_name", fileName)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Get access token (will refresh if needed)
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := c.download(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return content, nil
+}
+
+// ResumableFile is a download target that can be appended to, or truncated to
+// start over when the server does not honour a range request. *os.File
+// satisfies it.
+type ResumableFile interface {
+	io.Writer
+	io.Seeker
+	Truncate(size int64) error
+}
+
+// DownloadInfraEnvImage streams the discovery image at downloadURL, the
+// download_url of an infra-env, to f without buffering it in memory, and
+// returns the size of the image. Content already in f is treated as a partial
+// download and resumed with a Range request; a connection dropped mid-stream
+// is resumed the same way, up to MaxRetries times. Servers that don't support
+// ranges get the whole image again.
+//
+// validator is the ETag or Last-Modified of the image the content of f was
+// downloaded from. It is sent as If-Range so a resume never splices two
+// different images together, and is updated whenever the image is fetched
+// from the start. Content in f without a validator is discarded.
+func (c *Client) DownloadInfraEnvImage(ctx context.Context, downloadURL string, f ResumableFile, validator *string) (int64, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to seek to the end of the partial download: %w", err)
+	}
+	if offset > 0 && *validator == "" {
+		if offset, err = restartDownload(f); err != nil {
+			return 0, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		next, resumable, err := c.downloadImageFrom(ctx, downloadURL, f, offset, validator)
+		if err == nil {
+			return next, nil
+		}
+		if !errors.Is(err, ErrDownloadInterrupted) || attempt >= c.maxRetries || ctx.Err() != nil {
+			return next, err
+		}
+
+		offset = next
+		if !resumable {
+			if offset, err = restartDownload(f); err != nil {
+				return 0, err
+			}
+		}
+
+		timer := time.NewTimer(c.retryBackoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return offset, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// downloadImageFrom requests the image from offset onwards and appends it to
+// f. It returns the new end of f and whether the server accepts range
+// requests. validator is sent as If-Range, and replaced by the one of the
+// response when the server sends the whole image.
+func (c *Client) downloadImageFrom(ctx context.Context, downloadURL string, f ResumableFile, offset int64, validator *string) (int64, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return offset, false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Get access token (will refresh if needed)
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return offset, false, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	// Ranges are offsets into the image itself, not into a compressed
+	// encoding of it
+	req.Header.Set("Accept-Encoding", "identity")
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if *validator != "" {
+			req.Header.Set("If-Range", *validator)
+		}
+	}
+
+	resp, err := c.download(req)
+	if err != nil {
+		return offset, false, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// The whole image, either because no range was asked for or because
+		// the server ignored it
+		if offset > 0 {
+			if offset, err = restartDownload(f); err != nil {
+				return 0, false, err
+			}
+		}
+	case http.StatusPartialContent:
+		start, _, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			return offset, false, fmt.Errorf("unexpected Content-Range %q resuming from byte %d", resp.Header.Get("Content-Range"), offset)
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file is already complete, or longer than the image
+		if _, total, ok := parseContentRange(resp.Header.Get("Content-Range")); ok && total == offset {
+			return offset, true, nil
+		}
+		if _, err := restartDownload(f); err != nil {
+			return 0, false, err
+		}
+		return 0, false, fmt.Errorf("%w: partial download does not match the image", ErrDownloadInterrupted)
+	default:
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return offset, false, newAPIError(resp.StatusCode, bodyBytes)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if *validator = resp.Header.Get("ETag"); *validator == "" {
+			*validator = resp.Header.Get("Last-Modified")
+		}
+	}
+	resumable := resp.StatusCode == http.StatusPartialContent || resp.Header.Get("Accept-Ranges") == "bytes"
+
+	written, err := io.Copy(f, resp.Body)
+	if err != nil {
+		return offset + written, resumable, fmt.Errorf("%w: %w", ErrDownloadInterrupted, err)
+	}
+
+	return offset + written, resumable, nil
+}
+
+// restartDownload discards the content of a partial download
+func restartDownload(f ResumableFile) (int64, error) {
+	if err := f.Truncate(0); err != nil {
+		return 0, fmt.Errorf("failed to truncate the partial download: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to rewind the partial download: %w", err)
+	}
+	return 0, nil
+}
+
+// parseContentRange parses a Content-Range header of the form
+// "bytes start-end/total" or "bytes */total". start is -1 for the latter and
+// total is -1 when the size is unknown.
+func parseContentRange(value string) (start, total int64, ok bool) {
+	spec, found := strings.CutPrefix(value, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	rng, size, found := strings.Cut(spec, "/")
+	if !found {
+		return 0, 0, false
+	}
+
+	total = -1
+	if size != "*" {
+		n, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		total = n
+	}
+
+	if rng == "*" {
+		return -1, total, true
+	}
+	first, _, found := strings.Cut(rng, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, total, true
+}
+
+// GetInfraEnvBootArtifacts returns the kernel, initrd and rootfs URLs used to
+// PXE boot hosts into an infra-env, as listed in its iPXE script
+func (c *Client) GetInfraEnvBootArtifacts(ctx context.Context, infraEnvID string) (*models.BootArtifacts, error) {
+	script, err := c.DownloadInfraEnvFile(ctx, infraEnvID, "ipxe-script")
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := models.ParseIPXEScript(string(script))
+	return &artifacts, nil
+}
+
 // DownloadClusterFiles downloads various cluster files (ignition configs, manifests, logs, etc.)
 func (c *Client) DownloadClusterFiles(ctx context.Context, clusterID, fileName string, params map[string]string) ([]byte, error) {
+	ctx, cancel := downloadContext(ctx)
+	defer cancel()
+
 	baseURL := fmt.Sprintf("%s/%s/clusters/%s/downloads/files", c.baseURL, APIVersion, clusterID)
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -1153,7 +2317,7 @@ func (c *Client) DownloadClusterFiles(ctx context.Context, clusterID, fileName s
 		req.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.download(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -1163,7 +2327,7 @@ func (c *Client) DownloadClusterFiles(ctx context.Context, clusterID, fileName s
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Read the file content
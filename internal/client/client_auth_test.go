@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClient_AccessTokenSkipsRefresh(t *testing.T) {
+	var tokenRequests int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer static-access-token" {
+			t.Errorf("Authorization = %q, want Bearer static-access-token", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer apiServer.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:       apiServer.URL,
+		AccessToken:   "static-access-token",
+		OfflineToken:  "offline-token",
+		TokenEndpoint: tokenServer.URL,
+	})
+
+	if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&tokenRequests); got != 0 {
+		t.Errorf("Expected no token refresh requests, got %d", got)
+	}
+}
+
+func TestClient_OfflineTokenRefresh(t *testing.T) {
+	tests := []struct {
+		name         string
+		clientID     string
+		wantClientID string
+	}{
+		{
+			name:         "default client id",
+			wantClientID: ClientID,
+		},
+		{
+			name:         "custom client id",
+			clientID:     "my-client",
+			wantClientID: "my-client",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tokenRequests int32
+			tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&tokenRequests, 1)
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse token request: %v", err)
+				}
+				if got := r.PostForm.Get("client_id"); got != tt.wantClientID {
+					t.Errorf("client_id = %q, want %q", got, tt.wantClientID)
+				}
+				if got := r.PostForm.Get("refresh_token"); got != "offline-token" {
+					t.Errorf("refresh_token = %q, want offline-token", got)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "refreshed-token", ExpiresIn: 900})
+			}))
+			defer tokenServer.Close()
+
+			apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer refreshed-token" {
+					t.Errorf("Authorization = %q, want Bearer refreshed-token", got)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+			}))
+			defer apiServer.Close()
+
+			client := NewClient(ClientConfig{
+				BaseURL:       apiServer.URL,
+				OfflineToken:  "offline-token",
+				TokenEndpoint: tokenServer.URL,
+				TokenClientID: tt.clientID,
+			})
+
+			// The second call must reuse the cached access token
+			for i := 0; i < 2; i++ {
+				if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+					t.Fatalf("GetCluster() error = %v", err)
+				}
+			}
+			if got := atomic.LoadInt32(&tokenRequests); got != 1 {
+				t.Errorf("Expected 1 token refresh request, got %d", got)
+			}
+		})
+	}
+}
+
+func TestNewClient_TokenDefaults(t *testing.T) {
+	client := NewClient(ClientConfig{OfflineToken: "offline-token"})
+
+	if client.tokenEndpoint != TokenEndpoint {
+		t.Errorf("tokenEndpoint = %q, want %q", client.tokenEndpoint, TokenEndpoint)
+	}
+	if client.tokenClientID != ClientID {
+		t.Errorf("tokenClientID = %q, want %q", client.tokenClientID, ClientID)
+	}
+}
+
+func TestClient_ConcurrentTokenRefresh(t *testing.T) {
+	var tokenRequests int32
+	release := make(chan struct{})
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		// Hold the refresh until every caller is waiting on it
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "refreshed-token", ExpiresIn: 900})
+	}))
+	defer tokenServer.Close()
+
+	client := NewClient(ClientConfig{
+		OfflineToken:  "offline-token",
+		TokenEndpoint: tokenServer.URL,
+	})
+	client.accessToken = "expired-token"
+	client.tokenExpiry = time.Now().Add(-time.Minute)
+
+	const callers = 50
+	var wg sync.WaitGroup
+	tokens := make([]string, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], errs[i] = client.getAccessToken(context.Background())
+		}(i)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&tokenRequests); got != 1 {
+		t.Errorf("Expected exactly 1 token refresh request, got %d", got)
+	}
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil || tokens[i] != "refreshed-token" {
+			t.Errorf("getAccessToken() #%d = %q, %v, want refreshed-token", i, tokens[i], errs[i])
+		}
+	}
+}
+
+func TestClient_TokenRefreshWaiterCancelled(t *testing.T) {
+	release := make(chan struct{})
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "refreshed-token", ExpiresIn: 900})
+	}))
+	defer tokenServer.Close()
+	defer close(release)
+
+	client := NewClient(ClientConfig{
+		OfflineToken:  "offline-token",
+		TokenEndpoint: tokenServer.URL,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.getAccessToken(ctx); err != context.DeadlineExceeded {
+		t.Errorf("getAccessToken() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
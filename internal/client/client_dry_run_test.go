@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method != http.MethodGet {
+			t.Errorf("Unexpected write request in dry-run mode: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "existing-cluster-id", Name: "existing"})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:     server.URL + "/api/assisted-install",
+		AccessToken: "test-token",
+		DryRun:      true,
+	})
+	ctx := context.Background()
+
+	if !client.DryRun() {
+		t.Error("DryRun() = false, want true")
+	}
+
+	created, err := client.CreateCluster(ctx, models.ClusterCreateParams{
+		Name:             "dry-run",
+		OpenshiftVersion: "4.16",
+		PullSecret:       `{"auths":{}}`,
+	})
+	if err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+	if created.ID != "00000000-0000-0000-0000-000000000001" {
+		t.Errorf("Created cluster ID = %q, want a synthetic ID", created.ID)
+	}
+	if created.Name != "dry-run" || created.OpenshiftVersion != "4.16" {
+		t.Errorf("Created cluster = %+v, want the values sent", created)
+	}
+
+	name := "renamed"
+	updated, err := client.UpdateCluster(ctx, "existing-cluster-id", models.ClusterUpdateParams{Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateCluster() error = %v", err)
+	}
+	if updated.ID != "existing-cluster-id" || updated.Name != "renamed" {
+		t.Errorf("Updated cluster = %+v, want ID existing-cluster-id and name renamed", updated)
+	}
+
+	writes := []struct {
+		name string
+		call func() error
+	}{
+		{"InstallCluster", func() error { return client.InstallCluster(ctx, "existing-cluster-id") }},
+		{"BindHost", func() error {
+			return client.BindHost(ctx, "infra-env-id", "host-id", models.BindHostParams{ClusterID: "existing-cluster-id"})
+		}},
+		{"UnbindHost", func() error { return client.UnbindHost(ctx, "infra-env-id", "host-id") }},
+		{"CreateManifest", func() error {
+			return client.CreateManifest(ctx, "existing-cluster-id", models.CreateManifestParams{FileName: "a.yaml", Content: "YQ=="})
+		}},
+		{"DeleteManifest", func() error { return client.DeleteManifest(ctx, "existing-cluster-id", "manifests", "a.yaml") }},
+		{"DeleteCluster", func() error { return client.DeleteCluster(ctx, "existing-cluster-id") }},
+	}
+	for _, write := range writes {
+		if err := write.call(); err != nil {
+			t.Errorf("%s() error = %v", write.name, err)
+		}
+	}
+
+	// Reads are still sent
+	cluster, err := client.GetCluster(ctx, "existing-cluster-id")
+	if err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if cluster.Name != "existing" {
+		t.Errorf("GetCluster() name = %q, want existing", cluster.Name)
+	}
+
+	if len(requests) != 1 || requests[0] != "GET /api/assisted-install/v2/clusters/existing-cluster-id" {
+		t.Errorf("Requests sent = %v, want only the GetCluster request", requests)
+	}
+}
+
+func TestRedactedBody(t *testing.T) {
+	body := []byte(`{"name":"dry-run","pull_secret":"secret"}`)
+	var object map[string]interface{}
+	if err := json.Unmarshal(body, &object); err != nil {
+		t.Fatal(err)
+	}
+
+	got := redactedBody(object, body)
+	if got != `{"name":"dry-run","pull_secret":"(sensitive)"}` {
+		t.Errorf("redactedBody() = %s", got)
+	}
+	if object["pull_secret"] != "secret" {
+		t.Error("redactedBody() modified the request object")
+	}
+
+	if got := redactedBody(nil, []byte(`"overrides"`)); got != `"overrides"` {
+		t.Errorf("redactedBody() = %s, want the body unchanged", got)
+	}
+}
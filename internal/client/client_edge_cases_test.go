@@ -1,10 +1,17 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -59,6 +66,50 @@ func TestClient_UpdateCluster(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateClusterNetworking(t *testing.T) {
+	var body map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/v2/clusters/test-cluster-id" {
+			t.Errorf("Expected PATCH /v2/clusters/test-cluster-id, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: "ready"})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	cluster, err := client.UpdateClusterNetworking(context.Background(), "test-cluster-id",
+		[]string{"192.168.1.100"}, []string{"192.168.1.101"}, []string{"192.168.1.0/24"})
+	if err != nil {
+		t.Fatalf("UpdateClusterNetworking() error = %v", err)
+	}
+	if cluster.Status != "ready" {
+		t.Errorf("UpdateClusterNetworking() Status = %v, want ready", cluster.Status)
+	}
+
+	expected := map[string]string{
+		"api_vips":         `[{"ip":"192.168.1.100"}]`,
+		"ingress_vips":     `[{"ip":"192.168.1.101"}]`,
+		"machine_networks": `[{"cidr":"192.168.1.0/24"}]`,
+	}
+	if len(body) != len(expected) {
+		t.Errorf("Expected only networking fields in the body, got %v", body)
+	}
+	for field, value := range expected {
+		if string(body[field]) != value {
+			t.Errorf("Expected %s %s, got %s", field, value, body[field])
+		}
+	}
+}
+
 func TestClient_InstallCluster(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "POST" {
@@ -84,6 +135,57 @@ func TestClient_InstallCluster(t *testing.T) {
 	}
 }
 
+func TestClient_CancelAndResetCluster(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: "cancelled"})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	if err := client.CancelClusterInstallation(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("CancelClusterInstallation() error = %v", err)
+	}
+	if err := client.ResetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("ResetCluster() error = %v", err)
+	}
+
+	expected := []string{"/v2/clusters/test-cluster-id/actions/cancel", "/v2/clusters/test-cluster-id/actions/reset"}
+	if len(paths) != len(expected) || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("Expected requests to %v, got %v", expected, paths)
+	}
+}
+
+func TestClient_CancelClusterInstallation_NotAllowed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = w.Write([]byte(`{"reason":"cluster is not installing"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	err := client.CancelClusterInstallation(context.Background(), "test-cluster-id")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("Expected a 405 API error, got %v", err)
+	}
+}
+
 func TestClient_InfraEnvOperations(t *testing.T) {
 	t.Run("CreateInfraEnv", func(t *testing.T) {
 		expectedInfraEnv := &models.InfraEnv{
@@ -476,3 +578,303 @@ func TestClient_Timeout(t *testing.T) {
 		t.Error("Expected timeout error, got none")
 	}
 }
+
+func TestClient_DownloadOutlastsClientTimeout(t *testing.T) {
+	// Stream 4 MiB in chunks over about 200ms, longer than the client timeout
+	const chunks = 8
+	chunk := bytes.Repeat([]byte("x"), 512*1024)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < chunks; i++ {
+			_, _ = w.Write(chunk)
+			w.(http.Flusher).Flush()
+			time.Sleep(25 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+		Timeout:      50 * time.Millisecond,
+	})
+
+	t.Run("generous context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		content, err := client.DownloadClusterLogs(ctx, "test-cluster-id", nil)
+		if err != nil {
+			t.Fatalf("DownloadClusterLogs() error = %v", err)
+		}
+		if len(content) != chunks*len(chunk) {
+			t.Errorf("Expected %d bytes, got %d", chunks*len(chunk), len(content))
+		}
+	})
+
+	t.Run("short context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		if _, err := client.DownloadClusterFiles(ctx, "test-cluster-id", "logs", nil); err == nil {
+			t.Error("Expected the download to fail once the context deadline passed")
+		}
+	})
+}
+
+func TestDownloadContext(t *testing.T) {
+	ctx, cancel := downloadContext(context.Background())
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > DefaultDownloadTimeout {
+		t.Errorf("Expected a deadline within %s, got %v", DefaultDownloadTimeout, deadline)
+	}
+
+	parent, parentCancel := context.WithTimeout(context.Background(), 2*time.Hour)
+	defer parentCancel()
+	ctx, cancel = downloadContext(parent)
+	defer cancel()
+	if deadline, _ := ctx.Deadline(); time.Until(deadline) < DefaultDownloadTimeout {
+		t.Errorf("Expected the caller's deadline to be kept, got %v", deadline)
+	}
+}
+
+func TestClient_DownloadRedirectDropsAuthorization(t *testing.T) {
+	// The presigned storage host rejects requests carrying a second credential
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("only one auth mechanism allowed"))
+			return
+		}
+		if r.URL.Query().Get("X-Amz-Signature") != "signature" {
+			t.Errorf("Expected the presigned query to be kept, got %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte("file-content"))
+	}))
+	defer storage.Close()
+
+	var sameHostAuth string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/clusters/test-cluster-id/downloads/files":
+			if r.Header.Get("Authorization") != "Bearer test-token" {
+				t.Errorf("Expected the first hop to be authenticated, got %q", r.Header.Get("Authorization"))
+			}
+			if r.URL.Query().Get("file_name") == "metadata.json" {
+				http.Redirect(w, r, "/storage/metadata.json", http.StatusFound)
+				return
+			}
+			http.Redirect(w, r, storage.URL+"/bucket/install-config.yaml?X-Amz-Signature=signature", http.StatusFound)
+		case "/storage/metadata.json":
+			sameHostAuth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte("{}"))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer api.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      api.URL,
+		OfflineToken: "test-token",
+	})
+
+	content, err := client.DownloadClusterFiles(context.Background(), "test-cluster-id", "install-config.yaml", nil)
+	if err != nil {
+		t.Fatalf("DownloadClusterFiles() error = %v", err)
+	}
+	if string(content) != "file-content" {
+		t.Errorf("Expected file-content, got %s", content)
+	}
+
+	// Redirects within the API host stay authenticated
+	if _, err := client.DownloadClusterFiles(context.Background(), "test-cluster-id", "metadata.json", nil); err != nil {
+		t.Fatalf("DownloadClusterFiles() error = %v", err)
+	}
+	if sameHostAuth != "Bearer test-token" {
+		t.Errorf("Expected same host redirect to keep the Authorization header, got %q", sameHostAuth)
+	}
+}
+
+// dropAfter writes the first n bytes of image with a Content-Length for the
+// whole image, then aborts the connection
+func dropAfter(w http.ResponseWriter, image []byte, n int) {
+	w.Header().Set("Content-Length", strconv.Itoa(len(image)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(image[:n])
+	w.(http.Flusher).Flush()
+	panic(http.ErrAbortHandler)
+}
+
+func TestClient_DownloadInfraEnvImageResume(t *testing.T) {
+	image := bytes.Repeat([]byte("discovery-iso "), 4096)
+	const dropAt = 10000
+	const etag = `"image-v1"`
+
+	tests := []struct {
+		name         string
+		acceptRanges bool
+		partial      int
+		validator    string
+		wantRanges   []string
+	}{
+		{
+			name:         "dropped connection resumes",
+			acceptRanges: true,
+			wantRanges:   []string{"", fmt.Sprintf("bytes=%d-", dropAt)},
+		},
+		{
+			name:       "dropped connection without range support restarts",
+			wantRanges: []string{"", ""},
+		},
+		{
+			name:         "partial file resumes",
+			acceptRanges: true,
+			partial:      dropAt,
+			validator:    etag,
+			wantRanges:   []string{fmt.Sprintf("bytes=%d-", dropAt)},
+		},
+		{
+			name:       "partial file without range support restarts",
+			partial:    dropAt,
+			validator:  etag,
+			wantRanges: []string{fmt.Sprintf("bytes=%d-", dropAt)},
+		},
+		{
+			name:         "partial file of another image restarts",
+			acceptRanges: true,
+			partial:      dropAt,
+			validator:    `"image-v0"`,
+			wantRanges:   []string{fmt.Sprintf("bytes=%d-", dropAt)},
+		},
+		{
+			name:         "partial file without validator restarts",
+			acceptRanges: true,
+			partial:      dropAt,
+			wantRanges:   []string{""},
+		},
+		{
+			name:         "complete partial file",
+			acceptRanges: true,
+			partial:      len(image),
+			validator:    etag,
+			wantRanges:   []string{fmt.Sprintf("bytes=%d-", len(image))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ranges []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ranges = append(ranges, r.Header.Get("Range"))
+				if r.Header.Get("Authorization") != "Bearer test-token" {
+					t.Errorf("Expected every attempt to be authenticated, got %q", r.Header.Get("Authorization"))
+				}
+				if r.Header.Get("Accept-Encoding") != "identity" {
+					t.Errorf("Expected an uncompressed image to be requested, got Accept-Encoding %q", r.Header.Get("Accept-Encoding"))
+				}
+				// A dropped connection resumes with the ETag of the first response
+				ifRange := tt.validator
+				if tt.partial == 0 {
+					ifRange = etag
+				}
+				if r.Header.Get("Range") != "" && r.Header.Get("If-Range") != ifRange {
+					t.Errorf("Expected If-Range %q, got %q", ifRange, r.Header.Get("If-Range"))
+				}
+				w.Header().Set("ETag", etag)
+				if tt.partial == 0 && len(ranges) == 1 {
+					if tt.acceptRanges {
+						w.Header().Set("Accept-Ranges", "bytes")
+					}
+					dropAfter(w, image, dropAt)
+				}
+				if tt.acceptRanges {
+					http.ServeContent(w, r, "discovery.iso", time.Time{}, bytes.NewReader(image))
+					return
+				}
+				_, _ = w.Write(image)
+			}))
+			defer server.Close()
+
+			f, err := os.Create(filepath.Join(t.TempDir(), "discovery.iso.part"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			if _, err := f.Write(image[:tt.partial]); err != nil {
+				t.Fatal(err)
+			}
+
+			validator := tt.validator
+			size, err := newRetryTestClient(server.URL, 2).DownloadInfraEnvImage(context.Background(), server.URL+"/discovery.iso", f, &validator)
+			if err != nil {
+				t.Fatalf("DownloadInfraEnvImage() error = %v", err)
+			}
+			if validator != etag {
+				t.Errorf("Expected validator %q, got %q", etag, validator)
+			}
+			if size != int64(len(image)) {
+				t.Errorf("Expected %d bytes, got %d", len(image), size)
+			}
+			content, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(content, image) {
+				t.Errorf("Downloaded image differs from the served image (%d bytes, want %d)", len(content), len(image))
+			}
+			if !reflect.DeepEqual(ranges, tt.wantRanges) {
+				t.Errorf("Range headers = %q, want %q", ranges, tt.wantRanges)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadInfraEnvImageInterrupted(t *testing.T) {
+	image := bytes.Repeat([]byte("discovery-iso "), 4096)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Accept-Ranges", "bytes")
+		dropAfter(w, image, 100)
+	}))
+	defer server.Close()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "discovery.iso.part"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// Without retries the first drop is returned, with what was streamed kept
+	var validator string
+	_, err = newRetryTestClient(server.URL, 0).DownloadInfraEnvImage(context.Background(), server.URL+"/discovery.iso", f, &validator)
+	if !errors.Is(err, ErrDownloadInterrupted) {
+		t.Fatalf("Expected ErrDownloadInterrupted, got %v", err)
+	}
+	if info, err := f.Stat(); err != nil || info.Size() != 100 {
+		t.Errorf("Expected the 100 streamed bytes to be kept, got %v (%v)", info.Size(), err)
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantStart int64
+		wantTotal int64
+		wantOK    bool
+	}{
+		{value: "bytes 100-199/200", wantStart: 100, wantTotal: 200, wantOK: true},
+		{value: "bytes 100-199/*", wantStart: 100, wantTotal: -1, wantOK: true},
+		{value: "bytes */200", wantStart: -1, wantTotal: 200, wantOK: true},
+		{value: "", wantOK: false},
+		{value: "items 0-1/2", wantOK: false},
+		{value: "bytes 100-199", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		start, total, ok := parseContentRange(tt.value)
+		if ok != tt.wantOK || (ok && (start != tt.wantStart || total != tt.wantTotal)) {
+			t.Errorf("parseContentRange(%q) = %d, %d, %v, want %d, %d, %v", tt.value, start, total, ok, tt.wantStart, tt.wantTotal, tt.wantOK)
+		}
+	}
+}
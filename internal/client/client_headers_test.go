@@ -0,0 +1,349 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClient_ExtraHeaders(t *testing.T) {
+	expected := map[string]string{
+		OrgIDHeader:       "test-org",
+		"X-Tenant":        "tenant-a",
+		"X-Custom-Header": "custom-value",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name, value := range expected {
+			if got := r.Header.Get(name); got != value {
+				t.Errorf("%s %s: header %s = %q, want %q", r.Method, r.URL.Path, name, got, value)
+			}
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("%s %s: Authorization header was not preserved", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/clusters/test-cluster-id/credentials":
+			_ = json.NewEncoder(w).Encode(models.Credentials{Username: "kubeadmin"})
+		case "/v2/clusters/test-cluster-id/manifests/files":
+			_, _ = w.Write([]byte("kind: ConfigMap"))
+		default:
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+		OrgID:        "test-org",
+		ExtraHeaders: map[string]string{
+			"X-Tenant":        "tenant-a",
+			"X-Custom-Header": "custom-value",
+		},
+	})
+	ctx := context.Background()
+
+	// doRequest based call
+	if _, err := client.GetCluster(ctx, "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+
+	// Hand-rolled request calls
+	if _, err := client.GetClusterCredentials(ctx, "test-cluster-id"); err != nil {
+		t.Fatalf("GetClusterCredentials() error = %v", err)
+	}
+	if _, err := client.DownloadManifestContent(ctx, "test-cluster-id", "custom.yaml", "manifests"); err != nil {
+		t.Fatalf("DownloadManifestContent() error = %v", err)
+	}
+}
+
+func TestClient_ExtraHeadersNotShared(t *testing.T) {
+	headers := map[string]string{"X-Tenant": "tenant-a"}
+	client := NewClient(ClientConfig{ExtraHeaders: headers, OrgID: "test-org"})
+
+	if _, ok := headers[OrgIDHeader]; ok {
+		t.Error("NewClient() modified the caller's ExtraHeaders map")
+	}
+	if client.extraHeaders[OrgIDHeader] != "test-org" {
+		t.Errorf("Expected %s header to be set from OrgID", OrgIDHeader)
+	}
+}
+
+func TestClient_ExtraHeadersDoNotOverrideAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want Bearer test-token", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+		ExtraHeaders: map[string]string{"authorization": "Basic c2VjcmV0"},
+	})
+
+	if _, ok := client.extraHeaders["Authorization"]; ok {
+		t.Error("Expected an Authorization extra header to be ignored")
+	}
+	if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+}
+
+func TestClient_ExtraHeadersDroppedOnCrossHostRedirect(t *testing.T) {
+	var otherHost http.Header
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHost = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer other.Close()
+
+	var sameHost http.Header
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/clusters/same-host":
+			http.Redirect(w, r, "/moved/same-host", http.StatusFound)
+		case "/v2/clusters/other-host":
+			http.Redirect(w, r, other.URL+"/moved/other-host", http.StatusFound)
+		case "/moved/same-host":
+			sameHost = r.Header.Clone()
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer api.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      api.URL,
+		OfflineToken: "test-token",
+		OrgID:        "test-org",
+		ExtraHeaders: map[string]string{"X-Gateway-Key": "secret"},
+	})
+	ctx := context.Background()
+
+	if _, err := client.GetCluster(ctx, "same-host"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if sameHost.Get("X-Gateway-Key") != "secret" || sameHost.Get(OrgIDHeader) != "test-org" {
+		t.Errorf("Expected same host redirects to keep the extra headers, got %v", sameHost)
+	}
+
+	if _, err := client.GetCluster(ctx, "other-host"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if otherHost.Get("X-Gateway-Key") != "" || otherHost.Get(OrgIDHeader) != "" {
+		t.Errorf("Expected cross host redirects to drop the extra headers, got %v", otherHost)
+	}
+}
+
+func TestClient_DeprecationHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Sunset", "Wed, 31 Dec 2025 23:59:59 GMT")
+		w.Header().Add("Warning", `299 - "high_availability_mode is deprecated, use control_plane_count"`)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	var logs bytes.Buffer
+	ctx, deprecations := CollectDeprecations(tflogtest.RootLogger(context.Background(), &logs))
+
+	// Repeated responses must not repeat the notices
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetCluster(ctx, "test-cluster-id"); err != nil {
+			t.Fatalf("GetCluster() error = %v", err)
+		}
+	}
+
+	expected := []string{
+		"GET /v2/clusters/test-cluster-id is deprecated and will be removed after Wed, 31 Dec 2025 23:59:59 GMT",
+		"high_availability_mode is deprecated, use control_plane_count",
+	}
+	if warnings := deprecations(); !slices.Equal(warnings, expected) {
+		t.Errorf("Collected deprecations = %q, want %q", warnings, expected)
+	}
+
+	// Each operation collects the notices of its own requests, even those
+	// already logged for another one
+	otherCtx, otherDeprecations := CollectDeprecations(context.Background())
+	if warnings := otherDeprecations(); len(warnings) != 0 {
+		t.Errorf("Expected no deprecations before any request, got %q", warnings)
+	}
+	if _, err := client.GetCluster(otherCtx, "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if warnings := otherDeprecations(); !slices.Equal(warnings, expected) {
+		t.Errorf("Collected deprecations = %q, want %q", warnings, expected)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("Failed to decode logs: %v", err)
+	}
+	var logged int
+	for _, entry := range entries {
+		if entry["@level"] == "warn" && entry["@message"] == "Assisted Service API deprecation" {
+			logged++
+		}
+	}
+	if logged != len(expected) {
+		t.Errorf("Expected %d deprecation log warnings, got %d: %v", len(expected), logged, entries)
+	}
+}
+
+func TestClient_GzipResponses(t *testing.T) {
+	gzipped := func(t *testing.T, body []byte) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(body); err != nil {
+			t.Fatalf("failed to compress body: %v", err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatalf("failed to compress body: %v", err)
+		}
+		return buf.Bytes()
+	}
+
+	clusterJSON, err := json.Marshal(models.Cluster{ID: "test-cluster-id", Name: "gzip-cluster"})
+	if err != nil {
+		t.Fatalf("failed to marshal cluster: %v", err)
+	}
+	errorJSON := []byte(`{"code":"404","reason":"cluster not found"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("%s %s: Accept-Encoding = %q, want gzip", r.Method, r.URL.Path, got)
+		}
+
+		switch r.URL.Path {
+		case "/v2/clusters/gzip-cluster-id":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(gzipped(t, clusterJSON))
+		case "/v2/clusters/plain-cluster-id":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(clusterJSON)
+		case "/v2/clusters/missing-cluster-id":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write(gzipped(t, errorJSON))
+		case "/v2/clusters/gzip-cluster-id/manifests/files":
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(gzipped(t, []byte("kind: ConfigMap")))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{BaseURL: server.URL, OfflineToken: "test-token"})
+	ctx := context.Background()
+
+	for _, id := range []string{"gzip-cluster-id", "plain-cluster-id"} {
+		cluster, err := client.GetCluster(ctx, id)
+		if err != nil {
+			t.Fatalf("GetCluster(%s) error = %v", id, err)
+		}
+		if cluster.Name != "gzip-cluster" {
+			t.Errorf("GetCluster(%s) name = %q, want gzip-cluster", id, cluster.Name)
+		}
+	}
+
+	_, err = client.GetCluster(ctx, "missing-cluster-id")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetCluster() error = %v, want *APIError", err)
+	}
+	if apiErr.Reason != "cluster not found" {
+		t.Errorf("APIError.Reason = %q, want decoded reason", apiErr.Reason)
+	}
+
+	content, err := client.DownloadManifestContent(ctx, "gzip-cluster-id", "custom.yaml", "manifests")
+	if err != nil {
+		t.Fatalf("DownloadManifestContent() error = %v", err)
+	}
+	if content != "kind: ConfigMap" {
+		t.Errorf("DownloadManifestContent() = %q, want decoded content", content)
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	oldVersion := Version
+	Version = "1.2.3"
+	defer func() { Version = oldVersion }()
+
+	const want = "terraform-provider-openshift-assisted-installer/1.2.3"
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != want {
+			t.Errorf("token request: User-Agent = %q, want %q", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "refreshed-token", ExpiresIn: 900})
+	}))
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != want {
+			t.Errorf("%s %s: User-Agent = %q, want %q", r.Method, r.URL.Path, got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/events":
+			_ = json.NewEncoder(w).Encode([]models.Event{})
+		case "/v2/clusters/test-cluster-id/manifests/files":
+			_, _ = w.Write([]byte("kind: ConfigMap"))
+		default:
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:       server.URL,
+		OfflineToken:  "offline-token",
+		TokenEndpoint: tokenServer.URL,
+	})
+	ctx := context.Background()
+
+	// doRequest based call, preceded by the token refresh
+	if _, err := client.GetCluster(ctx, "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+
+	// Hand-rolled request calls
+	if _, err := client.GetClusterEvents(ctx, "test-cluster-id", nil); err != nil {
+		t.Fatalf("GetClusterEvents() error = %v", err)
+	}
+	if _, err := client.DownloadManifestContent(ctx, "test-cluster-id", "custom.yaml", "manifests"); err != nil {
+		t.Fatalf("DownloadManifestContent() error = %v", err)
+	}
+}
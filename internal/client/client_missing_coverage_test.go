@@ -3,8 +3,10 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
@@ -12,8 +14,8 @@ import (
 
 func TestClient_UpdateManifest(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != "PUT" || r.URL.Path != "/v2/clusters/cluster-id/manifests" {
-			t.Errorf("Expected PUT /v2/clusters/cluster-id/manifests, got %s %s", r.Method, r.URL.Path)
+		if r.Method != "PATCH" || r.URL.Path != "/v2/clusters/cluster-id/manifests" {
+			t.Errorf("Expected PATCH /v2/clusters/cluster-id/manifests, got %s %s", r.Method, r.URL.Path)
 		}
 
 		var params models.UpdateManifestParams
@@ -24,6 +26,12 @@ func TestClient_UpdateManifest(t *testing.T) {
 		if params.FileName != "updated.yaml" {
 			t.Errorf("Expected filename 'updated.yaml', got %s", params.FileName)
 		}
+		if params.Folder != "manifests" {
+			t.Errorf("Expected folder 'manifests', got %s", params.Folder)
+		}
+		if params.UpdatedContent != "updated-base64-content" {
+			t.Errorf("Expected updated_content 'updated-base64-content', got %s", params.UpdatedContent)
+		}
 
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -35,8 +43,9 @@ func TestClient_UpdateManifest(t *testing.T) {
 	})
 
 	params := models.UpdateManifestParams{
-		FileName: "updated.yaml",
-		Content:  "updated-base64-content",
+		Folder:         "manifests",
+		FileName:       "updated.yaml",
+		UpdatedContent: "updated-base64-content",
 	}
 
 	err := client.UpdateManifest(context.Background(), "cluster-id", params)
@@ -110,6 +119,49 @@ func TestClient_UnbindHost(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateHostInstallerArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		expectedBody string
+	}{
+		{name: "set", args: []string{"--append-karg", "console=ttyS0"}, expectedBody: `{"args":["--append-karg","console=ttyS0"]}`},
+		{name: "clear", args: nil, expectedBody: `{"args":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PATCH" || r.URL.Path != "/v2/infra-envs/infra-env-id/hosts/host-id/installer-args" {
+					t.Errorf("Expected PATCH /v2/infra-envs/infra-env-id/hosts/host-id/installer-args, got %s %s", r.Method, r.URL.Path)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				if strings.TrimSpace(string(body)) != tt.expectedBody {
+					t.Errorf("Expected body %s, got %s", tt.expectedBody, body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{ID: "host-id", InstallerArgs: `["--append-karg","console=ttyS0"]`})
+			}))
+			defer server.Close()
+
+			client := NewClient(ClientConfig{
+				BaseURL:      server.URL,
+				OfflineToken: "test-token",
+			})
+
+			host, err := client.UpdateHostInstallerArgs(context.Background(), "infra-env-id", "host-id", tt.args)
+			if err != nil {
+				t.Fatalf("UpdateHostInstallerArgs() error = %v", err)
+			}
+			if host.ID != "host-id" {
+				t.Errorf("UpdateHostInstallerArgs() ID = %v, want host-id", host.ID)
+			}
+		})
+	}
+}
+
 func TestClient_UpdateInfraEnv(t *testing.T) {
 	expectedInfraEnv := &models.InfraEnv{
 		ID:               "infra-env-id",
@@ -200,3 +252,188 @@ func TestClient_ListInfraEnvs(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_ResolveOperatorBundle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v2/operators/bundles/virtualization" {
+			t.Errorf("Expected GET /v2/operators/bundles/virtualization, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Bundle{
+			ID:        "virtualization",
+			Title:     "Virtualization",
+			Operators: []string{"cnv", "mtv", "nmstate"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	operators, err := client.ResolveOperatorBundle(context.Background(), "virtualization")
+	if err != nil {
+		t.Fatalf("ResolveOperatorBundle() error = %v", err)
+	}
+
+	if len(operators) != 3 {
+		t.Fatalf("Expected 3 operators, got %d", len(operators))
+	}
+	for i, name := range []string{"cnv", "mtv", "nmstate"} {
+		if operators[i].Name != name || operators[i].Properties != "" {
+			t.Errorf("Expected operator %d to be %q without properties, got %+v", i, name, operators[i])
+		}
+	}
+}
+
+func TestClient_GetPreflightRequirements(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v2/clusters/cluster-id/preflight-requirements" {
+			t.Errorf("Expected GET /v2/clusters/cluster-id/preflight-requirements, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"ocp": {"worker": {"quantitative": {"cpu_cores": 2, "ram_mib": 8192, "disk_size_gb": 100}}},
+			"operators": [{
+				"operator_name": "odf",
+				"dependencies": ["lso"],
+				"requirements": {"worker": {"quantitative": {"cpu_cores": 8, "ram_mib": 19456}, "qualitative": ["Requires at least 3 hosts"]}}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	requirements, err := client.GetPreflightRequirements(context.Background(), "cluster-id")
+	if err != nil {
+		t.Fatalf("GetPreflightRequirements() error = %v", err)
+	}
+
+	if ocp := requirements.OCP.ForRole("worker"); ocp == nil || ocp.CPUCores != 2 || ocp.RAMMib != 8192 || ocp.DiskSizeGB != 100 {
+		t.Errorf("Unexpected OpenShift worker requirements %+v", ocp)
+	}
+	if requirements.OCP.ForRole("master") != nil {
+		t.Error("Expected no OpenShift master requirements")
+	}
+	if len(requirements.Operators) != 1 || requirements.Operators[0].OperatorName != "odf" {
+		t.Fatalf("Expected the odf requirements, got %+v", requirements.Operators)
+	}
+	if odf := requirements.Operators[0].Requirements.ForRole("worker"); odf == nil || odf.CPUCores != 8 || odf.RAMMib != 19456 {
+		t.Errorf("Unexpected odf worker requirements %+v", odf)
+	}
+}
+
+func TestClient_UpdateInstallConfig(t *testing.T) {
+	overrides := `{"fips":true,"capabilities":{"baselineCapabilitySet":"None"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/v2/clusters/cluster-id/install-config" {
+			t.Errorf("Expected PATCH /v2/clusters/cluster-id/install-config, got %s %s", r.Method, r.URL.Path)
+		}
+
+		// The overrides are sent as a JSON string
+		var body string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Expected a JSON string body: %v", err)
+		}
+		if body != overrides {
+			t.Errorf("Expected overrides %s, got %s", overrides, body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	if err := client.UpdateInstallConfig(context.Background(), "cluster-id", overrides); err != nil {
+		t.Fatalf("UpdateInstallConfig() error = %v", err)
+	}
+}
+
+func TestClient_GetInfraEnvEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v2/events" {
+			t.Errorf("Expected GET /v2/events, got %s %s", r.Method, r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("infra_env_id") != "infra-env-id" {
+			t.Errorf("Expected infra_env_id=infra-env-id, got %q", query.Get("infra_env_id"))
+		}
+		if query.Get("severities") != "error" {
+			t.Errorf("Expected severities=error, got %q", query.Get("severities"))
+		}
+		if query.Has("cluster_id") {
+			t.Errorf("Expected no cluster_id, got %q", query.Get("cluster_id"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]models.Event{{Name: "image_download_failed", InfraEnvID: "infra-env-id", Severity: "error"}})
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	params := map[string]string{"severities": "error"}
+	events, err := client.GetInfraEnvEvents(context.Background(), "infra-env-id", params)
+	if err != nil {
+		t.Fatalf("GetInfraEnvEvents() error = %v", err)
+	}
+
+	if len(events.Events) != 1 || events.Events[0].InfraEnvID != "infra-env-id" {
+		t.Errorf("Unexpected events: %+v", events.Events)
+	}
+	if _, ok := params["infra_env_id"]; ok {
+		t.Error("GetInfraEnvEvents() should not modify the caller's params")
+	}
+}
+
+func TestClient_GetInfraEnvBootArtifacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v2/infra-envs/infra-env-id/downloads/files" {
+			t.Errorf("Expected GET /v2/infra-envs/infra-env-id/downloads/files, got %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("file_name"); got != "ipxe-script" {
+			t.Errorf("Expected file_name=ipxe-script, got %q", got)
+		}
+
+		_, _ = w.Write([]byte("#!ipxe\n" +
+			"initrd --name initrd https://example.com/pxe-initrd\n" +
+			"kernel https://example.com/kernel initrd=initrd coreos.live.rootfs_url=https://example.com/rootfs\n" +
+			"boot\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	artifacts, err := client.GetInfraEnvBootArtifacts(context.Background(), "infra-env-id")
+	if err != nil {
+		t.Fatalf("GetInfraEnvBootArtifacts() error = %v", err)
+	}
+
+	expected := models.BootArtifacts{
+		KernelURL: "https://example.com/kernel",
+		InitrdURL: "https://example.com/pxe-initrd",
+		RootfsURL: "https://example.com/rootfs",
+	}
+	if *artifacts != expected {
+		t.Errorf("GetInfraEnvBootArtifacts() = %+v, want %+v", *artifacts, expected)
+	}
+}
@@ -0,0 +1,358 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// newRetryTestClient returns a client with retries enabled and short backoff
+func newRetryTestClient(baseURL string, maxRetries int) *Client {
+	c := NewClient(ClientConfig{
+		BaseURL:      baseURL,
+		OfflineToken: "test-token",
+		MaxRetries:   maxRetries,
+	})
+	c.retryWaitMin = time.Millisecond
+	c.retryWaitMax = 5 * time.Millisecond
+	return c
+}
+
+func TestClient_RetryTransientFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{name: "too many requests", statusCode: http.StatusTooManyRequests},
+		{name: "internal server error", statusCode: http.StatusInternalServerError},
+		{name: "bad gateway", statusCode: http.StatusBadGateway},
+		{name: "service unavailable", statusCode: http.StatusServiceUnavailable},
+		{name: "gateway timeout", statusCode: http.StatusGatewayTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= 2 {
+					w.WriteHeader(tt.statusCode)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+			}))
+			defer server.Close()
+
+			cluster, err := newRetryTestClient(server.URL, 3).GetCluster(context.Background(), "test-cluster-id")
+			if err != nil {
+				t.Fatalf("GetCluster() error = %v", err)
+			}
+			if cluster.ID != "test-cluster-id" {
+				t.Errorf("GetCluster() ID = %v, want test-cluster-id", cluster.ID)
+			}
+			if got := atomic.LoadInt32(&attempts); got != 3 {
+				t.Errorf("Expected 3 attempts, got %d", got)
+			}
+		})
+	}
+}
+
+func TestClient_RetryExhausted(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := newRetryTestClient(server.URL, 2).GetCluster(context.Background(), "test-cluster-id")
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("Expected 3 attempts (1 + 2 retries), got %d", got)
+	}
+}
+
+func TestClient_RetryNotAttempted(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		call       func(c *Client) error
+	}{
+		{
+			name:       "client error",
+			statusCode: http.StatusNotFound,
+			call: func(c *Client) error {
+				_, err := c.GetCluster(context.Background(), "test-cluster-id")
+				return err
+			},
+		},
+		{
+			name:       "install action",
+			statusCode: http.StatusServiceUnavailable,
+			call: func(c *Client) error {
+				return c.InstallCluster(context.Background(), "test-cluster-id")
+			},
+		},
+		{
+			name:       "create",
+			statusCode: http.StatusBadGateway,
+			call: func(c *Client) error {
+				_, err := c.CreateCluster(context.Background(), models.ClusterCreateParams{Name: "test"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			if err := tt.call(newRetryTestClient(server.URL, 3)); err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if got := atomic.LoadInt32(&attempts); got != 1 {
+				t.Errorf("Expected a single attempt, got %d", got)
+			}
+		})
+	}
+}
+
+func TestClient_RetryResendsBody(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params models.ClusterUpdateParams
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil || params.Name == nil || *params.Name != "updated" {
+			t.Errorf("Attempt %d: expected request body to be resent, got err=%v params=%+v", atomic.LoadInt32(&attempts)+1, err, params)
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Name: "updated"})
+	}))
+	defer server.Close()
+
+	name := "updated"
+	_, err := newRetryTestClient(server.URL, 3).UpdateCluster(context.Background(), "test-cluster-id", models.ClusterUpdateParams{Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateCluster() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+}
+
+func TestClient_RetryHonorsContextCancellation(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL, 10)
+	c.retryWaitMin = time.Hour
+	c.retryWaitMax = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetCluster(ctx, "test-cluster-id")
+	if err == nil {
+		t.Fatal("Expected error when context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Retry did not stop on context cancellation, took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("Expected a single attempt before cancellation, got %d", got)
+	}
+}
+
+func TestClient_RetryBackoff(t *testing.T) {
+	c := NewClient(ClientConfig{})
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := c.retryBackoff(attempt)
+		if wait < defaultRetryWaitMin/2 || wait > defaultRetryWaitMax {
+			t.Errorf("retryBackoff(%d) = %v, want between %v and %v", attempt, wait, defaultRetryWaitMin/2, defaultRetryWaitMax)
+		}
+	}
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	var attempts int32
+	var firstAttempt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer server.Close()
+
+	cluster, err := newRetryTestClient(server.URL, 3).GetCluster(context.Background(), "test-cluster-id")
+	if err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if cluster.ID != "test-cluster-id" {
+		t.Errorf("GetCluster() ID = %v, want test-cluster-id", cluster.ID)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+	if elapsed := time.Since(firstAttempt); elapsed < time.Second {
+		t.Errorf("Expected retry to wait for Retry-After, retried after %v", elapsed)
+	}
+}
+
+func TestClient_RetryAfterPost(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// A 429 means the request was not processed, so even install is retried
+	if err := newRetryTestClient(server.URL, 3).InstallCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("InstallCluster() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+}
+
+func TestClient_TokenRefreshRetryAfter(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("refresh_token") != "offline-token" {
+			t.Errorf("Expected token form to be resent, got %v (err %v)", r.PostForm, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "access-token", ExpiresIn: 900})
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL, 3)
+	c.offlineToken = "offline-token"
+	c.tokenEndpoint = server.URL
+
+	token, err := c.getAccessToken(context.Background())
+	if err != nil {
+		t.Fatalf("getAccessToken() error = %v", err)
+	}
+	if token != "access-token" {
+		t.Errorf("getAccessToken() = %q, want access-token", token)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "empty", value: "", ok: false},
+		{name: "seconds", value: "5", expected: 5 * time.Second, ok: true},
+		{name: "zero seconds", value: "0", expected: 0, ok: true},
+		{name: "negative seconds", value: "-1", ok: false},
+		{name: "http date", value: now.Add(30 * time.Second).Format(http.TimeFormat), expected: 30 * time.Second, ok: true},
+		{name: "http date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		{name: "invalid", value: "soon", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			if ok != tt.ok || got != tt.expected {
+				t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		BaseURL:           server.URL,
+		OfflineToken:      "test-token",
+		RequestsPerSecond: 10,
+	})
+
+	// Burst of 10 is immediate, the next 5 requests need at least 400ms more
+	start := time.Now()
+	for i := 0; i < 15; i++ {
+		if _, err := c.ListClusters(context.Background()); err != nil {
+			t.Fatalf("ListClusters() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited, 15 requests took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 15 {
+		t.Errorf("Expected 15 requests, got %d", got)
+	}
+}
+
+func TestClient_RateLimitHonorsContext(t *testing.T) {
+	c := NewClient(ClientConfig{
+		BaseURL:           "http://127.0.0.1:0",
+		OfflineToken:      "test-token",
+		RequestsPerSecond: 0.001,
+	})
+
+	// The first request consumes the burst
+	_, _ = c.ListClusters(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ListClusters(ctx)
+	if err == nil || !strings.Contains(err.Error(), "rate limiter") {
+		t.Errorf("Expected rate limiter error on cancelled context, got %v", err)
+	}
+}
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -265,6 +267,70 @@ func TestClient_ErrorHandling(t *testing.T) {
 			if err.Error()[:len(tt.wantErrorMsg)] != tt.wantErrorMsg {
 				t.Errorf("Error message = %v, want prefix %v", err.Error(), tt.wantErrorMsg)
 			}
+
+			if errors.Is(err, ErrNotFound) != (tt.statusCode == http.StatusNotFound) {
+				t.Errorf("errors.Is(err, ErrNotFound) = %v for status %d", errors.Is(err, ErrNotFound), tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestClient_APIErrorConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code":"409","href":"","id":409,"kind":"Error","reason":"Cluster test-cluster-id is in installing state, install is not allowed"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	err := client.InstallCluster(context.Background(), "test-cluster-id")
+	wrapped := fmt.Errorf("triggering installation: %w", err)
+
+	var apiErr *APIError
+	if !errors.As(wrapped, &apiErr) {
+		t.Fatalf("Expected an APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusConflict || apiErr.Code != "409" {
+		t.Errorf("Expected status 409 with code 409, got status %d with code %q", apiErr.StatusCode, apiErr.Code)
+	}
+	if apiErr.Reason != "Cluster test-cluster-id is in installing state, install is not allowed" {
+		t.Errorf("Unexpected reason %q", apiErr.Reason)
+	}
+	if !errors.Is(wrapped, ErrConflict) || errors.Is(wrapped, ErrNotFound) {
+		t.Errorf("Expected the error to only match ErrConflict, got %v", err)
+	}
+	if err.Error() != "API request failed with status 409: Cluster test-cluster-id is in installing state, install is not allowed" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedCode   string
+		expectedReason string
+	}{
+		{name: "service error", body: `{"code":"ASSISTED-INSTALL-93","kind":"Error","reason":"Invalid cluster"}`, expectedCode: "ASSISTED-INSTALL-93", expectedReason: "Invalid cluster"},
+		{name: "numeric code", body: `{"code":401,"message":"Unauthorized"}`, expectedCode: "401", expectedReason: "Unauthorized"},
+		{name: "plain text", body: "upstream connect error"},
+		{name: "empty", body: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := newAPIError(http.StatusBadRequest, []byte(tt.body))
+			if apiErr.Code != tt.expectedCode || apiErr.Reason != tt.expectedReason {
+				t.Errorf("Expected code %q and reason %q, got %q and %q", tt.expectedCode, tt.expectedReason, apiErr.Code, apiErr.Reason)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Expected the raw body to be kept, got %q", apiErr.Body)
+			}
 		})
 	}
 }
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClient_TLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer server.Close()
+
+	serverCAPEM := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	tests := []struct {
+		name        string
+		config      ClientConfig
+		expectError bool
+	}{
+		{
+			name:        "default verifies against system roots",
+			config:      ClientConfig{},
+			expectError: true,
+		},
+		{
+			name:   "server CA",
+			config: ClientConfig{CACertPEM: serverCAPEM},
+		},
+		{
+			name:   "insecure skip verify",
+			config: ClientConfig{InsecureSkipVerify: true},
+		},
+		{
+			name:        "invalid CA keeps verification",
+			config:      ClientConfig{CACertPEM: "not a certificate"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.BaseURL = server.URL
+			tt.config.AccessToken = "test-token"
+			client := NewClient(tt.config)
+
+			_, err := client.GetCluster(context.Background(), "test-cluster-id")
+			if (err != nil) != tt.expectError {
+				t.Errorf("GetCluster() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestCACertPool(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	serverCAPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+
+	if _, err := CACertPool(string(serverCAPEM)); err != nil {
+		t.Errorf("CACertPool() error = %v", err)
+	}
+	if _, err := CACertPool("-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n"); err == nil {
+		t.Error("CACertPool() expected an error for an invalid certificate")
+	}
+}
+
+func TestClient_ProxyURL(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target
+		proxied = append(proxied, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("failed to parse proxy URL: %v", err)
+	}
+
+	client := NewClient(ClientConfig{
+		BaseURL:     "http://assisted.example.com/api/assisted-install",
+		AccessToken: "test-token",
+		ProxyURL:    proxyURL,
+	})
+
+	if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+
+	want := "http://assisted.example.com/api/assisted-install/v2/clusters/test-cluster-id"
+	if len(proxied) != 1 || proxied[0] != want {
+		t.Errorf("Expected the request for %s to go through the proxy, got %v", want, proxied)
+	}
+}
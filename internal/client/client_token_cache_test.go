@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// newTokenCacheServers returns a token server handing out numbered access
+// tokens valid for expiresIn seconds, and an API server recording the bearer
+// tokens it receives
+func newTokenCacheServers(t *testing.T, expiresIn int, tokenRequests *int32, bearers *[]string) (*httptest.Server, *httptest.Server) {
+	t.Helper()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: fmt.Sprintf("access-token-%d", n), ExpiresIn: expiresIn})
+	}))
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*bearers = append(*bearers, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	return tokenServer, apiServer
+}
+
+func TestClient_TokenCache(t *testing.T) {
+	var tokenRequests int32
+	var bearers []string
+	tokenServer, apiServer := newTokenCacheServers(t, 900, &tokenRequests, &bearers)
+	defer tokenServer.Close()
+	defer apiServer.Close()
+
+	cacheDir := t.TempDir()
+	newClient := func(offlineToken string) *Client {
+		return NewClient(ClientConfig{
+			BaseURL:       apiServer.URL,
+			OfflineToken:  offlineToken,
+			TokenEndpoint: tokenServer.URL,
+			TokenCacheDir: cacheDir,
+		})
+	}
+	getCluster := func(client *Client) {
+		t.Helper()
+		if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+			t.Fatalf("GetCluster() error = %v", err)
+		}
+	}
+
+	// Miss: the token is exchanged and written to the cache
+	getCluster(newClient("offline-token"))
+	if got := atomic.LoadInt32(&tokenRequests); got != 1 {
+		t.Fatalf("Expected 1 token request on a cache miss, got %d", got)
+	}
+
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("Expected one cache file, got %v (%v)", entries, err)
+	}
+	info, err := entries[0].Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Cache file mode = %v, want 0600", info.Mode().Perm())
+	}
+	content, err := os.ReadFile(filepath.Join(cacheDir, entries[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(entries[0].Name()+string(content), "offline-token") {
+		t.Error("Expected the offline token not to be written to the cache")
+	}
+
+	// Hit: a new client reuses the cached token without contacting SSO
+	getCluster(newClient("offline-token"))
+	if got := atomic.LoadInt32(&tokenRequests); got != 1 {
+		t.Errorf("Expected no token request on a cache hit, got %d", got-1)
+	}
+
+	// Another offline token has its own cache entry
+	getCluster(newClient("other-offline-token"))
+	if got := atomic.LoadInt32(&tokenRequests); got != 2 {
+		t.Errorf("Expected a token request for another offline token, got %d requests", got)
+	}
+
+	want := []string{"access-token-1", "access-token-1", "access-token-2"}
+	if strings.Join(bearers, ",") != strings.Join(want, ",") {
+		t.Errorf("Bearer tokens = %v, want %v", bearers, want)
+	}
+}
+
+func TestClient_TokenCacheExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresIn int
+	}{
+		// A token expiring within the buffer is already stale when cached
+		{name: "within expiry buffer", expiresIn: int(tokenExpiryBuffer / time.Second)},
+		{name: "expired", expiresIn: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tokenRequests int32
+			var bearers []string
+			tokenServer, apiServer := newTokenCacheServers(t, tt.expiresIn, &tokenRequests, &bearers)
+			defer tokenServer.Close()
+			defer apiServer.Close()
+
+			config := ClientConfig{
+				BaseURL:       apiServer.URL,
+				OfflineToken:  "offline-token",
+				TokenEndpoint: tokenServer.URL,
+				TokenCacheDir: t.TempDir(),
+			}
+			for i := 0; i < 2; i++ {
+				if _, err := NewClient(config).GetCluster(context.Background(), "test-cluster-id"); err != nil {
+					t.Fatalf("GetCluster() error = %v", err)
+				}
+			}
+
+			if got := atomic.LoadInt32(&tokenRequests); got != 2 {
+				t.Errorf("Expected the expired cached token to be refreshed, got %d token requests", got)
+			}
+		})
+	}
+}
+
+func TestClient_TokenCacheUnwritable(t *testing.T) {
+	var tokenRequests int32
+	var bearers []string
+	tokenServer, apiServer := newTokenCacheServers(t, 900, &tokenRequests, &bearers)
+	defer tokenServer.Close()
+	defer apiServer.Close()
+
+	// A file where the cache directory should be
+	cacheDir := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(cacheDir, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(ClientConfig{
+		BaseURL:       apiServer.URL,
+		OfflineToken:  "offline-token",
+		TokenEndpoint: tokenServer.URL,
+		TokenCacheDir: cacheDir,
+	})
+	if _, err := client.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("Expected a cache write failure not to fail the request, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cachedToken is the content of a token cache file
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// tokenCachePath returns the cache file of the client's offline token. The
+// name is a hash of the offline token and the SSO settings it is exchanged
+// with, so the token itself is never written to disk and tokens of different
+// accounts or SSO deployments do not collide.
+func (c *Client) tokenCachePath() string {
+	sum := sha256.Sum256([]byte(c.tokenEndpoint + "\n" + c.tokenClientID + "\n" + c.offlineToken))
+	return filepath.Join(c.tokenCacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// loadCachedToken returns the cached access token when it has not reached
+// its expiry, which already includes tokenExpiryBuffer
+func (c *Client) loadCachedToken() (string, time.Time, bool) {
+	content, err := os.ReadFile(c.tokenCachePath())
+	if err != nil {
+		return "", time.Time{}, false
+	}
+
+	var cached cachedToken
+	if err := json.Unmarshal(content, &cached); err != nil {
+		return "", time.Time{}, false
+	}
+	if cached.AccessToken == "" || !time.Now().Before(cached.Expiry) {
+		return "", time.Time{}, false
+	}
+
+	return cached.AccessToken, cached.Expiry, true
+}
+
+// storeCachedToken writes the access token to the cache, readable only by
+// the current user. The file is replaced atomically so concurrent runs never
+// read a partial token.
+func (c *Client) storeCachedToken(accessToken string, expiry time.Time) error {
+	content, err := json.Marshal(cachedToken{AccessToken: accessToken, Expiry: expiry})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.tokenCacheDir, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(c.tokenCacheDir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), c.tokenCachePath())
+}
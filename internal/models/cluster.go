@@ -1,55 +1,84 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
 type Cluster struct {
-	Kind                     string              `json:"kind"`
-	ID                       string              `json:"id"`
-	Href                     string              `json:"href"`
-	Name                     string              `json:"name"`
-	OpenshiftVersion         string              `json:"openshift_version"`
-	OCPReleaseImage          string              `json:"ocp_release_image,omitempty"`
-	OpenshiftClusterID       string              `json:"openshift_cluster_id,omitempty"`
-	BaseDNSDomain            string              `json:"base_dns_domain,omitempty"`
-	ClusterNetworkCIDR       string              `json:"cluster_network_cidr,omitempty"`
-	ClusterNetworkHostPrefix int                 `json:"cluster_network_host_prefix,omitempty"`
-	ServiceNetworkCIDR       string              `json:"service_network_cidr,omitempty"`
-	ClusterNetworks          []ClusterNetwork    `json:"cluster_networks,omitempty"`
-	ServiceNetworks          []ServiceNetwork    `json:"service_networks,omitempty"`
-	MachineNetworks          []MachineNetwork    `json:"machine_networks,omitempty"`
-	APIVips                  []APIVip            `json:"api_vips,omitempty"`
-	IngressVips              []IngressVip        `json:"ingress_vips,omitempty"`
-	PullSecret               string              `json:"pull_secret"`
-	SSHPublicKey             string              `json:"ssh_public_key,omitempty"`
-	VipDHCPAllocation        bool                `json:"vip_dhcp_allocation,omitempty"`
-	HTTPProxy                string              `json:"http_proxy,omitempty"`
-	HTTPSProxy               string              `json:"https_proxy,omitempty"`
-	NoProxy                  string              `json:"no_proxy,omitempty"`
-	UserManagedNetworking    bool                `json:"user_managed_networking,omitempty"`
-	AdditionalNTPSource      string              `json:"additional_ntp_source,omitempty"`
-	Hyperthreading           string              `json:"hyperthreading,omitempty"`
-	Status                   string              `json:"status"`
-	StatusInfo               string              `json:"status_info"`
-	StatusUpdatedAt          time.Time           `json:"status_updated_at,omitempty"`
-	CreatedAt                time.Time           `json:"created_at,omitempty"`
-	UpdatedAt                time.Time           `json:"updated_at,omitempty"`
-	Platform                 *Platform           `json:"platform,omitempty"`
-	LoadBalancer             *LoadBalancer       `json:"load_balancer,omitempty"`
-	DiskEncryption           *DiskEncryption     `json:"disk_encryption,omitempty"`
-	IgnitionEndpoint         *IgnitionEndpoint   `json:"ignition_endpoint,omitempty"`
-	Tags                     string              `json:"tags,omitempty"`
-	OLMOperators             []OLMOperator       `json:"olm_operators,omitempty"`
-	ControlPlaneCount        int                 `json:"control_plane_count,omitempty"`
-	CPUArchitecture          string              `json:"cpu_architecture,omitempty"`
-	SchedulableMasters       bool                `json:"schedulable_masters,omitempty"`
-	HighAvailabilityMode     string              `json:"high_availability_mode,omitempty"`
-	NetworkType              string              `json:"network_type,omitempty"`
-	HostCount                int                 `json:"total_host_count,omitempty"`
-	ImageInfo                *ImageInfo          `json:"image_info,omitempty"`
-	MonitoredOperators       []MonitoredOperator `json:"monitored_operators,omitempty"`
-	DeletedAt                string              `json:"deleted_at,omitempty"`
+	Kind                         string                       `json:"kind"`
+	ID                           string                       `json:"id"`
+	Href                         string                       `json:"href"`
+	Name                         string                       `json:"name"`
+	UserName                     string                       `json:"user_name,omitempty"`
+	OrgID                        string                       `json:"org_id,omitempty"`
+	EmailDomain                  string                       `json:"email_domain,omitempty"`
+	OpenshiftVersion             string                       `json:"openshift_version"`
+	OCPReleaseImage              string                       `json:"ocp_release_image,omitempty"`
+	OpenshiftClusterID           string                       `json:"openshift_cluster_id,omitempty"`
+	BaseDNSDomain                string                       `json:"base_dns_domain,omitempty"`
+	ClusterNetworkCIDR           string                       `json:"cluster_network_cidr,omitempty"`
+	ClusterNetworkHostPrefix     int                          `json:"cluster_network_host_prefix,omitempty"`
+	ServiceNetworkCIDR           string                       `json:"service_network_cidr,omitempty"`
+	MachineNetworkCIDR           string                       `json:"machine_network_cidr,omitempty"`
+	ClusterNetworks              []ClusterNetwork             `json:"cluster_networks,omitempty"`
+	ServiceNetworks              []ServiceNetwork             `json:"service_networks,omitempty"`
+	MachineNetworks              []MachineNetwork             `json:"machine_networks,omitempty"`
+	APIVips                      []APIVip                     `json:"api_vips,omitempty"`
+	APIVipDNSName                string                       `json:"api_vip_dns_name,omitempty"`
+	IngressVips                  []IngressVip                 `json:"ingress_vips,omitempty"`
+	PullSecret                   string                       `json:"pull_secret"`
+	PullSecretSet                bool                         `json:"pull_secret_set,omitempty"`
+	SSHPublicKey                 string                       `json:"ssh_public_key,omitempty"`
+	VipDHCPAllocation            bool                         `json:"vip_dhcp_allocation,omitempty"`
+	HTTPProxy                    string                       `json:"http_proxy,omitempty"`
+	HTTPSProxy                   string                       `json:"https_proxy,omitempty"`
+	NoProxy                      string                       `json:"no_proxy,omitempty"`
+	UserManagedNetworking        bool                         `json:"user_managed_networking,omitempty"`
+	AdditionalNTPSource          string                       `json:"additional_ntp_source,omitempty"`
+	Hyperthreading               string                       `json:"hyperthreading,omitempty"`
+	InstallConfigOverrides       string                       `json:"install_config_overrides,omitempty"`
+	Status                       string                       `json:"status"`
+	StatusInfo                   string                       `json:"status_info"`
+	StatusUpdatedAt              time.Time                    `json:"status_updated_at,omitempty"`
+	Progress                     *ClusterProgress             `json:"progress,omitempty"`
+	ValidationsInfo              string                       `json:"validations_info,omitempty"`
+	LogsInfo                     string                       `json:"logs_info,omitempty"`
+	ControllerLogsCollectedAt    time.Time                    `json:"controller_logs_collected_at,omitempty"`
+	ControllerLogsStartedAt      time.Time                    `json:"controller_logs_started_at,omitempty"`
+	InstallStartedAt             time.Time                    `json:"install_started_at,omitempty"`
+	InstallCompletedAt           time.Time                    `json:"install_completed_at,omitempty"`
+	CreatedAt                    time.Time                    `json:"created_at,omitempty"`
+	UpdatedAt                    time.Time                    `json:"updated_at,omitempty"`
+	Platform                     *Platform                    `json:"platform,omitempty"`
+	LoadBalancer                 *LoadBalancer                `json:"load_balancer,omitempty"`
+	DiskEncryption               *DiskEncryption              `json:"disk_encryption,omitempty"`
+	IgnitionEndpoint             *IgnitionEndpoint            `json:"ignition_endpoint,omitempty"`
+	Tags                         string                       `json:"tags,omitempty"`
+	OLMOperators                 []OLMOperator                `json:"olm_operators,omitempty"`
+	ControlPlaneCount            int                          `json:"control_plane_count,omitempty"`
+	CPUArchitecture              string                       `json:"cpu_architecture,omitempty"`
+	SchedulableMasters           bool                         `json:"schedulable_masters,omitempty"`
+	SchedulableMastersForcedTrue bool                         `json:"schedulable_masters_forced_true,omitempty"`
+	HighAvailabilityMode         string                       `json:"high_availability_mode,omitempty"`
+	NetworkType                  string                       `json:"network_type,omitempty"`
+	HostCount                    int                          `json:"total_host_count,omitempty"`
+	ReadyHostCount               int                          `json:"ready_host_count,omitempty"`
+	EnabledHostCount             int                          `json:"enabled_host_count,omitempty"`
+	HostNetworks                 []HostNetwork                `json:"host_networks,omitempty"`
+	Hosts                        []Host                       `json:"hosts,omitempty"`
+	ImageInfo                    *ImageInfo                   `json:"image_info,omitempty"`
+	MonitoredOperators           []MonitoredOperator          `json:"monitored_operators,omitempty"`
+	ConnectivityMajorityGroups   string                       `json:"connectivity_majority_groups,omitempty"`
+	IPCollisions                 string                       `json:"ip_collisions,omitempty"`
+	IgnoredHostValidations       string                       `json:"ignored_host_validations,omitempty"`
+	IgnoredClusterValidations    string                       `json:"ignored_cluster_validations,omitempty"`
+	FeatureUsage                 string                       `json:"feature_usage,omitempty"`
+	AMSSubscriptionID            string                       `json:"ams_subscription_id,omitempty"`
+	Imported                     bool                         `json:"imported,omitempty"`
+	LastInstallationPreparation  *LastInstallationPreparation `json:"last-installation-preparation,omitempty"`
+	OrgSoftTimeoutsEnabled       bool                         `json:"org_soft_timeouts_enabled,omitempty"`
+	DeletedAt                    string                       `json:"deleted_at,omitempty"`
 }
 
 type Platform struct {
@@ -118,11 +147,44 @@ type MachineNetwork struct {
 }
 
 type APIVip struct {
-	IP string `json:"ip"`
+	IP           string `json:"ip"`
+	ClusterID    string `json:"cluster_id,omitempty"`
+	Verification string `json:"verification,omitempty"`
 }
 
 type IngressVip struct {
-	IP string `json:"ip"`
+	IP           string `json:"ip"`
+	ClusterID    string `json:"cluster_id,omitempty"`
+	Verification string `json:"verification,omitempty"`
+}
+
+// HostNetwork is a network the hosts of a cluster have an address in
+type HostNetwork struct {
+	CIDR    string   `json:"cidr"`
+	HostIDs []string `json:"host_ids,omitempty"`
+}
+
+// ParseConnectivityMajorityGroups decodes the connectivity majority groups,
+// keyed by machine network CIDR or by IPv4/IPv6 for layer 3 connectivity, to
+// the IDs of the hosts in the majority group. It returns nil when the service
+// has not reported any.
+func (c *Cluster) ParseConnectivityMajorityGroups() (map[string][]string, error) {
+	if c.ConnectivityMajorityGroups == "" {
+		return nil, nil
+	}
+
+	var groups map[string][]string
+	if err := json.Unmarshal([]byte(c.ConnectivityMajorityGroups), &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// LastInstallationPreparation is the outcome of the last preparation for
+// installation of a cluster
+type LastInstallationPreparation struct {
+	Status string `json:"status,omitempty"`
+	Reason string `json:"reason,omitempty"`
 }
 
 type LoadBalancer struct {
@@ -136,8 +198,9 @@ type DiskEncryption struct {
 }
 
 type IgnitionEndpoint struct {
-	URL       string `json:"url,omitempty"`
-	CACertPEM string `json:"ca_cert_pem,omitempty"`
+	URL string `json:"url,omitempty"`
+	// CACertificate is the base64 encoded CA certificate used when contacting the URL via https
+	CACertificate string `json:"ca_certificate,omitempty"`
 }
 
 type ClusterCreateParams struct {
@@ -194,12 +257,13 @@ type ClusterUpdateParams struct {
 	UserManagedNetworking    *bool             `json:"user_managed_networking,omitempty"`
 	AdditionalNTPSource      *string           `json:"additional_ntp_source,omitempty"`
 	Hyperthreading           *string           `json:"hyperthreading,omitempty"`
+	NetworkType              *string           `json:"network_type,omitempty"`
 	Platform                 *Platform         `json:"platform,omitempty"`
 	LoadBalancer             *LoadBalancer     `json:"load_balancer,omitempty"`
 	DiskEncryption           *DiskEncryption   `json:"disk_encryption,omitempty"`
 	IgnitionEndpoint         *IgnitionEndpoint `json:"ignition_endpoint,omitempty"`
 	Tags                     *string           `json:"tags,omitempty"`
-	OLMOperators             []OLMOperator     `json:"olm_operators,omitempty"`
+	OLMOperators             *[]OLMOperator    `json:"olm_operators,omitempty"`
 	PullSecret               *string           `json:"pull_secret,omitempty"`
 	ControlPlaneCount        *int              `json:"control_plane_count,omitempty"`
 	SchedulableMasters       *bool             `json:"schedulable_masters,omitempty"`
@@ -217,6 +281,15 @@ type ImageInfo struct {
 }
 
 // MonitoredOperator represents an operator being monitored for installation
+type ClusterProgress struct {
+	TotalPercentage                         int64  `json:"total_percentage,omitempty"`
+	PreparingForInstallationStagePercentage int64  `json:"preparing_for_installation_stage_percentage,omitempty"`
+	InstallingStagePercentage               int64  `json:"installing_stage_percentage,omitempty"`
+	FinalizingStagePercentage               int64  `json:"finalizing_stage_percentage,omitempty"`
+	FinalizingStage                         string `json:"finalizing_stage,omitempty"`
+	FinalizingStageStartedAt                string `json:"finalizing_stage_started_at,omitempty"`
+}
+
 type MonitoredOperator struct {
 	ClusterID        string `json:"cluster_id"`
 	Name             string `json:"name"`
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -18,19 +20,128 @@ type Host struct {
 	RequestedHostname           string                       `json:"requested_hostname,omitempty"`
 	HostName                    string                       `json:"host_name,omitempty"`
 	Role                        string                       `json:"role,omitempty"`
+	SuggestedRole               string                       `json:"suggested_role,omitempty"`
+	Inventory                   string                       `json:"inventory,omitempty"`
+	InstallationDiskID          string                       `json:"installation_disk_id,omitempty"`
+	InstallationDiskPath        string                       `json:"installation_disk_path,omitempty"`
+	SkipFormattingDisks         string                       `json:"skip_formatting_disks,omitempty"`
+	DisksToBeFormatted          string                       `json:"disks_to_be_formatted,omitempty"`
+	InstallerArgs               string                       `json:"installer_args,omitempty"`
+	IgnitionConfigOverrides     string                       `json:"ignition_config_overrides,omitempty"`
 	DisksSelectedConfig         []DiskConfig                 `json:"disks_selected_config,omitempty"`
 	DisksSkipFormatting         []DiskSkipFormatting         `json:"disks_skip_formatting,omitempty"`
 	MachineConfigPoolName       string                       `json:"machine_config_pool_name,omitempty"`
 	IgnitionEndpointToken       string                       `json:"ignition_endpoint_token,omitempty"`
 	IgnitionEndpointHTTPHeaders []IgnitionEndpointHTTPHeader `json:"ignition_endpoint_http_headers,omitempty"`
-	NodeLabels                  []NodeLabel                  `json:"node_labels,omitempty"`
+	NodeLabels                  string                       `json:"node_labels,omitempty"`
+	DomainNameResolutions       string                       `json:"domain_name_resolutions,omitempty"`
+}
+
+// Inventory is the hardware inventory a host reports as a JSON string, limited
+// to the fields the provider uses
+type Inventory struct {
+	Hostname   string          `json:"hostname,omitempty"`
+	Interfaces []HostInterface `json:"interfaces,omitempty"`
+	Disks      []HostDisk      `json:"disks,omitempty"`
+	CPU        HostCPU         `json:"cpu,omitempty"`
+	Memory     HostMemory      `json:"memory,omitempty"`
+}
+
+type HostCPU struct {
+	Count int `json:"count,omitempty"`
+}
+
+type HostMemory struct {
+	PhysicalBytes int64 `json:"physical_bytes,omitempty"`
+	UsableBytes   int64 `json:"usable_bytes,omitempty"`
+}
+
+type HostInterface struct {
+	Name          string   `json:"name,omitempty"`
+	MacAddress    string   `json:"mac_address,omitempty"`
+	IPv4Addresses []string `json:"ipv4_addresses,omitempty"`
+	IPv6Addresses []string `json:"ipv6_addresses,omitempty"`
+}
+
+type HostDisk struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Path      string `json:"path,omitempty"`
+	ByPath    string `json:"by_path,omitempty"`
+	DriveType string `json:"drive_type,omitempty"`
+	SizeBytes int64  `json:"size_bytes,omitempty"`
+	Bootable  bool   `json:"bootable,omitempty"`
+}
+
+// ParseInventory decodes the host inventory, returning nil when the host has
+// not reported one yet
+func (h *Host) ParseInventory() (*Inventory, error) {
+	if h.Inventory == "" {
+		return nil, nil
+	}
+
+	var inventory Inventory
+	if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+		return nil, err
+	}
+	return &inventory, nil
+}
+
+// DomainResolutions are the results of the DNS lookups a host runs for the
+// cluster, reported as a JSON string
+type DomainResolutions struct {
+	Resolutions []DomainResolution `json:"resolutions"`
+}
+
+type DomainResolution struct {
+	DomainName    string   `json:"domain_name"`
+	IPv4Addresses []string `json:"ipv4_addresses,omitempty"`
+	IPv6Addresses []string `json:"ipv6_addresses,omitempty"`
+	Cnames        []string `json:"cnames,omitempty"`
+}
+
+// Resolved reports whether the lookup returned any address or alias
+func (r DomainResolution) Resolved() bool {
+	return len(r.IPv4Addresses) > 0 || len(r.IPv6Addresses) > 0 || len(r.Cnames) > 0
+}
+
+// ParseDomainNameResolutions decodes the host's DNS lookup results, returning
+// nil when the host has not reported any yet
+func (h *Host) ParseDomainNameResolutions() (*DomainResolutions, error) {
+	if h.DomainNameResolutions == "" {
+		return nil, nil
+	}
+
+	var resolutions DomainResolutions
+	if err := json.Unmarshal([]byte(h.DomainNameResolutions), &resolutions); err != nil {
+		return nil, err
+	}
+	return &resolutions, nil
+}
+
+// HasMACAddress reports whether one of the network interfaces in the host
+// inventory has the given MAC address. MAC addresses are compared case-insensitively.
+func (h *Host) HasMACAddress(mac string) bool {
+	inventory, err := h.ParseInventory()
+	if err != nil || inventory == nil {
+		return false
+	}
+
+	for _, iface := range inventory.Interfaces {
+		if strings.EqualFold(iface.MacAddress, mac) {
+			return true
+		}
+	}
+	return false
 }
 
 type Progress struct {
-	CurrentStage   string    `json:"current_stage,omitempty"`
-	ProgressInfo   string    `json:"progress_info,omitempty"`
-	StageStartedAt time.Time `json:"stage_started_at,omitempty"`
-	StageUpdatedAt time.Time `json:"stage_updated_at,omitempty"`
+	CurrentStage           string    `json:"current_stage,omitempty"`
+	ProgressInfo           string    `json:"progress_info,omitempty"`
+	InstallationPercentage int64     `json:"installation_percentage,omitempty"`
+	StageStartedAt         time.Time `json:"stage_started_at,omitempty"`
+	StageUpdatedAt         time.Time `json:"stage_updated_at,omitempty"`
+	StageTimedOut          bool      `json:"stage_timed_out,omitempty"`
 }
 
 type DiskConfig struct {
@@ -38,8 +149,11 @@ type DiskConfig struct {
 	Role string `json:"role,omitempty"`
 }
 
+// DiskSkipFormatting adds a disk to, or removes it from, the disks the
+// installer leaves unformatted
 type DiskSkipFormatting struct {
-	DiskID string `json:"disk_id"`
+	DiskID         string `json:"disk_id"`
+	SkipFormatting bool   `json:"skip_formatting"`
 }
 
 type IgnitionEndpointHTTPHeader struct {
@@ -61,7 +175,17 @@ type HostUpdateParams struct {
 	MachineConfigPoolName       *string                      `json:"machine_config_pool_name,omitempty"`
 	IgnitionEndpointToken       *string                      `json:"ignition_endpoint_token,omitempty"`
 	IgnitionEndpointHTTPHeaders []IgnitionEndpointHTTPHeader `json:"ignition_endpoint_http_headers,omitempty"`
-	NodeLabels                  []NodeLabel                  `json:"node_labels,omitempty"`
+	NodeLabels                  *[]NodeLabel                 `json:"node_labels,omitempty"`
+}
+
+// InstallerArgsParams are the extra coreos-installer arguments of a host
+type InstallerArgsParams struct {
+	Args []string `json:"args"`
+}
+
+// HostIgnitionParams are the ignition config overrides of a host
+type HostIgnitionParams struct {
+	Config string `json:"config"`
 }
 
 type BindHostParams struct {
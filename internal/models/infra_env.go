@@ -1,29 +1,32 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
 type InfraEnv struct {
-	Kind                  string    `json:"kind"`
-	ID                    string    `json:"id"`
-	Href                  string    `json:"href"`
-	Name                  string    `json:"name"`
-	OpenshiftVersion      string    `json:"openshift_version"`
-	CPUArchitecture       string    `json:"cpu_architecture,omitempty"`
-	ClusterID             string    `json:"cluster_id,omitempty"`
-	SSHAuthorizedKey      string    `json:"ssh_authorized_key,omitempty"`
-	PullSecretSet         bool      `json:"pull_secret_set"`
-	StaticNetworkConfig   string    `json:"static_network_config,omitempty"`
-	AdditionalNTPSources  string    `json:"additional_ntp_sources,omitempty"`
-	AdditionalTrustBundle string    `json:"additional_trust_bundle,omitempty"`
-	Proxy                 *Proxy    `json:"proxy,omitempty"`
-	Type                  string    `json:"type"`
-	CreatedAt             time.Time `json:"created_at,omitempty"`
-	UpdatedAt             time.Time `json:"updated_at,omitempty"`
-	DownloadURL           string    `json:"download_url,omitempty"`
-	ExpiresAt             time.Time `json:"expires_at,omitempty"`
-	SizeBytes             int64     `json:"size_bytes,omitempty"`
+	Kind                   string    `json:"kind"`
+	ID                     string    `json:"id"`
+	Href                   string    `json:"href"`
+	Name                   string    `json:"name"`
+	OpenshiftVersion       string    `json:"openshift_version"`
+	CPUArchitecture        string    `json:"cpu_architecture,omitempty"`
+	ClusterID              string    `json:"cluster_id,omitempty"`
+	SSHAuthorizedKey       string    `json:"ssh_authorized_key,omitempty"`
+	PullSecretSet          bool      `json:"pull_secret_set"`
+	StaticNetworkConfig    string    `json:"static_network_config,omitempty"`
+	AdditionalNTPSources   string    `json:"additional_ntp_sources,omitempty"`
+	AdditionalTrustBundle  string    `json:"additional_trust_bundle,omitempty"`
+	Proxy                  *Proxy    `json:"proxy,omitempty"`
+	Type                   string    `json:"type"`
+	IgnitionConfigOverride string    `json:"ignition_config_override,omitempty"`
+	KernelArguments        string    `json:"kernel_arguments,omitempty"`
+	CreatedAt              time.Time `json:"created_at,omitempty"`
+	UpdatedAt              time.Time `json:"updated_at,omitempty"`
+	DownloadURL            string    `json:"download_url,omitempty"`
+	ExpiresAt              time.Time `json:"expires_at,omitempty"`
+	SizeBytes              int64     `json:"size_bytes,omitempty"`
 }
 
 type Proxy struct {
@@ -48,17 +51,20 @@ type InfraEnvCreateParams struct {
 	KernelArguments        []KernelArgument          `json:"kernel_arguments,omitempty"`
 }
 
+// InfraEnvUpdateParams holds the changes to an infrastructure environment.
+// A nil StaticNetworkConfig leaves the configuration unchanged, while an
+// empty one clears it.
 type InfraEnvUpdateParams struct {
-	Name                   *string                   `json:"name,omitempty"`
-	PullSecret             *string                   `json:"pull_secret,omitempty"`
-	SSHAuthorizedKey       *string                   `json:"ssh_authorized_key,omitempty"`
-	StaticNetworkConfig    []HostStaticNetworkConfig `json:"static_network_config,omitempty"`
-	AdditionalNTPSources   *string                   `json:"additional_ntp_sources,omitempty"`
-	AdditionalTrustBundle  *string                   `json:"additional_trust_bundle,omitempty"`
-	Proxy                  *Proxy                    `json:"proxy,omitempty"`
-	IgnitionConfigOverride *string                   `json:"ignition_config_override,omitempty"`
-	ImageType              *string                   `json:"image_type,omitempty"`
-	KernelArguments        []KernelArgument          `json:"kernel_arguments,omitempty"`
+	Name                   *string                    `json:"name,omitempty"`
+	PullSecret             *string                    `json:"pull_secret,omitempty"`
+	SSHAuthorizedKey       *string                    `json:"ssh_authorized_key,omitempty"`
+	StaticNetworkConfig    *[]HostStaticNetworkConfig `json:"static_network_config,omitempty"`
+	AdditionalNTPSources   *string                    `json:"additional_ntp_sources,omitempty"`
+	AdditionalTrustBundle  *string                    `json:"additional_trust_bundle,omitempty"`
+	Proxy                  *Proxy                     `json:"proxy,omitempty"`
+	IgnitionConfigOverride *string                    `json:"ignition_config_override,omitempty"`
+	ImageType              *string                    `json:"image_type,omitempty"`
+	KernelArguments        []KernelArgument           `json:"kernel_arguments,omitempty"`
 }
 
 // HostStaticNetworkConfig represents static network configuration for a host
@@ -78,3 +84,58 @@ type KernelArgument struct {
 	Operation string `json:"operation"` // append, replace, delete
 	Value     string `json:"value"`
 }
+
+// BootArtifacts holds the URLs of the artifacts a host needs to PXE boot into
+// discovery
+type BootArtifacts struct {
+	KernelURL string
+	InitrdURL string
+	RootfsURL string
+}
+
+// rootfsKernelArgument is the kernel argument the live ISO reads the rootfs URL from
+const rootfsKernelArgument = "coreos.live.rootfs_url="
+
+// ParseIPXEScript extracts the boot artifact URLs from the iPXE script of an
+// infra-env. The kernel and initrd URLs are taken from the kernel and initrd
+// commands, the rootfs URL from the kernel arguments. URLs missing from the
+// script are left empty.
+func ParseIPXEScript(script string) BootArtifacts {
+	var artifacts BootArtifacts
+	for _, line := range strings.Split(script, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		command := fields[0]
+		if command != "kernel" && command != "initrd" {
+			continue
+		}
+
+		// Skip the command options, such as --name initrd, up to the URL
+		args := fields[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+			if !strings.Contains(args[0], "=") && len(args) > 1 {
+				args = args[1:]
+			}
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		if command == "initrd" {
+			artifacts.InitrdURL = args[0]
+			continue
+		}
+
+		artifacts.KernelURL = args[0]
+		for _, arg := range args[1:] {
+			if strings.HasPrefix(arg, rootfsKernelArgument) {
+				artifacts.RootfsURL = strings.TrimPrefix(arg, rootfsKernelArgument)
+			}
+		}
+	}
+	return artifacts
+}
@@ -12,7 +12,10 @@ type CreateManifestParams struct {
 	Content  string `json:"content"`
 }
 
+// UpdateManifestParams identifies a manifest by folder and file name and
+// replaces its content with the base64 encoded UpdatedContent
 type UpdateManifestParams struct {
-	FileName string `json:"file_name"`
-	Content  string `json:"content"`
+	Folder         string `json:"folder"`
+	FileName       string `json:"file_name"`
+	UpdatedContent string `json:"updated_content,omitempty"`
 }
@@ -131,6 +131,32 @@ func TestCluster_NewFields(t *testing.T) {
 	}
 }
 
+func TestClusterVips_Verification(t *testing.T) {
+	data := `{"id":"test-cluster-id","api_vips":[{"cluster_id":"test-cluster-id","ip":"192.168.1.100","verification":"succeeded"}],` +
+		`"ingress_vips":[{"cluster_id":"test-cluster-id","ip":"192.168.1.101","verification":"failed"}]}`
+
+	var cluster Cluster
+	if err := json.Unmarshal([]byte(data), &cluster); err != nil {
+		t.Fatalf("Failed to unmarshal cluster: %v", err)
+	}
+
+	if len(cluster.APIVips) != 1 || cluster.APIVips[0].Verification != "succeeded" {
+		t.Errorf("Expected API VIP verification succeeded, got %+v", cluster.APIVips)
+	}
+	if len(cluster.IngressVips) != 1 || cluster.IngressVips[0].Verification != "failed" {
+		t.Errorf("Expected ingress VIP verification failed, got %+v", cluster.IngressVips)
+	}
+
+	// VIPs sent in update requests carry only the address
+	sent, err := json.Marshal(APIVip{IP: "192.168.1.100"})
+	if err != nil {
+		t.Fatalf("Failed to marshal API VIP: %v", err)
+	}
+	if string(sent) != `{"ip":"192.168.1.100"}` {
+		t.Errorf("Expected only the IP to be sent, got %s", sent)
+	}
+}
+
 func TestClusterCreateParams_Validation(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -442,6 +468,34 @@ func TestHost_JSONMarshal(t *testing.T) {
 	}
 }
 
+func TestHost_HasMACAddress(t *testing.T) {
+	host := &Host{
+		Inventory: `{"interfaces":[{"name":"eth0","mac_address":"52:54:00:AA:BB:01"},{"name":"eth1","mac_address":"52:54:00:aa:bb:02"}]}`,
+	}
+
+	tests := []struct {
+		mac      string
+		expected bool
+	}{
+		{mac: "52:54:00:aa:bb:01", expected: true},
+		{mac: "52:54:00:AA:BB:02", expected: true},
+		{mac: "52:54:00:aa:bb:03", expected: false},
+	}
+
+	for _, tt := range tests {
+		if got := host.HasMACAddress(tt.mac); got != tt.expected {
+			t.Errorf("HasMACAddress(%s) = %v, want %v", tt.mac, got, tt.expected)
+		}
+	}
+
+	if (&Host{}).HasMACAddress("52:54:00:aa:bb:01") {
+		t.Error("Host without inventory should not match a MAC address")
+	}
+	if (&Host{Inventory: "not json"}).HasMACAddress("52:54:00:aa:bb:01") {
+		t.Error("Host with invalid inventory should not match a MAC address")
+	}
+}
+
 func TestPlatform_JSONMarshal(t *testing.T) {
 	platform := &Platform{
 		Type: "baremetal",
@@ -499,3 +553,219 @@ func TestOLMOperator_JSONMarshal(t *testing.T) {
 		t.Errorf("Properties mismatch: got %s, want %s", unmarshaled.Properties, operator.Properties)
 	}
 }
+
+func TestIgnitionEndpoint_JSONMarshal(t *testing.T) {
+	endpoint := &IgnitionEndpoint{
+		URL:           "https://ignition.example.com/config",
+		CACertificate: "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCg==",
+	}
+
+	data, err := json.Marshal(endpoint)
+	if err != nil {
+		t.Fatalf("Failed to marshal IgnitionEndpoint: %v", err)
+	}
+
+	// The API field name is ca_certificate, not ca_cert_pem
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Failed to unmarshal IgnitionEndpoint into map: %v", err)
+	}
+	if raw["ca_certificate"] != endpoint.CACertificate {
+		t.Errorf("Expected ca_certificate field %q, got %v", endpoint.CACertificate, raw["ca_certificate"])
+	}
+
+	var unmarshaled IgnitionEndpoint
+	if err := json.Unmarshal(data, &unmarshaled); err != nil {
+		t.Fatalf("Failed to unmarshal IgnitionEndpoint: %v", err)
+	}
+
+	if unmarshaled.URL != endpoint.URL {
+		t.Errorf("URL mismatch: got %s, want %s", unmarshaled.URL, endpoint.URL)
+	}
+}
+
+func TestEventsResponse_JSONUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{
+			name: "event list",
+			json: `[{"name":"cluster_installation_started","severity":"info","message":"Installation started"}]`,
+		},
+		{
+			name: "wrapped event list",
+			json: `{"events":[{"name":"cluster_installation_started","severity":"info","message":"Installation started"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp EventsResponse
+			if err := json.Unmarshal([]byte(tt.json), &resp); err != nil {
+				t.Fatalf("Failed to unmarshal events: %v", err)
+			}
+			if len(resp.Events) != 1 || resp.Events[0].Name != "cluster_installation_started" {
+				t.Errorf("Unexpected events: %+v", resp.Events)
+			}
+		})
+	}
+}
+
+func TestHost_ParseInventory(t *testing.T) {
+	host := &Host{
+		Inventory: `{
+			"hostname": "master-0.example.com",
+			"bmc_address": "0.0.0.0",
+			"boot": {"current_boot_mode": "uefi"},
+			"cpu": {"architecture": "x86_64", "count": 16, "flags": ["fpu", "vme"], "frequency": 2294.61, "model_name": "Intel(R) Xeon(R) Gold 6140 CPU @ 2.30GHz"},
+			"disks": [
+				{"by_path": "/dev/disk/by-path/pci-0000:00:1f.2-ata-1", "drive_type": "SSD", "id": "/dev/disk/by-id/wwn-0x5000c500a0b1c2d3", "installation_eligibility": {"eligible": true}, "name": "sda", "path": "/dev/sda", "size_bytes": 480103981056, "bootable": true},
+				{"drive_type": "ODD", "id": "/dev/sr0", "installation_eligibility": {"not_eligible_reasons": ["Disk is removable"]}, "name": "sr0", "path": "/dev/sr0", "removable": true, "size_bytes": 1120342016}
+			],
+			"interfaces": [
+				{"name": "ens3", "mac_address": "52:54:00:aa:bb:01", "ipv4_addresses": ["192.168.122.10/24"], "ipv6_addresses": ["fe80::5054:ff:feaa:bb01/64"], "has_carrier": true, "mtu": 1500, "speed_mbps": 10000, "flags": ["up", "broadcast", "multicast"]},
+				{"name": "ens4", "mac_address": "52:54:00:aa:bb:02", "ipv4_addresses": [], "ipv6_addresses": []}
+			],
+			"memory": {"physical_bytes": 68719476736, "usable_bytes": 67108864000, "physical_bytes_method": "dmidecode"},
+			"system_vendor": {"manufacturer": "Red Hat", "product_name": "KVM", "virtual": true}
+		}`,
+	}
+
+	inventory, err := host.ParseInventory()
+	if err != nil {
+		t.Fatalf("ParseInventory() returned error: %v", err)
+	}
+
+	if inventory.Hostname != "master-0.example.com" {
+		t.Errorf("Hostname mismatch: got %s", inventory.Hostname)
+	}
+	if inventory.CPU.Count != 16 {
+		t.Errorf("CPU.Count mismatch: got %d, want 16", inventory.CPU.Count)
+	}
+	if inventory.Memory.PhysicalBytes != 68719476736 || inventory.Memory.UsableBytes != 67108864000 {
+		t.Errorf("Memory mismatch: got %+v", inventory.Memory)
+	}
+	if len(inventory.Disks) != 2 {
+		t.Fatalf("Expected 2 disks, got %d", len(inventory.Disks))
+	}
+	expectedDisk := HostDisk{
+		ID:        "/dev/disk/by-id/wwn-0x5000c500a0b1c2d3",
+		Name:      "sda",
+		Path:      "/dev/sda",
+		ByPath:    "/dev/disk/by-path/pci-0000:00:1f.2-ata-1",
+		DriveType: "SSD",
+		SizeBytes: 480103981056,
+		Bootable:  true,
+	}
+	if inventory.Disks[0] != expectedDisk {
+		t.Errorf("Disk mismatch: got %+v, want %+v", inventory.Disks[0], expectedDisk)
+	}
+	if len(inventory.Interfaces) != 2 {
+		t.Fatalf("Expected 2 interfaces, got %d", len(inventory.Interfaces))
+	}
+	iface := inventory.Interfaces[0]
+	if iface.MacAddress != "52:54:00:aa:bb:01" || len(iface.IPv4Addresses) != 1 || iface.IPv4Addresses[0] != "192.168.122.10/24" {
+		t.Errorf("Interface mismatch: got %+v", iface)
+	}
+
+	if inventory, err := (&Host{}).ParseInventory(); inventory != nil || err != nil {
+		t.Errorf("Expected no inventory for a host that has not reported one, got %+v, %v", inventory, err)
+	}
+	if _, err := (&Host{Inventory: "not json"}).ParseInventory(); err == nil {
+		t.Error("Expected an error for an invalid inventory")
+	}
+}
+
+func TestValidationGroups_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected int
+		wantErr  bool
+	}{
+		{
+			name:     "json-formatted string",
+			data:     `{"validations_info":"{\"network\":[{\"id\":\"api-vips-defined\",\"status\":\"success\",\"message\":\"ok\"}],\"hosts-data\":[]}"}`,
+			expected: 2,
+		},
+		{
+			name:     "object",
+			data:     `{"validations_info":{"network":[{"id":"api-vips-defined","status":"success","message":"ok"}]}}`,
+			expected: 1,
+		},
+		{
+			name: "empty string",
+			data: `{"validations_info":""}`,
+		},
+		{
+			name:    "invalid string",
+			data:    `{"validations_info":"not json"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp ClusterValidationResponse
+			err := json.Unmarshal([]byte(tt.data), &resp)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to unmarshal validations: %v", err)
+			}
+			if len(resp.ValidationsInfo) != tt.expected {
+				t.Errorf("Expected %d validation groups, got %d", tt.expected, len(resp.ValidationsInfo))
+			}
+			if tt.expected > 0 && resp.ValidationsInfo["network"][0].ID != "api-vips-defined" {
+				t.Errorf("Expected the network validation to be parsed, got %+v", resp.ValidationsInfo["network"])
+			}
+		})
+	}
+}
+
+func TestParseIPXEScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		expected BootArtifacts
+	}{
+		{
+			name: "full script",
+			script: `#!ipxe
+initrd --name initrd https://example.com/pxe-initrd?arch=x86_64
+kernel https://example.com/kernel?arch=x86_64 initrd=initrd coreos.live.rootfs_url=https://example.com/rootfs?arch=x86_64 random.trust_cpu=on
+boot
+`,
+			expected: BootArtifacts{
+				KernelURL: "https://example.com/kernel?arch=x86_64",
+				InitrdURL: "https://example.com/pxe-initrd?arch=x86_64",
+				RootfsURL: "https://example.com/rootfs?arch=x86_64",
+			},
+		},
+		{
+			name:   "option with inline value",
+			script: "initrd --name=initrd https://example.com/initrd\nkernel https://example.com/kernel\n",
+			expected: BootArtifacts{
+				KernelURL: "https://example.com/kernel",
+				InitrdURL: "https://example.com/initrd",
+			},
+		},
+		{
+			name:   "no boot commands",
+			script: "#!ipxe\nchain https://example.com/next.ipxe\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseIPXEScript(tt.script); got != tt.expected {
+				t.Errorf("ParseIPXEScript() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
 
 // Credentials represents cluster admin credentials
 type Credentials struct {
@@ -28,6 +32,17 @@ type EventsResponse struct {
 	Events []Event `json:"events,omitempty"`
 }
 
+// UnmarshalJSON accepts the bare event list returned by the events API as
+// well as an object wrapping it in "events"
+func (r *EventsResponse) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, &r.Events)
+	}
+
+	type eventsResponse EventsResponse
+	return json.Unmarshal(data, (*eventsResponse)(r))
+}
+
 // LogsState represents the state of log collection
 type LogsState string
 
@@ -0,0 +1,51 @@
+package models
+
+// PreflightHardwareRequirements are the hardware requirements of a cluster,
+// for OpenShift itself and for each operator of the cluster
+type PreflightHardwareRequirements struct {
+	Operators []OperatorHardwareRequirements       `json:"operators,omitempty"`
+	OCP       *HostTypeHardwareRequirementsWrapper `json:"ocp,omitempty"`
+}
+
+type OperatorHardwareRequirements struct {
+	OperatorName string                               `json:"operator_name"`
+	Dependencies []string                             `json:"dependencies,omitempty"`
+	Requirements *HostTypeHardwareRequirementsWrapper `json:"requirements,omitempty"`
+}
+
+// HostTypeHardwareRequirementsWrapper holds requirements per host role
+type HostTypeHardwareRequirementsWrapper struct {
+	Master *HostTypeHardwareRequirements `json:"master,omitempty"`
+	Worker *HostTypeHardwareRequirements `json:"worker,omitempty"`
+}
+
+type HostTypeHardwareRequirements struct {
+	Quantitative *ClusterHostRequirementsDetails `json:"quantitative,omitempty"`
+	Qualitative  []string                        `json:"qualitative,omitempty"`
+}
+
+type ClusterHostRequirementsDetails struct {
+	CPUCores   int `json:"cpu_cores,omitempty"`
+	RAMMib     int `json:"ram_mib,omitempty"`
+	DiskSizeGB int `json:"disk_size_gb,omitempty"`
+}
+
+// ForRole returns the quantitative requirements for the given host role, or
+// nil when there are none
+func (w *HostTypeHardwareRequirementsWrapper) ForRole(role string) *ClusterHostRequirementsDetails {
+	if w == nil {
+		return nil
+	}
+
+	var requirements *HostTypeHardwareRequirements
+	switch role {
+	case "master":
+		requirements = w.Master
+	case "worker":
+		requirements = w.Worker
+	}
+	if requirements == nil {
+		return nil
+	}
+	return requirements.Quantitative
+}
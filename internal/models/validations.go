@@ -1,5 +1,10 @@
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // ValidationInfo represents validation information from the API
 type ValidationInfo struct {
 	ID              string `json:"id"`
@@ -10,15 +15,37 @@ type ValidationInfo struct {
 	ValidationGroup string `json:"validation_group,omitempty"`
 }
 
+// ValidationGroups holds validation results grouped by category. The API
+// reports them as a JSON-formatted string, a JSON object is accepted as well.
+type ValidationGroups map[string][]ValidationInfo
+
+func (g *ValidationGroups) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		if encoded == "" {
+			*g = nil
+			return nil
+		}
+		data = []byte(encoded)
+	}
+
+	var groups map[string][]ValidationInfo
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return fmt.Errorf("failed to parse validations_info: %w", err)
+	}
+	*g = groups
+	return nil
+}
+
 // ClusterValidationResponse represents cluster validation response
 type ClusterValidationResponse struct {
-	ValidationsInfo map[string][]ValidationInfo `json:"validations_info"`
+	ValidationsInfo ValidationGroups `json:"validations_info"`
 }
 
 // HostValidationResponse represents host validation response
 type HostValidationResponse struct {
-	ID              string                      `json:"id"`
-	ValidationsInfo map[string][]ValidationInfo `json:"validations_info"`
+	ID              string           `json:"id"`
+	ValidationsInfo ValidationGroups `json:"validations_info"`
 }
 
 // HostsValidationResponse represents response for all hosts validations
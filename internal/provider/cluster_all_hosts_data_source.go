@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterAllHostsDataSource{}
+
+func NewClusterAllHostsDataSource() datasource.DataSource {
+	return &ClusterAllHostsDataSource{}
+}
+
+// ClusterAllHostsDataSource lists the hosts of a cluster across all the
+// infra-envs that discovered them.
+type ClusterAllHostsDataSource struct {
+	client *client.Client
+}
+
+// ClusterAllHostsDataSourceModel describes the data source data model.
+type ClusterAllHostsDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	ClusterID types.String            `tfsdk:"cluster_id"`
+	Hosts     []ClusterHostEntryModel `tfsdk:"hosts"`
+}
+
+// ClusterHostEntryModel describes a host of the cluster and the infra-env it
+// was discovered in.
+type ClusterHostEntryModel struct {
+	ID                types.String `tfsdk:"id"`
+	InfraEnvID        types.String `tfsdk:"infra_env_id"`
+	CPUArchitecture   types.String `tfsdk:"cpu_architecture"`
+	RequestedHostname types.String `tfsdk:"requested_hostname"`
+	Status            types.String `tfsdk:"status"`
+	Role              types.String `tfsdk:"role"`
+	SuggestedRole     types.String `tfsdk:"suggested_role"`
+}
+
+func (d *ClusterAllHostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_all_hosts"
+}
+
+func (d *ClusterAllHostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the hosts bound to a cluster across all infrastructure environments, including late-binding ones and those of other CPU architectures. Useful to plan the installation of multi-architecture clusters.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier.",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster to list hosts for",
+				Required:            true,
+			},
+			"hosts": schema.ListNestedAttribute{
+				MarkdownDescription: "Hosts bound to the cluster, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the host (UUID)",
+							Computed:            true,
+						},
+						"infra_env_id": schema.StringAttribute{
+							MarkdownDescription: "The infrastructure environment the host was discovered in",
+							Computed:            true,
+						},
+						"cpu_architecture": schema.StringAttribute{
+							MarkdownDescription: "CPU architecture of the infrastructure environment's discovery ISO",
+							Computed:            true,
+						},
+						"requested_hostname": schema.StringAttribute{
+							MarkdownDescription: "Requested hostname for this host",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current host status",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role assigned to this host (master, worker, auto-assign)",
+							Computed:            true,
+						},
+						"suggested_role": schema.StringAttribute{
+							MarkdownDescription: "The role the service suggests for an auto-assign host",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterAllHostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterAllHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterAllHostsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+
+	tflog.Info(ctx, "Fetching cluster hosts across infra-envs", map[string]any{
+		"data_source": "oai_cluster_all_hosts",
+		"cluster_id":  clusterID,
+	})
+
+	infraEnvs, err := d.client.ListInfraEnvs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching infra-envs", fmt.Sprintf("Could not list infra-envs: %s", err))
+		return
+	}
+
+	seen := make(map[string]bool)
+	data.Hosts = []ClusterHostEntryModel{}
+	for _, infraEnv := range infraEnvs {
+		// Hosts of an infra-env bound to another cluster cannot belong to this
+		// one, while late-binding infra-envs may hold hosts of any cluster
+		if infraEnv.ClusterID != "" && infraEnv.ClusterID != clusterID {
+			continue
+		}
+
+		hosts, err := d.client.ListHosts(ctx, infraEnv.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching hosts", fmt.Sprintf("Could not list hosts for infra-env %s: %s", infraEnv.ID, err))
+			return
+		}
+
+		for _, host := range hosts {
+			if host.ClusterID != clusterID || seen[host.ID] {
+				continue
+			}
+			seen[host.ID] = true
+
+			data.Hosts = append(data.Hosts, ClusterHostEntryModel{
+				ID:                types.StringValue(host.ID),
+				InfraEnvID:        types.StringValue(infraEnv.ID),
+				CPUArchitecture:   stringValueOrNull(infraEnv.CPUArchitecture),
+				RequestedHostname: stringValueOrNull(host.RequestedHostname),
+				Status:            types.StringValue(host.Status),
+				Role:              stringValueOrNull(host.Role),
+				SuggestedRole:     stringValueOrNull(host.SuggestedRole),
+			})
+		}
+	}
+
+	// The order of infra-envs is not guaranteed, so sort to keep the list stable
+	sort.Slice(data.Hosts, func(i, j int) bool {
+		return data.Hosts[i].ID.ValueString() < data.Hosts[j].ID.ValueString()
+	})
+
+	data.ID = types.StringValue(fmt.Sprintf("cluster-all-hosts-%s", clusterID))
+
+	tflog.Info(ctx, "Successfully fetched cluster hosts", map[string]any{
+		"host_count": len(data.Hosts),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterAllHostsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	var listed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/infra-envs":
+			_ = json.NewEncoder(w).Encode([]models.InfraEnv{
+				{ID: "infra-env-x86", ClusterID: "test-cluster-id", CPUArchitecture: "x86_64"},
+				{ID: "infra-env-arm", CPUArchitecture: "arm64"},
+				{ID: "infra-env-other", ClusterID: "other-cluster-id", CPUArchitecture: "x86_64"},
+			})
+		case "/v2/infra-envs/infra-env-x86/hosts":
+			listed = append(listed, "infra-env-x86")
+			_ = json.NewEncoder(w).Encode([]models.Host{
+				{ID: "host-c", InfraEnvID: "infra-env-x86", ClusterID: "test-cluster-id", Status: "known", Role: "master", RequestedHostname: "master-0"},
+				{ID: "host-a", InfraEnvID: "infra-env-x86", ClusterID: "test-cluster-id", Status: "known", Role: "auto-assign", SuggestedRole: "worker"},
+			})
+		case "/v2/infra-envs/infra-env-arm/hosts":
+			listed = append(listed, "infra-env-arm")
+			_ = json.NewEncoder(w).Encode([]models.Host{
+				{ID: "host-b", InfraEnvID: "infra-env-arm", ClusterID: "test-cluster-id", Status: "known", Role: "worker"},
+				{ID: "host-d", InfraEnvID: "infra-env-arm", ClusterID: "other-cluster-id", Status: "known"},
+				{ID: "host-e", InfraEnvID: "infra-env-arm", Status: "known-unbound"},
+				// Duplicate listings are only reported once
+				{ID: "host-c", InfraEnvID: "infra-env-x86", ClusterID: "test-cluster-id", Status: "known", Role: "master"},
+			})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ds := &ClusterAllHostsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if len(listed) != 2 {
+		t.Errorf("Expected only the cluster's and the late-binding infra-env to be listed, got %v", listed)
+	}
+
+	var state ClusterAllHostsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+
+	expected := []struct {
+		id, infraEnvID, arch, role string
+	}{
+		{id: "host-a", infraEnvID: "infra-env-x86", arch: "x86_64", role: "auto-assign"},
+		{id: "host-b", infraEnvID: "infra-env-arm", arch: "arm64", role: "worker"},
+		{id: "host-c", infraEnvID: "infra-env-x86", arch: "x86_64", role: "master"},
+	}
+	if len(state.Hosts) != len(expected) {
+		t.Fatalf("Expected %d hosts, got %d: %+v", len(expected), len(state.Hosts), state.Hosts)
+	}
+	for i, host := range expected {
+		got := state.Hosts[i]
+		if got.ID.ValueString() != host.id || got.InfraEnvID.ValueString() != host.infraEnvID ||
+			got.CPUArchitecture.ValueString() != host.arch || got.Role.ValueString() != host.role {
+			t.Errorf("Host %d: expected %+v, got %+v", i, host, got)
+		}
+	}
+	if state.Hosts[0].SuggestedRole.ValueString() != "worker" {
+		t.Errorf("Expected the suggested role of host-a, got %s", state.Hosts[0].SuggestedRole)
+	}
+	if state.Hosts[2].RequestedHostname.ValueString() != "master-0" {
+		t.Errorf("Expected the first listing of host-c to be kept, got %s", state.Hosts[2].RequestedHostname)
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterArtifactsDataSource{}
+
+func NewClusterArtifactsDataSource() datasource.DataSource {
+	return &ClusterArtifactsDataSource{}
+}
+
+// ClusterArtifactsDataSource defines the data source implementation.
+type ClusterArtifactsDataSource struct {
+	client *client.Client
+}
+
+// ClusterArtifactsDataSourceModel describes the data source data model.
+type ClusterArtifactsDataSourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ClusterID types.String   `tfsdk:"cluster_id"`
+	OutputDir types.String   `tfsdk:"output_dir"`
+	Files     []types.String `tfsdk:"files"`
+}
+
+func (d *ClusterArtifactsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_artifacts"
+}
+
+func (d *ClusterArtifactsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the artifacts of an installed cluster to a directory: `auth/kubeconfig`, `auth/kubeadmin-password`, `install-config.yaml` and the custom manifests under `manifests/` and `openshift/`. Can only be used after the cluster installation is complete.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier.",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster to export artifacts for.",
+				Required:            true,
+			},
+			"output_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory to write the artifacts to. It is created if it does not exist and existing files are overwritten.",
+				Required:            true,
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Paths of the written files, in the order they were written.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ClusterArtifactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterArtifactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterArtifactsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	outputDir := data.OutputDir.ValueString()
+
+	tflog.Info(ctx, "Exporting cluster artifacts", map[string]any{
+		"data_source": "oai_cluster_artifacts",
+		"cluster_id":  clusterID,
+		"output_dir":  outputDir,
+	})
+
+	if !requireInstalledCluster(ctx, d.client, clusterID, "Artifacts", &resp.Diagnostics) {
+		return
+	}
+
+	data.Files = []types.String{}
+	write := func(name string, content []byte) bool {
+		path := filepath.Join(outputDir, name)
+		if err := writeOutputFile(path, content); err != nil {
+			resp.Diagnostics.AddError("Error writing cluster artifact", fmt.Sprintf("Unable to write %s: %s", path, err))
+			return false
+		}
+		data.Files = append(data.Files, types.StringValue(path))
+		return true
+	}
+
+	for _, name := range []string{"kubeconfig", "kubeadmin-password"} {
+		content, err := d.client.DownloadClusterCredentialFile(ctx, clusterID, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching cluster artifact", fmt.Sprintf("Could not download %s for cluster %s: %s", name, clusterID, err))
+			return
+		}
+		if !write(filepath.Join("auth", name), content) {
+			return
+		}
+	}
+
+	installConfig, err := d.client.DownloadClusterFiles(ctx, clusterID, "install-config.yaml", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching cluster artifact", fmt.Sprintf("Could not download install-config.yaml for cluster %s: %s", clusterID, err))
+		return
+	}
+	if !write("install-config.yaml", installConfig) {
+		return
+	}
+
+	manifests, err := d.client.ListManifests(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching cluster artifact", fmt.Sprintf("Could not list manifests for cluster %s: %s", clusterID, err))
+		return
+	}
+	for _, manifest := range manifests {
+		content, err := d.client.DownloadManifestContent(ctx, clusterID, manifest.FileName, manifest.Folder)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching cluster artifact", fmt.Sprintf("Could not download manifest %s/%s for cluster %s: %s", manifest.Folder, manifest.FileName, clusterID, err))
+			return
+		}
+		// Keep the folder so manifests and openshift manifests with the same name do not collide
+		if !write(filepath.Join(manifest.Folder, filepath.Base(manifest.FileName)), []byte(content)) {
+			return
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("artifacts-%s", clusterID))
+
+	tflog.Info(ctx, "Successfully exported cluster artifacts", map[string]any{
+		"file_count": len(data.Files),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
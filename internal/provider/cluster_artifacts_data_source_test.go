@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterArtifactsDataSource_Metadata(t *testing.T) {
+	ds := NewClusterArtifactsDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	if metadataResp.TypeName != "openshift_assisted_installer_cluster_artifacts" {
+		t.Errorf("Expected type name 'openshift_assisted_installer_cluster_artifacts', got '%s'", metadataResp.TypeName)
+	}
+}
+
+func TestClusterArtifactsDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/v2/clusters/test-cluster-id":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: "installed"})
+		case "/v2/clusters/test-cluster-id/downloads/credentials":
+			_, _ = w.Write([]byte("credential:" + query.Get("file_name")))
+		case "/v2/clusters/test-cluster-id/downloads/files":
+			_, _ = w.Write([]byte("file:" + query.Get("file_name")))
+		case "/v2/clusters/test-cluster-id/manifests":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]models.Manifest{
+				{Folder: "manifests", FileName: "chrony.yaml"},
+				{Folder: "openshift", FileName: "chrony.yaml"},
+			})
+		case "/v2/clusters/test-cluster-id/manifests/files":
+			_, _ = w.Write([]byte("manifest:" + query.Get("folder") + "/" + query.Get("file_name")))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ds := &ClusterArtifactsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	outputDir := filepath.Join(t.TempDir(), "artifacts")
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"output_dir": tftypes.NewValue(tftypes.String, outputDir),
+	})
+	ds.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var state ClusterArtifactsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+
+	expected := []struct {
+		name    string
+		content string
+	}{
+		{"auth/kubeconfig", "credential:kubeconfig"},
+		{"auth/kubeadmin-password", "credential:kubeadmin-password"},
+		{"install-config.yaml", "file:install-config.yaml"},
+		{"manifests/chrony.yaml", "manifest:manifests/chrony.yaml"},
+		{"openshift/chrony.yaml", "manifest:openshift/chrony.yaml"},
+	}
+
+	if len(state.Files) != len(expected) {
+		t.Fatalf("Expected %d files, got %v", len(expected), state.Files)
+	}
+	for i, file := range expected {
+		path := filepath.Join(outputDir, file.name)
+		if state.Files[i].ValueString() != path {
+			t.Errorf("Expected file %d to be %s, got %s", i, path, state.Files[i])
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", path, err)
+			continue
+		}
+		if string(content) != file.content {
+			t.Errorf("Expected %s to contain %q, got %q", file.name, file.content, content)
+		}
+	}
+}
+
+func TestClusterArtifactsDataSource_ReadNotInstalled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/clusters/test-cluster-id" {
+			t.Errorf("Unexpected request to %s before installation completed", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: "installing"})
+	}))
+	defer server.Close()
+
+	ds := &ClusterArtifactsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	outputDir := filepath.Join(t.TempDir(), "artifacts")
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"output_dir": tftypes.NewValue(tftypes.String, outputDir),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Cluster Not Installed" {
+		t.Fatalf("Expected Cluster Not Installed error, got %+v", resp.Diagnostics)
+	}
+	if _, err := os.Stat(outputDir); !os.IsNotExist(err) {
+		t.Errorf("Expected no output directory to be created, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterResource_Create_ExpandsBundle(t *testing.T) {
+	tests := []struct {
+		name              string
+		expandBundle      bool
+		expectedRequested []string
+		expectedAdded     []string
+	}{
+		{
+			name:              "expand bundle",
+			expandBundle:      true,
+			expectedRequested: []string{"cnv", "mtv", "nmstate"},
+			expectedAdded:     []string{"mtv", "nmstate"},
+		},
+		{
+			name:              "expansion disabled",
+			expandBundle:      false,
+			expectedRequested: []string{"cnv"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requested []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/operators/bundles/virtualization":
+					_ = json.NewEncoder(w).Encode(models.Bundle{
+						ID:        "virtualization",
+						Operators: []string{"cnv", "mtv", "nmstate"},
+					})
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters":
+					var params models.ClusterCreateParams
+					if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+						t.Errorf("Failed to decode create params: %v", err)
+					}
+					for _, op := range params.OLMOperators {
+						requested = append(requested, op.Name)
+					}
+					w.WriteHeader(http.StatusCreated)
+					_ = json.NewEncoder(w).Encode(models.Cluster{
+						ID:               "test-cluster-id",
+						Name:             params.Name,
+						OpenshiftVersion: params.OpenshiftVersion,
+						Status:           "insufficient",
+						OLMOperators:     params.OLMOperators,
+					})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			operatorsType := clusterConfigAttributeType(t, "olm_operators").(tftypes.List)
+			operatorType := operatorsType.ElementType.(tftypes.Object)
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"pull_secret":       tftypes.NewValue(tftypes.String, "pull-secret"),
+				"bundle":            tftypes.NewValue(tftypes.String, "virtualization"),
+				"expand_bundle":     tftypes.NewValue(tftypes.Bool, tt.expandBundle),
+				"olm_operators": tftypes.NewValue(operatorsType, []tftypes.Value{
+					tftypes.NewValue(operatorType, map[string]tftypes.Value{
+						"name":       tftypes.NewValue(tftypes.String, "cnv"),
+						"properties": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if !slices.Equal(requested, tt.expectedRequested) {
+				t.Errorf("Expected create request operators %v, got %v", tt.expectedRequested, requested)
+			}
+
+			var data ClusterResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			var added []string
+			data.BundleOperators.ElementsAs(ctx, &added, false)
+			if !slices.Equal(added, tt.expectedAdded) {
+				t.Errorf("Expected bundle_operators %v, got %v", tt.expectedAdded, added)
+			}
+
+			// Only the configured operator is tracked in olm_operators
+			var operators []OLMOperatorModel
+			data.OLMOperators.ElementsAs(ctx, &operators, false)
+			if len(operators) != 1 || operators[0].Name.ValueString() != "cnv" {
+				t.Errorf("Expected olm_operators to contain only cnv, got %+v", operators)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// cpuArchitectures are the CPU architectures the Assisted Service accepts for
+// clusters and infrastructure environments
+var cpuArchitectures = []string{"x86_64", "aarch64", "arm64", "ppc64le", "s390x", "multi"}
+
+// normalizeCPUArchitecture maps aarch64 to arm64, the form the Assisted
+// Service stores for clusters
+func normalizeCPUArchitecture(architecture string) string {
+	if architecture == "aarch64" {
+		return "arm64"
+	}
+	return architecture
+}
+
+// cpuArchitectureValue returns the CPU architecture the API reported, keeping
+// the configured spelling when it names the same architecture so that
+// configuring aarch64 does not force a replacement.
+func cpuArchitectureValue(configured types.String, reported string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() &&
+		normalizeCPUArchitecture(configured.ValueString()) == normalizeCPUArchitecture(reported) {
+		return configured
+	}
+	return types.StringValue(reported)
+}
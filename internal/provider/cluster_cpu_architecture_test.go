@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClusterResource_Schema_CPUArchitecture(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ClusterResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	attr, ok := schemaResp.Schema.Attributes["cpu_architecture"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected cpu_architecture to be a string attribute")
+	}
+
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: "x86_64"},
+		{value: "aarch64"},
+		{value: "arm64"},
+		{value: "ppc64le"},
+		{value: "s390x"},
+		{value: "multi"},
+		{value: "amd64", expectError: true},
+		{value: "aarch_64", expectError: true},
+		{value: "X86_64", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			for _, v := range attr.StringValidators() {
+				v.ValidateString(ctx, validator.StringRequest{
+					Path:        path.Root("cpu_architecture"),
+					ConfigValue: types.StringValue(tt.value),
+				}, resp)
+			}
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCPUArchitectureValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		reported   string
+		expected   string
+	}{
+		{
+			name:       "not configured",
+			configured: types.StringUnknown(),
+			reported:   "x86_64",
+			expected:   "x86_64",
+		},
+		{
+			name:       "same spelling",
+			configured: types.StringValue("arm64"),
+			reported:   "arm64",
+			expected:   "arm64",
+		},
+		{
+			name:       "aarch64 reported as arm64",
+			configured: types.StringValue("aarch64"),
+			reported:   "arm64",
+			expected:   "aarch64",
+		},
+		{
+			name:       "arm64 reported as aarch64",
+			configured: types.StringValue("arm64"),
+			reported:   "aarch64",
+			expected:   "arm64",
+		},
+		{
+			name:       "different architecture",
+			configured: types.StringValue("aarch64"),
+			reported:   "x86_64",
+			expected:   "x86_64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cpuArchitectureValue(tt.configured, tt.reported)
+			if got.ValueString() != tt.expected {
+				t.Errorf("cpuArchitectureValue() = %s, want %s", got.ValueString(), tt.expected)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,6 +30,7 @@ type ClusterCredentialsDataSourceModel struct {
 	Username   types.String `tfsdk:"username"`
 	Password   types.String `tfsdk:"password"`
 	ConsoleURL types.String `tfsdk:"console_url"`
+	Kubeconfig types.String `tfsdk:"kubeconfig"`
 }
 
 func (d *ClusterCredentialsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -62,6 +64,11 @@ func (d *ClusterCredentialsDataSource) Schema(ctx context.Context, req datasourc
 				MarkdownDescription: "URL of the OpenShift web console",
 				Computed:            true,
 			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "Admin kubeconfig for the cluster, suitable for configuring the kubernetes and helm providers",
+				Computed:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
@@ -96,8 +103,15 @@ func (d *ClusterCredentialsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	clusterID := data.ClusterID.ValueString()
+
+	// Credentials are only issued once the installation has completed
+	if !requireInstalledCluster(ctx, d.client, clusterID, "Credentials", &resp.Diagnostics) {
+		return
+	}
+
 	// Get cluster credentials from API
-	credentials, err := d.client.GetClusterCredentials(ctx, data.ClusterID.ValueString())
+	credentials, err := d.client.GetClusterCredentials(ctx, clusterID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -106,12 +120,44 @@ func (d *ClusterCredentialsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	kubeconfig, err := d.client.DownloadClusterCredentialFile(ctx, clusterID, "kubeconfig")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to download cluster kubeconfig, got error: %s", err),
+		)
+		return
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	data.ID = data.ClusterID // Use cluster_id as the unique identifier
 	data.Username = types.StringValue(credentials.Username)
 	data.Password = types.StringValue(credentials.Password)
 	data.ConsoleURL = types.StringValue(credentials.ConsoleURL)
+	data.Kubeconfig = types.StringValue(string(kubeconfig))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// requireInstalledCluster adds an error and returns false unless the cluster
+// has finished installing. what names the data that is only available then.
+func requireInstalledCluster(ctx context.Context, c *client.Client, clusterID, what string, diags *diag.Diagnostics) bool {
+	cluster, err := c.GetCluster(ctx, clusterID)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster %s, got error: %s", clusterID, err),
+		)
+		return false
+	}
+	if cluster.Status != "installed" {
+		diags.AddError(
+			"Cluster Not Installed",
+			fmt.Sprintf("%s for cluster %s are only available once installation has completed, but the cluster status is %q. "+
+				"Make this data source depend on the openshift_assisted_installer_cluster_installation resource.", what, clusterID, cluster.Status),
+		)
+		return false
+	}
+	return true
+}
@@ -5,16 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
-	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestClusterCredentialsDataSource_Schema(t *testing.T) {
@@ -43,11 +40,17 @@ func TestClusterCredentialsDataSource_Schema(t *testing.T) {
 	if _, ok := attrs["console_url"]; !ok {
 		t.Error("console_url attribute is missing")
 	}
+	if _, ok := attrs["kubeconfig"]; !ok {
+		t.Error("kubeconfig attribute is missing")
+	}
 
 	// Check that password is marked as sensitive
 	if !attrs["password"].IsSensitive() {
 		t.Error("password attribute should be marked as sensitive")
 	}
+	if !attrs["kubeconfig"].IsSensitive() {
+		t.Error("kubeconfig attribute should be marked as sensitive")
+	}
 }
 
 func TestClusterCredentialsDataSource_Configure(t *testing.T) {
@@ -90,106 +93,132 @@ func TestClusterCredentialsDataSource_Metadata(t *testing.T) {
 	}
 }
 
-// SkipTestClusterCredentialsDataSource_Read requires full Terraform framework
-// This is an integration test, not a unit test. To run integration tests,
-// use: go test -tags=integration
-// func TestClusterCredentialsDataSource_Read(t *testing.T) {
-func SkipTestClusterCredentialsDataSource_Read(t *testing.T) {
-	t.Skip("Integration test - requires full Terraform Plugin Framework")
-	// Mock credentials response
-	mockCredentials := models.Credentials{
-		Username:   "kubeadmin",
-		Password:   "secret123",
-		ConsoleURL: "https://console-openshift-console.apps.test-cluster.example.com",
+func TestClusterCredentialsDataSource_Read(t *testing.T) {
+	const kubeconfig = "apiVersion: v1\nkind: Config\nclusters:\n- name: test-cluster\n"
+
+	tests := []struct {
+		name          string
+		status        string
+		expectError   string
+		expectedCalls []string
+	}{
+		{
+			name:   "installed",
+			status: "installed",
+			expectedCalls: []string{
+				"/v2/clusters/test-cluster-id",
+				"/v2/clusters/test-cluster-id/credentials",
+				"/v2/clusters/test-cluster-id/downloads/credentials",
+			},
+		},
+		{
+			name:          "still installing",
+			status:        "installing",
+			expectError:   "Cluster Not Installed",
+			expectedCalls: []string{"/v2/clusters/test-cluster-id"},
+		},
 	}
 
-	// Create mock server
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
-			t.Errorf("Expected GET request, got %s", r.Method)
-		}
-
-		expectedPath := "/v2/clusters/test-cluster-id/credentials"
-		if r.URL.Path != expectedPath {
-			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
-		}
-
-		// Check authentication header
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			t.Error("Missing Authorization header")
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockCredentials)
-	}))
-	defer server.Close()
-
-	// Create test provider with mock server
-	testProvider := &OAIProvider{}
-	testProvider.Configure(context.Background(), provider.ConfigureRequest{}, &provider.ConfigureResponse{
-		DataSourceData: client.NewClient(client.ClientConfig{
-			BaseURL:      server.URL,
-			OfflineToken: "test-token",
-		}),
-	})
-
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
-			"openshift_assisted_installer": providerserver.NewProtocol6WithError(testProvider),
-		},
-		Steps: []resource.TestStep{
-			{
-				Config: `
-					data "openshift_assisted_installer_cluster_credentials" "test" {
-						cluster_id = "test-cluster-id"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET request, got %s", r.Method)
+				}
+				calls = append(calls, r.URL.Path)
+
+				switch r.URL.Path {
+				case "/v2/clusters/test-cluster-id":
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: tt.status})
+				case "/v2/clusters/test-cluster-id/credentials":
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(models.Credentials{
+						Username:   "kubeadmin",
+						Password:   "secret123",
+						ConsoleURL: "https://console-openshift-console.apps.test-cluster.example.com",
+					})
+				case "/v2/clusters/test-cluster-id/downloads/credentials":
+					if got := r.URL.Query().Get("file_name"); got != "kubeconfig" {
+						t.Errorf("Expected file_name kubeconfig, got %s", got)
 					}
-				`,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.openshift_assisted_installer_cluster_credentials.test", "cluster_id", "test-cluster-id"),
-					resource.TestCheckResourceAttr("data.openshift_assisted_installer_cluster_credentials.test", "username", "kubeadmin"),
-					resource.TestCheckResourceAttr("data.openshift_assisted_installer_cluster_credentials.test", "password", "secret123"),
-					resource.TestCheckResourceAttr("data.openshift_assisted_installer_cluster_credentials.test", "console_url", "https://console-openshift-console.apps.test-cluster.example.com"),
-				),
-			},
-		},
-	})
+					_, _ = w.Write([]byte(kubeconfig))
+				default:
+					t.Errorf("Unexpected path %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			ds := &ClusterCredentialsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			})
+			ds.Read(context.Background(), req, resp)
+
+			if !slices.Equal(calls, tt.expectedCalls) {
+				t.Errorf("Expected calls %v, got %v", tt.expectedCalls, calls)
+			}
+
+			if tt.expectError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.expectError {
+					t.Fatalf("Expected %q error, got %+v", tt.expectError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state ClusterCredentialsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			if state.Username.ValueString() != "kubeadmin" {
+				t.Errorf("Expected username kubeadmin, got %s", state.Username)
+			}
+			if state.Password.ValueString() != "secret123" {
+				t.Errorf("Expected password secret123, got %s", state.Password)
+			}
+			if state.ConsoleURL.ValueString() != "https://console-openshift-console.apps.test-cluster.example.com" {
+				t.Errorf("Unexpected console_url %s", state.ConsoleURL)
+			}
+			if state.Kubeconfig.ValueString() != kubeconfig {
+				t.Errorf("Expected kubeconfig %q, got %q", kubeconfig, state.Kubeconfig.ValueString())
+			}
+		})
+	}
 }
 
-// SkipTestClusterCredentialsDataSource_Read_Error requires full Terraform framework
-// This is an integration test, not a unit test.
-// func TestClusterCredentialsDataSource_Read_Error(t *testing.T) {
-func SkipTestClusterCredentialsDataSource_Read_Error(t *testing.T) {
-	t.Skip("Integration test - requires full Terraform Plugin Framework")
-	// Create mock server that returns an error
+func TestClusterCredentialsDataSource_ReadError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
 		_, _ = w.Write([]byte("Cluster not found"))
 	}))
 	defer server.Close()
 
-	// Create test provider with mock server
-	testProvider := &OAIProvider{}
-	testProvider.Configure(context.Background(), provider.ConfigureRequest{}, &provider.ConfigureResponse{
-		DataSourceData: client.NewClient(client.ClientConfig{
+	ds := &ClusterCredentialsDataSource{
+		client: client.NewClient(client.ClientConfig{
 			BaseURL:      server.URL,
 			OfflineToken: "test-token",
 		}),
-	})
+	}
 
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
-			"openshift_assisted_installer": providerserver.NewProtocol6WithError(testProvider),
-		},
-		Steps: []resource.TestStep{
-			{
-				Config: `
-					data "openshift_assisted_installer_cluster_credentials" "test" {
-						cluster_id = "nonexistent-cluster"
-					}
-				`,
-				ExpectError: regexp.MustCompile("API request failed with status 404"),
-			},
-		},
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "nonexistent-cluster"),
 	})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a missing cluster")
+	}
 }
@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -108,6 +112,7 @@ type ClusterDataSourceModel struct {
 
 	// Connectivity and networking details
 	ConnectivityMajorityGroups types.String `tfsdk:"connectivity_majority_groups"`
+	ConnectivityGroups         types.List   `tfsdk:"connectivity_groups"`
 	IPCollisions               types.String `tfsdk:"ip_collisions"`
 	HostNetworks               types.List   `tfsdk:"host_networks"`
 
@@ -116,9 +121,9 @@ type ClusterDataSourceModel struct {
 	IgnoredClusterValidations types.String `tfsdk:"ignored_cluster_validations"`
 
 	// Operators and features
-	MonitoredOperators types.List   `tfsdk:"monitored_operators"`
-	FeatureUsage       types.String `tfsdk:"feature_usage"`
-	AMSSubscriptionID  types.String `tfsdk:"ams_subscription_id"`
+	MonitoredOperators []ClusterMonitoredOperatorModel `tfsdk:"monitored_operators"`
+	FeatureUsage       types.String                    `tfsdk:"feature_usage"`
+	AMSSubscriptionID  types.String                    `tfsdk:"ams_subscription_id"`
 
 	// Day-2 and import
 	Imported                    types.Bool   `tfsdk:"imported"`
@@ -135,6 +140,57 @@ type ClusterDataSourceModel struct {
 	InstallationCompletedAt types.String `tfsdk:"installation_completed_at"`
 }
 
+// Attribute types of the nested objects of the cluster data source
+var (
+	clusterDataSourceNetworkAttrTypes = map[string]attr.Type{
+		"cidr": types.StringType,
+	}
+	clusterDataSourceClusterNetworkAttrTypes = map[string]attr.Type{
+		"cidr":        types.StringType,
+		"host_prefix": types.Int64Type,
+	}
+	clusterDataSourceHostNetworkAttrTypes = map[string]attr.Type{
+		"cidr":     types.StringType,
+		"host_ids": types.ListType{ElemType: types.StringType},
+	}
+	clusterDataSourceConnectivityGroupAttrTypes = map[string]attr.Type{
+		"network":           types.StringType,
+		"host_ids":          types.ListType{ElemType: types.StringType},
+		"excluded_host_ids": types.ListType{ElemType: types.StringType},
+	}
+	clusterDataSourceProgressAttrTypes = map[string]attr.Type{
+		"current_stage":           types.StringType,
+		"installation_percentage": types.Int64Type,
+		"stage_started_at":        types.StringType,
+		"stage_updated_at":        types.StringType,
+	}
+	clusterDataSourceLogsInfoAttrTypes = map[string]attr.Type{
+		"state":      types.StringType,
+		"state_info": types.StringType,
+	}
+	clusterDataSourceDiskEncryptionAttrTypes = map[string]attr.Type{
+		"enable_on": types.StringType,
+		"mode":      types.StringType,
+	}
+	clusterDataSourceTypeAttrTypes = map[string]attr.Type{
+		"type": types.StringType,
+	}
+	clusterDataSourceImageInfoAttrTypes = map[string]attr.Type{
+		"created_at":   types.StringType,
+		"expires_at":   types.StringType,
+		"download_url": types.StringType,
+		"size_bytes":   types.Int64Type,
+	}
+	clusterDataSourceIgnitionEndpointAttrTypes = map[string]attr.Type{
+		"url":            types.StringType,
+		"ca_certificate": types.StringType,
+	}
+	clusterDataSourceLastPreparationAttrTypes = map[string]attr.Type{
+		"reason": types.StringType,
+		"status": types.StringType,
+	}
+)
+
 // ClusterAPIVipModel represents API/Ingress VIP configuration for data source
 type ClusterAPIVipModel struct {
 	IP           types.String `tfsdk:"ip"`
@@ -142,6 +198,17 @@ type ClusterAPIVipModel struct {
 	Verification types.String `tfsdk:"verification"`
 }
 
+// ClusterMonitoredOperatorModel represents an operator the service monitors
+// during and after installation
+type ClusterMonitoredOperatorModel struct {
+	Name            types.String `tfsdk:"name"`
+	Version         types.String `tfsdk:"version"`
+	Status          types.String `tfsdk:"status"`
+	StatusInfo      types.String `tfsdk:"status_info"`
+	StatusUpdatedAt types.String `tfsdk:"status_updated_at"`
+	TimeoutSeconds  types.Int64  `tfsdk:"timeout_seconds"`
+}
+
 func (d *ClusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cluster"
 }
@@ -226,7 +293,7 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 			},
 			"machine_network_cidr": schema.StringAttribute{
-				MarkdownDescription: "A CIDR that all hosts belonging to the cluster should have interfaces with IP addresses that belong to this CIDR",
+				MarkdownDescription: "A CIDR that all hosts belonging to the cluster should have interfaces with IP addresses that belong to this CIDR. Falls back to the first entry of `machine_networks`",
 				Computed:            true,
 			},
 			"api_vip_dns_name": schema.StringAttribute{
@@ -237,20 +304,45 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The desired network type used (OpenShiftSDN, OVNKubernetes)",
 				Computed:            true,
 			},
-			"cluster_networks": schema.ListAttribute{
+			"cluster_networks": schema.ListNestedAttribute{
 				MarkdownDescription: "Cluster networks that are associated with this cluster",
 				Computed:            true,
-				ElementType:         types.ObjectType{},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "IP address block from which Pod IPs are allocated",
+							Computed:            true,
+						},
+						"host_prefix": schema.Int64Attribute{
+							MarkdownDescription: "The subnet prefix length to assign to each individual node",
+							Computed:            true,
+						},
+					},
+				},
 			},
-			"service_networks": schema.ListAttribute{
+			"service_networks": schema.ListNestedAttribute{
 				MarkdownDescription: "Service networks that are associated with this cluster",
 				Computed:            true,
-				ElementType:         types.ObjectType{},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "The IP address pool to use for service IP addresses",
+							Computed:            true,
+						},
+					},
+				},
 			},
-			"machine_networks": schema.ListAttribute{
+			"machine_networks": schema.ListNestedAttribute{
 				MarkdownDescription: "Machine networks that are associated with this cluster",
 				Computed:            true,
-				ElementType:         types.ObjectType{},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "The IP address block of the machine network",
+							Computed:            true,
+						},
+					},
+				},
 			},
 
 			// VIP Configuration
@@ -268,7 +360,7 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							Computed:            true,
 						},
 						"verification": schema.StringAttribute{
-							MarkdownDescription: "VIP verification status",
+							MarkdownDescription: "VIP verification status: `unverified`, `failed` or `succeeded`",
 							Computed:            true,
 						},
 					},
@@ -288,7 +380,7 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							Computed:            true,
 						},
 						"verification": schema.StringAttribute{
-							MarkdownDescription: "VIP verification status",
+							MarkdownDescription: "VIP verification status: `unverified`, `failed` or `succeeded`",
 							Computed:            true,
 						},
 					},
@@ -522,14 +614,48 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "JSON formatted string containing the majority groups for connectivity checks",
 				Computed:            true,
 			},
+			"connectivity_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Parsed `connectivity_majority_groups`: the hosts in the majority connectivity group of each network, ordered by network",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network": schema.StringAttribute{
+							MarkdownDescription: "Machine network CIDR, or `IPv4`/`IPv6` for layer 3 connectivity",
+							Computed:            true,
+						},
+						"host_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the hosts in the majority group",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"excluded_host_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the hosts outside the majority group: hosts with an address in the network, or all hosts of the cluster for `IPv4`/`IPv6`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
 			"ip_collisions": schema.StringAttribute{
 				MarkdownDescription: "JSON formatted string containing ip collisions detected in the cluster",
 				Computed:            true,
 			},
-			"host_networks": schema.ListAttribute{
-				MarkdownDescription: "List of host networks to be filled during query",
+			"host_networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Networks the hosts of the cluster have addresses in",
 				Computed:            true,
-				ElementType:         types.ObjectType{},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "CIDR of the network",
+							Computed:            true,
+						},
+						"host_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the hosts with an address in the network",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
 			},
 
 			// Validation overrides
@@ -543,10 +669,37 @@ func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			},
 
 			// Operators and features
-			"monitored_operators": schema.ListAttribute{
-				MarkdownDescription: "Operators that are associated with this cluster",
+			"monitored_operators": schema.ListNestedAttribute{
+				MarkdownDescription: "Operators the service monitors for this cluster, with their status during and after installation",
 				Computed:            true,
-				ElementType:         types.ObjectType{},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the operator",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version of the operator",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the operator (available, progressing, failed)",
+							Computed:            true,
+						},
+						"status_info": schema.StringAttribute{
+							MarkdownDescription: "Detailed information about the operator status",
+							Computed:            true,
+						},
+						"status_updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the operator status was last updated",
+							Computed:            true,
+						},
+						"timeout_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Time in seconds the service waits for the operator to become available",
+							Computed:            true,
+						},
+					},
+				},
 			},
 			"feature_usage": schema.StringAttribute{
 				MarkdownDescription: "JSON-formatted string containing the usage information by feature name",
@@ -654,79 +807,109 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Map API response to data model
+	resp.Diagnostics.Append(d.updateModelFromCluster(ctx, &data, cluster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateModelFromCluster maps every cluster field the API returned into the
+// data source model. Fields the API left empty are null.
+func (d *ClusterDataSource) updateModelFromCluster(ctx context.Context, data *ClusterDataSourceModel, cluster *models.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Kind = types.StringValue(cluster.Kind)
+	data.Href = types.StringValue(cluster.Href)
+	data.Status = types.StringValue(cluster.Status)
+	data.StatusInfo = types.StringValue(cluster.StatusInfo)
+
+	// Core cluster info
 	data.Name = types.StringValue(cluster.Name)
-	data.BaseDNSDomain = types.StringValue(cluster.BaseDNSDomain)
+	data.UserName = stringValueOrNull(cluster.UserName)
+	data.OrgID = stringValueOrNull(cluster.OrgID)
+	data.EmailDomain = stringValueOrNull(cluster.EmailDomain)
 	data.OpenshiftVersion = types.StringValue(cluster.OpenshiftVersion)
+	data.OCPReleaseImage = stringValueOrNull(cluster.OCPReleaseImage)
+	data.OpenshiftClusterID = stringValueOrNull(cluster.OpenshiftClusterID)
+	data.BaseDNSDomain = types.StringValue(cluster.BaseDNSDomain)
 	data.CPUArchitecture = types.StringValue(cluster.CPUArchitecture)
-	data.Status = types.StringValue(cluster.Status)
-	data.StatusInfo = types.StringValue(cluster.StatusInfo)
-	data.Kind = types.StringValue(cluster.Kind)
 
-	// Handle platform - construct nested object
-	if cluster.Platform != nil && cluster.Platform.Type != "" {
-		platformObj, diag := types.ObjectValue(
-			map[string]attr.Type{
-				"type": types.StringType,
-			},
-			map[string]attr.Value{
-				"type": types.StringValue(cluster.Platform.Type),
-			},
-		)
-		resp.Diagnostics.Append(diag...)
-		data.Platform = platformObj
+	// Network configuration
+	data.ClusterNetworkCIDR = types.StringValue(cluster.ClusterNetworkCIDR)
+	data.ClusterNetworkHostPrefix = types.Int64Null()
+	if cluster.ClusterNetworkHostPrefix != 0 {
+		data.ClusterNetworkHostPrefix = types.Int64Value(int64(cluster.ClusterNetworkHostPrefix))
+	}
+	data.ServiceNetworkCIDR = types.StringValue(cluster.ServiceNetworkCIDR)
+	data.MachineNetworkCIDR = stringValueOrNull(cluster.MachineNetworkCIDR)
+	if cluster.MachineNetworkCIDR == "" && len(cluster.MachineNetworks) > 0 {
+		// Clusters created with the machine_networks list leave the legacy
+		// field empty, so report the primary machine network instead
+		data.MachineNetworkCIDR = types.StringValue(cluster.MachineNetworks[0].CIDR)
 	}
+	data.APIVipDNSName = stringValueOrNull(cluster.APIVipDNSName)
+	data.NetworkType = stringValueOrNull(cluster.NetworkType)
+	data.VipDhcpAllocation = types.BoolValue(cluster.VipDHCPAllocation)
+	data.UserManagedNetworking = types.BoolValue(cluster.UserManagedNetworking)
 
-	// Handle timestamps
-	// Note: InstallStartedAt and InstallCompletedAt are not in the basic cluster model
-	if !cluster.CreatedAt.IsZero() {
-		data.CreatedAt = types.StringValue(cluster.CreatedAt.String())
+	data.APIVips = nil
+	for _, vip := range cluster.APIVips {
+		data.APIVips = append(data.APIVips, clusterVipModel(data.ID, vip.IP, vip.ClusterID, vip.Verification))
 	}
-	if !cluster.UpdatedAt.IsZero() {
-		data.UpdatedAt = types.StringValue(cluster.UpdatedAt.String())
+	data.IngressVips = nil
+	for _, vip := range cluster.IngressVips {
+		data.IngressVips = append(data.IngressVips, clusterVipModel(data.ID, vip.IP, vip.ClusterID, vip.Verification))
 	}
 
-	// Handle API VIPs
-	if cluster.APIVips != nil {
-		var apiVips []ClusterAPIVipModel
-		for _, vip := range cluster.APIVips {
-			apiVips = append(apiVips, ClusterAPIVipModel{
-				IP:           types.StringValue(vip.IP),
-				ClusterID:    data.ID,               // Use the cluster ID from the data
-				Verification: types.StringValue(""), // Not available in basic cluster model
-			})
-		}
-		data.APIVips = apiVips
+	clusterNetworks := make([]attr.Value, 0, len(cluster.ClusterNetworks))
+	for _, network := range cluster.ClusterNetworks {
+		value, objDiags := types.ObjectValue(clusterDataSourceClusterNetworkAttrTypes, map[string]attr.Value{
+			"cidr":        types.StringValue(network.CIDR),
+			"host_prefix": types.Int64Value(int64(network.HostPrefix)),
+		})
+		diags.Append(objDiags...)
+		clusterNetworks = append(clusterNetworks, value)
 	}
+	data.ClusterNetworks = clusterDataSourceList(clusterDataSourceClusterNetworkAttrTypes, clusterNetworks, &diags)
 
-	// Handle Ingress VIPs
-	if cluster.IngressVips != nil {
-		var ingressVips []ClusterAPIVipModel
-		for _, vip := range cluster.IngressVips {
-			ingressVips = append(ingressVips, ClusterAPIVipModel{
-				IP:           types.StringValue(vip.IP),
-				ClusterID:    data.ID,               // Use the cluster ID from the data
-				Verification: types.StringValue(""), // Not available in basic cluster model
-			})
-		}
-		data.IngressVips = ingressVips
+	serviceNetworks := make([]attr.Value, 0, len(cluster.ServiceNetworks))
+	for _, network := range cluster.ServiceNetworks {
+		value, objDiags := types.ObjectValue(clusterDataSourceNetworkAttrTypes, map[string]attr.Value{
+			"cidr": types.StringValue(network.CIDR),
+		})
+		diags.Append(objDiags...)
+		serviceNetworks = append(serviceNetworks, value)
 	}
+	data.ServiceNetworks = clusterDataSourceList(clusterDataSourceNetworkAttrTypes, serviceNetworks, &diags)
 
-	// Handle network configuration
-	data.ClusterNetworkCIDR = types.StringValue(cluster.ClusterNetworkCIDR)
-	data.ServiceNetworkCIDR = types.StringValue(cluster.ServiceNetworkCIDR)
-	// MachineNetworkCIDR is not a direct field in the cluster model
-
-	// Handle host counts
-	data.HostsCount = types.Int64Value(int64(cluster.HostCount))
-	// ReadyHostsCount, MastersCount, WorkersCount are not available in basic cluster model
+	machineNetworks := make([]attr.Value, 0, len(cluster.MachineNetworks))
+	for _, network := range cluster.MachineNetworks {
+		value, objDiags := types.ObjectValue(clusterDataSourceNetworkAttrTypes, map[string]attr.Value{
+			"cidr": types.StringValue(network.CIDR),
+		})
+		diags.Append(objDiags...)
+		machineNetworks = append(machineNetworks, value)
+	}
+	data.MachineNetworks = clusterDataSourceList(clusterDataSourceNetworkAttrTypes, machineNetworks, &diags)
 
-	// Handle boolean flags
-	data.UserManagedNetworking = types.BoolValue(cluster.UserManagedNetworking)
-	data.VipDhcpAllocation = types.BoolValue(cluster.VipDHCPAllocation)
-	data.SchedulableMasters = types.BoolValue(cluster.SchedulableMasters)
+	hostNetworks := make([]attr.Value, 0, len(cluster.HostNetworks))
+	for _, network := range cluster.HostNetworks {
+		hostIDs, objDiags := types.ListValueFrom(ctx, types.StringType, append([]string{}, network.HostIDs...))
+		diags.Append(objDiags...)
+		value, objDiags := types.ObjectValue(clusterDataSourceHostNetworkAttrTypes, map[string]attr.Value{
+			"cidr":     types.StringValue(network.CIDR),
+			"host_ids": hostIDs,
+		})
+		diags.Append(objDiags...)
+		hostNetworks = append(hostNetworks, value)
+	}
+	data.HostNetworks = clusterDataSourceList(clusterDataSourceHostNetworkAttrTypes, hostNetworks, &diags)
 
-	// Control plane count (fallback to high availability mode if needed)
+	// Host configuration
+	data.ControlPlaneCount = types.Int64Null()
 	if cluster.ControlPlaneCount > 0 {
 		data.ControlPlaneCount = types.Int64Value(int64(cluster.ControlPlaneCount))
 	} else if cluster.HighAvailabilityMode != "" {
@@ -737,10 +920,246 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			data.ControlPlaneCount = types.Int64Value(1)
 		}
 	}
+	data.HighAvailabilityMode = stringValueOrNull(cluster.HighAvailabilityMode)
+	data.SchedulableMasters = types.BoolValue(cluster.SchedulableMasters)
+	data.SchedulableMastersForced = types.BoolValue(cluster.SchedulableMastersForcedTrue)
 
-	// Handle href
-	data.Href = types.StringValue(cluster.Href)
+	// Host counts, with the legacy names kept as aliases
+	data.TotalHostCount = types.Int64Value(int64(cluster.HostCount))
+	data.ReadyHostCount = types.Int64Value(int64(cluster.ReadyHostCount))
+	data.EnabledHostCount = types.Int64Value(int64(cluster.EnabledHostCount))
+	data.HostsCount = data.TotalHostCount
+	data.ReadyHostsCount = data.ReadyHostCount
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	var masters, workers int64
+	for i := range cluster.Hosts {
+		switch effectiveHostRole(&cluster.Hosts[i]).ValueString() {
+		case "master":
+			masters++
+		case "worker":
+			workers++
+		}
+	}
+	data.MastersCount = types.Int64Value(masters)
+	data.WorkersCount = types.Int64Value(workers)
+
+	// Security & Access
+	data.SSHPublicKey = stringValueOrNull(cluster.SSHPublicKey)
+	data.PullSecretSet = types.BoolValue(cluster.PullSecretSet)
+
+	// Proxy configuration
+	data.HTTPProxy = stringValueOrNull(cluster.HTTPProxy)
+	data.HTTPSProxy = stringValueOrNull(cluster.HTTPSProxy)
+	data.NoProxy = stringValueOrNull(cluster.NoProxy)
+
+	// Timestamps
+	data.StatusUpdatedAt = timestampValue(cluster.StatusUpdatedAt)
+	data.InstallStartedAt = timestampValue(cluster.InstallStartedAt)
+	data.InstallCompletedAt = timestampValue(cluster.InstallCompletedAt)
+	data.InstallationStartedAt = data.InstallStartedAt
+	data.InstallationCompletedAt = data.InstallCompletedAt
+	data.CreatedAt = timestampValue(cluster.CreatedAt)
+	data.UpdatedAt = timestampValue(cluster.UpdatedAt)
+
+	// Progress and validation
+	data.Progress = types.ObjectNull(clusterDataSourceProgressAttrTypes)
+	if cluster.Progress != nil {
+		var objDiags diag.Diagnostics
+		data.Progress, objDiags = types.ObjectValue(clusterDataSourceProgressAttrTypes, map[string]attr.Value{
+			"current_stage":           stringValueOrNull(cluster.Progress.FinalizingStage),
+			"installation_percentage": types.Int64Value(cluster.Progress.TotalPercentage),
+			"stage_started_at":        stringValueOrNull(cluster.Progress.FinalizingStageStartedAt),
+			"stage_updated_at":        types.StringNull(), // Not reported by the API
+		})
+		diags.Append(objDiags...)
+	}
+	data.ValidationsInfo = stringValueOrNull(cluster.ValidationsInfo)
+
+	// Logs
+	data.LogsInfo = types.ObjectNull(clusterDataSourceLogsInfoAttrTypes)
+	if cluster.LogsInfo != "" {
+		var objDiags diag.Diagnostics
+		data.LogsInfo, objDiags = types.ObjectValue(clusterDataSourceLogsInfoAttrTypes, map[string]attr.Value{
+			"state":      types.StringValue(cluster.LogsInfo),
+			"state_info": types.StringNull(),
+		})
+		diags.Append(objDiags...)
+	}
+	data.ControllerLogsCollectedAt = timestampValue(cluster.ControllerLogsCollectedAt)
+	data.ControllerLogsStartedAt = timestampValue(cluster.ControllerLogsStartedAt)
+
+	// Advanced configuration
+	data.InstallConfigOverrides = stringValueOrNull(cluster.InstallConfigOverrides)
+	data.AdditionalNTPSource = stringValueOrNull(cluster.AdditionalNTPSource)
+	data.Hyperthreading = stringValueOrNull(cluster.Hyperthreading)
+	data.DiskEncryption = types.ObjectNull(clusterDataSourceDiskEncryptionAttrTypes)
+	if cluster.DiskEncryption != nil {
+		var objDiags diag.Diagnostics
+		data.DiskEncryption, objDiags = types.ObjectValue(clusterDataSourceDiskEncryptionAttrTypes, map[string]attr.Value{
+			"enable_on": stringValueOrNull(cluster.DiskEncryption.EnableOn),
+			"mode":      stringValueOrNull(cluster.DiskEncryption.Mode),
+		})
+		diags.Append(objDiags...)
+	}
+
+	// System objects
+	data.Platform = types.ObjectNull(clusterDataSourceTypeAttrTypes)
+	if cluster.Platform != nil && cluster.Platform.Type != "" {
+		var objDiags diag.Diagnostics
+		data.Platform, objDiags = types.ObjectValue(clusterDataSourceTypeAttrTypes, map[string]attr.Value{
+			"type": types.StringValue(cluster.Platform.Type),
+		})
+		diags.Append(objDiags...)
+	}
+	data.LoadBalancer = types.ObjectNull(clusterDataSourceTypeAttrTypes)
+	if cluster.LoadBalancer != nil && cluster.LoadBalancer.Type != "" {
+		var objDiags diag.Diagnostics
+		data.LoadBalancer, objDiags = types.ObjectValue(clusterDataSourceTypeAttrTypes, map[string]attr.Value{
+			"type": types.StringValue(cluster.LoadBalancer.Type),
+		})
+		diags.Append(objDiags...)
+	}
+	data.ImageInfo = types.ObjectNull(clusterDataSourceImageInfoAttrTypes)
+	if cluster.ImageInfo != nil {
+		var objDiags diag.Diagnostics
+		data.ImageInfo, objDiags = types.ObjectValue(clusterDataSourceImageInfoAttrTypes, map[string]attr.Value{
+			"created_at":   stringValueOrNull(cluster.ImageInfo.CreatedAt),
+			"expires_at":   stringValueOrNull(cluster.ImageInfo.ExpiresAt),
+			"download_url": stringValueOrNull(cluster.ImageInfo.DownloadURL),
+			"size_bytes":   types.Int64Value(cluster.ImageInfo.SizeBytes),
+		})
+		diags.Append(objDiags...)
+	}
+	data.IgnitionEndpoint = types.ObjectNull(clusterDataSourceIgnitionEndpointAttrTypes)
+	if cluster.IgnitionEndpoint != nil {
+		var objDiags diag.Diagnostics
+		data.IgnitionEndpoint, objDiags = types.ObjectValue(clusterDataSourceIgnitionEndpointAttrTypes, map[string]attr.Value{
+			"url":            stringValueOrNull(cluster.IgnitionEndpoint.URL),
+			"ca_certificate": stringValueOrNull(cluster.IgnitionEndpoint.CACertificate),
+		})
+		diags.Append(objDiags...)
+	}
+
+	// Connectivity and validation overrides
+	data.ConnectivityMajorityGroups = stringValueOrNull(cluster.ConnectivityMajorityGroups)
+	data.ConnectivityGroups = clusterConnectivityGroups(ctx, cluster, &diags)
+	data.IPCollisions = stringValueOrNull(cluster.IPCollisions)
+	data.IgnoredHostValidations = stringValueOrNull(cluster.IgnoredHostValidations)
+	data.IgnoredClusterValidations = stringValueOrNull(cluster.IgnoredClusterValidations)
+
+	// Operators and features
+	data.MonitoredOperators = nil
+	if cluster.MonitoredOperators != nil {
+		operators := make([]ClusterMonitoredOperatorModel, 0, len(cluster.MonitoredOperators))
+		for _, op := range cluster.MonitoredOperators {
+			operators = append(operators, ClusterMonitoredOperatorModel{
+				Name:            types.StringValue(op.Name),
+				Version:         stringValueOrNull(op.Version),
+				Status:          stringValueOrNull(op.Status),
+				StatusInfo:      stringValueOrNull(op.StatusInfo),
+				StatusUpdatedAt: stringValueOrNull(op.StatusUpdatedAt),
+				TimeoutSeconds:  types.Int64Value(op.TimeoutSeconds),
+			})
+		}
+		data.MonitoredOperators = operators
+	}
+	data.FeatureUsage = stringValueOrNull(cluster.FeatureUsage)
+	data.AMSSubscriptionID = stringValueOrNull(cluster.AMSSubscriptionID)
+
+	// Day-2 and import
+	data.Imported = types.BoolValue(cluster.Imported)
+	data.Tags = stringValueOrNull(cluster.Tags)
+	data.OrgSoftTimeoutsEnabled = types.BoolValue(cluster.OrgSoftTimeoutsEnabled)
+	data.LastInstallationPreparation = types.ObjectNull(clusterDataSourceLastPreparationAttrTypes)
+	if cluster.LastInstallationPreparation != nil {
+		var objDiags diag.Diagnostics
+		data.LastInstallationPreparation, objDiags = types.ObjectValue(clusterDataSourceLastPreparationAttrTypes, map[string]attr.Value{
+			"reason": stringValueOrNull(cluster.LastInstallationPreparation.Reason),
+			"status": stringValueOrNull(cluster.LastInstallationPreparation.Status),
+		})
+		diags.Append(objDiags...)
+	}
+
+	return diags
+}
+
+// clusterConnectivityGroups lists the majority connectivity group of each
+// network with the hosts outside it. Hosts are outside the group of a machine
+// network when they have an address in it, and outside the IPv4/IPv6 groups
+// when they belong to the cluster.
+func clusterConnectivityGroups(ctx context.Context, cluster *models.Cluster, diags *diag.Diagnostics) types.List {
+	groups, err := cluster.ParseConnectivityMajorityGroups()
+	if err != nil {
+		diags.AddWarning(
+			"Invalid Connectivity Majority Groups",
+			fmt.Sprintf("Unable to parse the connectivity majority groups of cluster %s, \"connectivity_groups\" is left empty: %s", cluster.ID, err),
+		)
+	}
+
+	networkHosts := make(map[string][]string, len(cluster.HostNetworks))
+	for _, network := range cluster.HostNetworks {
+		networkHosts[network.CIDR] = network.HostIDs
+	}
+	var clusterHosts []string
+	for _, host := range cluster.Hosts {
+		clusterHosts = append(clusterHosts, host.ID)
+	}
+
+	networks := slices.Sorted(maps.Keys(groups))
+	elements := make([]attr.Value, 0, len(networks))
+	for _, network := range networks {
+		candidates := networkHosts[network]
+		if network == "IPv4" || network == "IPv6" {
+			candidates = clusterHosts
+		}
+
+		var excluded []string
+		for _, hostID := range candidates {
+			if !slices.Contains(groups[network], hostID) {
+				excluded = append(excluded, hostID)
+			}
+		}
+		slices.Sort(excluded)
+
+		hostIDs, objDiags := types.ListValueFrom(ctx, types.StringType, append([]string{}, groups[network]...))
+		diags.Append(objDiags...)
+		excludedIDs, objDiags := types.ListValueFrom(ctx, types.StringType, append([]string{}, excluded...))
+		diags.Append(objDiags...)
+		value, objDiags := types.ObjectValue(clusterDataSourceConnectivityGroupAttrTypes, map[string]attr.Value{
+			"network":           types.StringValue(network),
+			"host_ids":          hostIDs,
+			"excluded_host_ids": excludedIDs,
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, value)
+	}
+
+	return clusterDataSourceList(clusterDataSourceConnectivityGroupAttrTypes, elements, diags)
+}
+
+// clusterVipModel converts an API or ingress VIP, which belongs to the cluster
+// being read when the API does not say otherwise
+func clusterVipModel(clusterID types.String, ip, vipClusterID, verification string) ClusterAPIVipModel {
+	vip := ClusterAPIVipModel{
+		IP:           types.StringValue(ip),
+		ClusterID:    clusterID,
+		Verification: stringValueOrNull(verification),
+	}
+	if vipClusterID != "" {
+		vip.ClusterID = types.StringValue(vipClusterID)
+	}
+	return vip
+}
+
+// clusterDataSourceList builds a list of nested objects, null when the API
+// returned none
+func clusterDataSourceList(attrTypes map[string]attr.Type, elements []attr.Value, diags *diag.Diagnostics) types.List {
+	elemType := types.ObjectType{AttrTypes: attrTypes}
+	if len(elements) == 0 {
+		return types.ListNull(elemType)
+	}
+
+	list, objDiags := types.ListValue(elemType, elements)
+	diags.Append(objDiags...)
+	return list
 }
@@ -2,11 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClusterDataSource_Schema(t *testing.T) {
@@ -85,3 +94,456 @@ func TestClusterDataSource_ConfigureError(t *testing.T) {
 	assert.True(t, configResp.Diagnostics.HasError())
 	assert.Nil(t, ds.client)
 }
+
+func TestClusterDataSource_Read_MonitoredOperators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{
+			ID:     "test-cluster-id",
+			Name:   "test-cluster",
+			Status: "finalizing",
+			MonitoredOperators: []models.MonitoredOperator{
+				{Name: "console", Version: "4.16.0", Status: "available", StatusInfo: "All is well", StatusUpdatedAt: "2024-01-01T10:00:00Z", TimeoutSeconds: 3600},
+				{Name: "cvo", Status: "progressing", StatusInfo: "Working towards 4.16.0", TimeoutSeconds: 3600},
+				{Name: "lvms", Status: "failed", StatusInfo: "Operator degraded", TimeoutSeconds: 1800},
+				{Name: "odf"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	ds := &ClusterDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state ClusterDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	require.Len(t, state.MonitoredOperators, 4)
+
+	console := state.MonitoredOperators[0]
+	assert.Equal(t, "console", console.Name.ValueString())
+	assert.Equal(t, "4.16.0", console.Version.ValueString())
+	assert.Equal(t, "available", console.Status.ValueString())
+	assert.Equal(t, "All is well", console.StatusInfo.ValueString())
+	assert.Equal(t, "2024-01-01T10:00:00Z", console.StatusUpdatedAt.ValueString())
+	assert.Equal(t, int64(3600), console.TimeoutSeconds.ValueInt64())
+
+	assert.Equal(t, "progressing", state.MonitoredOperators[1].Status.ValueString())
+	assert.True(t, state.MonitoredOperators[1].Version.IsNull())
+
+	assert.Equal(t, "failed", state.MonitoredOperators[2].Status.ValueString())
+	assert.Equal(t, "Operator degraded", state.MonitoredOperators[2].StatusInfo.ValueString())
+	assert.Equal(t, int64(1800), state.MonitoredOperators[2].TimeoutSeconds.ValueInt64())
+
+	// An operator the service has not reported on yet has no status
+	assert.True(t, state.MonitoredOperators[3].Status.IsNull())
+	assert.True(t, state.MonitoredOperators[3].StatusInfo.IsNull())
+}
+
+// richClusterJSON is a cluster as returned by the API during installation
+const richClusterJSON = `{
+  "kind": "Cluster",
+  "id": "test-cluster-id",
+  "href": "/api/assisted-install/v2/clusters/test-cluster-id",
+  "name": "prod",
+  "user_name": "jdoe",
+  "org_id": "1234567",
+  "email_domain": "example.com",
+  "openshift_version": "4.16.3",
+  "ocp_release_image": "quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64",
+  "openshift_cluster_id": "5a3f6a4e-9c0d-4d5b-8f7e-2b1c3d4e5f60",
+  "base_dns_domain": "example.com",
+  "cpu_architecture": "x86_64",
+  "cluster_network_cidr": "10.128.0.0/14",
+  "cluster_network_host_prefix": 23,
+  "service_network_cidr": "172.30.0.0/16",
+  "machine_network_cidr": "192.168.122.0/24",
+  "cluster_networks": [{"cluster_id": "test-cluster-id", "cidr": "10.128.0.0/14", "host_prefix": 23}],
+  "service_networks": [{"cluster_id": "test-cluster-id", "cidr": "172.30.0.0/16"}],
+  "machine_networks": [{"cluster_id": "test-cluster-id", "cidr": "192.168.122.0/24"}],
+  "api_vips": [{"cluster_id": "test-cluster-id", "ip": "192.168.122.100", "verification": "succeeded"}],
+  "ingress_vips": [{"cluster_id": "test-cluster-id", "ip": "192.168.122.101", "verification": "unverified"}],
+  "api_vip_dns_name": "api.prod.example.com",
+  "network_type": "OVNKubernetes",
+  "vip_dhcp_allocation": false,
+  "user_managed_networking": false,
+  "ssh_public_key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAI jdoe@example.com",
+  "pull_secret_set": true,
+  "http_proxy": "http://proxy.example.com:3128",
+  "https_proxy": "http://proxy.example.com:3128",
+  "no_proxy": ".example.com",
+  "status": "installing",
+  "status_info": "Installation in progress",
+  "status_updated_at": "2024-05-01T10:30:00Z",
+  "progress": {
+    "total_percentage": 42,
+    "preparing_for_installation_stage_percentage": 100,
+    "installing_stage_percentage": 60,
+    "finalizing_stage_percentage": 0,
+    "finalizing_stage": "Waiting for cluster operators",
+    "finalizing_stage_started_at": "2024-05-01T10:25:00Z"
+  },
+  "validations_info": "{\"network\":[{\"id\":\"api-vips-defined\",\"status\":\"success\"}]}",
+  "logs_info": "collecting",
+  "controller_logs_started_at": "2024-05-01T10:20:00Z",
+  "controller_logs_collected_at": "0001-01-01T00:00:00.000Z",
+  "install_config_overrides": "{\"fips\":true}",
+  "additional_ntp_source": "clock.example.com",
+  "hyperthreading": "all",
+  "disk_encryption": {"enable_on": "masters", "mode": "tpmv2"},
+  "platform": {"type": "baremetal"},
+  "load_balancer": {"type": "cluster-managed"},
+  "image_info": {"created_at": "2024-05-01T09:00:00Z", "expires_at": "2024-05-01T13:00:00Z", "download_url": "https://example.com/discovery.iso", "size_bytes": 1073741824},
+  "ignition_endpoint": {"url": "https://ignition.example.com", "ca_certificate": "LS0tLS1CRUdJTg=="},
+  "connectivity_majority_groups": "{\"192.168.122.0/24\":[]}",
+  "ip_collisions": "{}",
+  "ignored_host_validations": "[\"ntp-synced\"]",
+  "ignored_cluster_validations": "[]",
+  "host_networks": [{"cidr": "192.168.122.0/24", "host_ids": ["host-1", "host-2", "host-3", "host-4"]}],
+  "control_plane_count": 3,
+  "high_availability_mode": "Full",
+  "schedulable_masters": false,
+  "schedulable_masters_forced_true": false,
+  "total_host_count": 4,
+  "ready_host_count": 3,
+  "enabled_host_count": 4,
+  "hosts": [
+    {"id": "host-1", "role": "master"},
+    {"id": "host-2", "role": "master"},
+    {"id": "host-3", "role": "auto-assign", "suggested_role": "master"},
+    {"id": "host-4", "role": "worker"}
+  ],
+  "monitored_operators": [{"cluster_id": "test-cluster-id", "name": "console", "status": "progressing", "timeout_seconds": 3600}],
+  "feature_usage": "{\"OVN network type\":{\"id\":\"OVN_NETWORK_TYPE\",\"name\":\"OVN network type\"}}",
+  "ams_subscription_id": "2aBcDeFgHiJkLmNoPqRsTuVwXyZ",
+  "imported": false,
+  "tags": "prod,team-a",
+  "last-installation-preparation": {"status": "success"},
+  "org_soft_timeouts_enabled": true,
+  "install_started_at": "2024-05-01T10:00:00Z",
+  "install_completed_at": "0001-01-01T00:00:00.000Z",
+  "created_at": "2024-05-01T08:00:00Z",
+  "updated_at": "2024-05-01T10:30:00Z"
+}`
+
+func TestClusterDataSource_Read_AllFields(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(richClusterJSON))
+	}))
+	defer server.Close()
+
+	ds := &ClusterDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state ClusterDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	// Core cluster info
+	assert.Equal(t, "jdoe", state.UserName.ValueString())
+	assert.Equal(t, "1234567", state.OrgID.ValueString())
+	assert.Equal(t, "example.com", state.EmailDomain.ValueString())
+	assert.Equal(t, "quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64", state.OCPReleaseImage.ValueString())
+	assert.Equal(t, "5a3f6a4e-9c0d-4d5b-8f7e-2b1c3d4e5f60", state.OpenshiftClusterID.ValueString())
+
+	// Networking
+	assert.Equal(t, int64(23), state.ClusterNetworkHostPrefix.ValueInt64())
+	assert.Equal(t, "192.168.122.0/24", state.MachineNetworkCIDR.ValueString())
+	assert.Equal(t, "api.prod.example.com", state.APIVipDNSName.ValueString())
+	assert.Equal(t, "OVNKubernetes", state.NetworkType.ValueString())
+	require.Len(t, state.APIVips, 1)
+	assert.Equal(t, "192.168.122.100", state.APIVips[0].IP.ValueString())
+	assert.Equal(t, "succeeded", state.APIVips[0].Verification.ValueString())
+	require.Len(t, state.IngressVips, 1)
+	assert.Equal(t, "unverified", state.IngressVips[0].Verification.ValueString())
+	assert.Equal(t, `[{"cidr":"10.128.0.0/14","host_prefix":23}]`, valueJSON(t, state.ClusterNetworks))
+	assert.Equal(t, `[{"cidr":"172.30.0.0/16"}]`, valueJSON(t, state.ServiceNetworks))
+	assert.Equal(t, `[{"cidr":"192.168.122.0/24"}]`, valueJSON(t, state.MachineNetworks))
+	assert.Equal(t, `[{"cidr":"192.168.122.0/24","host_ids":["host-1","host-2","host-3","host-4"]}]`, valueJSON(t, state.HostNetworks))
+
+	// Hosts
+	assert.Equal(t, "Full", state.HighAvailabilityMode.ValueString())
+	assert.False(t, state.SchedulableMastersForced.ValueBool())
+	assert.Equal(t, int64(4), state.TotalHostCount.ValueInt64())
+	assert.Equal(t, int64(3), state.ReadyHostCount.ValueInt64())
+	assert.Equal(t, int64(4), state.EnabledHostCount.ValueInt64())
+	assert.Equal(t, int64(4), state.HostsCount.ValueInt64())
+	assert.Equal(t, int64(3), state.ReadyHostsCount.ValueInt64())
+	assert.Equal(t, int64(3), state.MastersCount.ValueInt64())
+	assert.Equal(t, int64(1), state.WorkersCount.ValueInt64())
+
+	// Access and proxy
+	assert.True(t, state.PullSecretSet.ValueBool())
+	assert.Equal(t, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAI jdoe@example.com", state.SSHPublicKey.ValueString())
+	assert.Equal(t, "http://proxy.example.com:3128", state.HTTPProxy.ValueString())
+	assert.Equal(t, "http://proxy.example.com:3128", state.HTTPSProxy.ValueString())
+	assert.Equal(t, ".example.com", state.NoProxy.ValueString())
+
+	// Timestamps
+	assert.Equal(t, "2024-05-01T10:30:00Z", state.StatusUpdatedAt.ValueString())
+	assert.Equal(t, "2024-05-01T10:00:00Z", state.InstallStartedAt.ValueString())
+	assert.Equal(t, "2024-05-01T10:00:00Z", state.InstallationStartedAt.ValueString())
+	assert.True(t, state.InstallCompletedAt.IsNull())
+	assert.True(t, state.InstallationCompletedAt.IsNull())
+	assert.Equal(t, "2024-05-01T08:00:00Z", state.CreatedAt.ValueString())
+	assert.Equal(t, "2024-05-01T10:20:00Z", state.ControllerLogsStartedAt.ValueString())
+	assert.True(t, state.ControllerLogsCollectedAt.IsNull())
+
+	// Nested objects
+	assert.Equal(t, `{"current_stage":"Waiting for cluster operators","installation_percentage":42,"stage_started_at":"2024-05-01T10:25:00Z","stage_updated_at":null}`, valueJSON(t, state.Progress))
+	assert.Equal(t, `{"state":"collecting","state_info":null}`, valueJSON(t, state.LogsInfo))
+	assert.Equal(t, `{"created_at":"2024-05-01T09:00:00Z","download_url":"https://example.com/discovery.iso","expires_at":"2024-05-01T13:00:00Z","size_bytes":1073741824}`, valueJSON(t, state.ImageInfo))
+	assert.Equal(t, `{"enable_on":"masters","mode":"tpmv2"}`, valueJSON(t, state.DiskEncryption))
+	assert.Equal(t, `{"type":"baremetal"}`, valueJSON(t, state.Platform))
+	assert.Equal(t, `{"type":"cluster-managed"}`, valueJSON(t, state.LoadBalancer))
+	assert.Equal(t, `{"ca_certificate":"LS0tLS1CRUdJTg==","url":"https://ignition.example.com"}`, valueJSON(t, state.IgnitionEndpoint))
+	assert.Equal(t, `{"reason":null,"status":"success"}`, valueJSON(t, state.LastInstallationPreparation))
+
+	// JSON strings and the rest
+	assert.Equal(t, `{"network":[{"id":"api-vips-defined","status":"success"}]}`, state.ValidationsInfo.ValueString())
+	assert.Equal(t, `{"fips":true}`, state.InstallConfigOverrides.ValueString())
+	assert.Equal(t, "clock.example.com", state.AdditionalNTPSource.ValueString())
+	assert.Equal(t, "all", state.Hyperthreading.ValueString())
+	assert.Equal(t, `{"192.168.122.0/24":[]}`, state.ConnectivityMajorityGroups.ValueString())
+	assert.Equal(t, "{}", state.IPCollisions.ValueString())
+	assert.Equal(t, `["ntp-synced"]`, state.IgnoredHostValidations.ValueString())
+	assert.Equal(t, "[]", state.IgnoredClusterValidations.ValueString())
+	assert.Contains(t, state.FeatureUsage.ValueString(), "OVN_NETWORK_TYPE")
+	assert.Equal(t, "2aBcDeFgHiJkLmNoPqRsTuVwXyZ", state.AMSSubscriptionID.ValueString())
+	assert.False(t, state.Imported.ValueBool())
+	assert.Equal(t, "prod,team-a", state.Tags.ValueString())
+	assert.True(t, state.OrgSoftTimeoutsEnabled.ValueBool())
+	require.Len(t, state.MonitoredOperators, 1)
+	assert.Equal(t, "progressing", state.MonitoredOperators[0].Status.ValueString())
+}
+
+func TestClusterDataSource_Read_MinimalCluster(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"Cluster","id":"test-cluster-id","name":"new","openshift_version":"4.16","status":"insufficient","status_info":"","href":""}`))
+	}))
+	defer server.Close()
+
+	ds := &ClusterDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state ClusterDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	// Fields the API did not return are null rather than empty
+	assert.True(t, state.OrgID.IsNull())
+	assert.True(t, state.Progress.IsNull())
+	assert.True(t, state.ImageInfo.IsNull())
+	assert.True(t, state.LogsInfo.IsNull())
+	assert.True(t, state.HostNetworks.IsNull())
+	assert.True(t, state.ClusterNetworks.IsNull())
+	assert.True(t, state.CreatedAt.IsNull())
+	assert.Equal(t, int64(0), state.MastersCount.ValueInt64())
+}
+
+func TestClusterDataSource_Read_MachineNetworks(t *testing.T) {
+	tests := []struct {
+		name         string
+		cluster      string
+		expectedCIDR string
+		expectedList string
+	}{
+		{
+			name:         "legacy field and list",
+			cluster:      `{"id":"test-cluster-id","machine_network_cidr":"192.168.122.0/24","machine_networks":[{"cidr":"192.168.122.0/24"}]}`,
+			expectedCIDR: "192.168.122.0/24",
+			expectedList: `[{"cidr":"192.168.122.0/24"}]`,
+		},
+		{
+			name:         "list only",
+			cluster:      `{"id":"test-cluster-id","machine_networks":[{"cluster_id":"test-cluster-id","cidr":"10.0.0.0/16"},{"cluster_id":"test-cluster-id","cidr":"fd2e:6f44:5dd8::/64"}]}`,
+			expectedCIDR: "10.0.0.0/16",
+			expectedList: `[{"cidr":"10.0.0.0/16"},{"cidr":"fd2e:6f44:5dd8::/64"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.cluster))
+			}))
+			defer server.Close()
+
+			ds := &ClusterDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			})
+			ds.Read(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+			var state ClusterDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+			assert.Equal(t, tt.expectedCIDR, state.MachineNetworkCIDR.ValueString())
+			assert.Equal(t, tt.expectedList, valueJSON(t, state.MachineNetworks))
+		})
+	}
+}
+
+func TestClusterDataSource_Read_ConnectivityGroups(t *testing.T) {
+	tests := []struct {
+		name          string
+		groups        string
+		expected      string
+		expectWarning bool
+	}{
+		{
+			name:   "majority groups",
+			groups: `{\"192.168.122.0/24\":[\"host-1\",\"host-2\"],\"IPv4\":[\"host-1\",\"host-2\",\"host-3\"]}`,
+			expected: `[{"excluded_host_ids":["host-3"],"host_ids":["host-1","host-2"],"network":"192.168.122.0/24"},` +
+				`{"excluded_host_ids":["host-4"],"host_ids":["host-1","host-2","host-3"],"network":"IPv4"}]`,
+		},
+		{
+			name:     "not reported",
+			expected: "null",
+		},
+		{
+			name:          "invalid",
+			groups:        "not json",
+			expected:      "null",
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{
+  "id": "test-cluster-id",
+  "connectivity_majority_groups": "%s",
+  "host_networks": [{"cidr": "192.168.122.0/24", "host_ids": ["host-1", "host-2", "host-3"]}],
+  "hosts": [{"id": "host-1"}, {"id": "host-2"}, {"id": "host-3"}, {"id": "host-4"}]
+}`, tt.groups)
+			}))
+			defer server.Close()
+
+			ds := &ClusterDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			})
+			ds.Read(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() == 1)
+
+			var state ClusterDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+			assert.Equal(t, tt.expected, valueJSON(t, state.ConnectivityGroups))
+		})
+	}
+}
+
+// valueJSON renders a Terraform object or list as JSON with sorted keys, so
+// nested values can be compared in one assertion
+func valueJSON(t *testing.T, value attr.Value) string {
+	t.Helper()
+
+	raw, err := value.ToTerraformValue(context.Background())
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(terraformValueToGo(t, raw))
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+func terraformValueToGo(t *testing.T, value tftypes.Value) any {
+	t.Helper()
+
+	if value.IsNull() {
+		return nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		require.NoError(t, value.As(&s))
+		return s
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		require.NoError(t, value.As(&b))
+		return b
+	case value.Type().Is(tftypes.Number):
+		var n big.Float
+		require.NoError(t, value.As(&n))
+		i, _ := n.Int64()
+		return i
+	case value.Type().Is(tftypes.List{}):
+		var elements []tftypes.Value
+		require.NoError(t, value.As(&elements))
+		result := make([]any, 0, len(elements))
+		for _, element := range elements {
+			result = append(result, terraformValueToGo(t, element))
+		}
+		return result
+	default:
+		var attributes map[string]tftypes.Value
+		require.NoError(t, value.As(&attributes))
+		result := make(map[string]any, len(attributes))
+		for name, attribute := range attributes {
+			result[name] = terraformValueToGo(t, attribute)
+		}
+		return result
+	}
+}
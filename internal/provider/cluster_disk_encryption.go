@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// diskEncryptionModeTang is the disk encryption mode that binds the disks to
+// Tang servers
+const diskEncryptionModeTang = "tang"
+
+// TangServerModel describes an entry of disk_encryption.tang_server_list
+type TangServerModel struct {
+	URL        types.String `tfsdk:"url"`
+	Thumbprint types.String `tfsdk:"thumbprint"`
+}
+
+// tangServer is a Tang server in the JSON array the API expects in
+// disk_encryption.tang_servers
+type tangServer struct {
+	URL        string `json:"url"`
+	Thumbprint string `json:"thumbprint"`
+}
+
+// diskEncryptionBlock returns the configured disk_encryption block,
+// or false when it is unset or not known yet
+func diskEncryptionBlock(data ClusterResourceModel) (DiskEncryptionModel, bool) {
+	var model DiskEncryptionModel
+	if data.DiskEncryption.IsNull() || data.DiskEncryption.IsUnknown() {
+		return model, false
+	}
+	if diags := data.DiskEncryption.As(context.Background(), &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return model, false
+	}
+	return model, true
+}
+
+// tangServersFromList converts tang_server_list. It returns false when the
+// list or one of its values is not known yet.
+func tangServersFromList(list types.List) ([]tangServer, bool) {
+	if list.IsNull() {
+		return nil, true
+	}
+	if list.IsUnknown() {
+		return nil, false
+	}
+
+	var entries []TangServerModel
+	if diags := list.ElementsAs(context.Background(), &entries, false); diags.HasError() {
+		return nil, false
+	}
+
+	servers := make([]tangServer, len(entries))
+	for i, entry := range entries {
+		if entry.URL.IsUnknown() || entry.Thumbprint.IsUnknown() {
+			return nil, false
+		}
+		servers[i] = tangServer{URL: entry.URL.ValueString(), Thumbprint: entry.Thumbprint.ValueString()}
+	}
+	return servers, true
+}
+
+// tangServersJSON marshals Tang servers into the JSON array the API expects
+func tangServersJSON(servers []tangServer) (string, error) {
+	raw, err := json.Marshal(servers)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// diskEncryptionFromModel converts the disk_encryption block to the API model.
+// tang_server_list is sent as the JSON array the API expects, and the
+// tang_servers JSON string is only used when the list is empty.
+func (r *ClusterResource) diskEncryptionFromModel(data ClusterResourceModel) *models.DiskEncryption {
+	model, ok := diskEncryptionBlock(data)
+	if !ok {
+		return nil
+	}
+
+	result := &models.DiskEncryption{
+		EnableOn: model.EnableOn.ValueString(),
+		Mode:     model.Mode.ValueString(),
+	}
+
+	if servers, ok := tangServersFromList(model.TangServerList); ok && len(servers) > 0 {
+		if tangServers, err := tangServersJSON(servers); err == nil {
+			result.TangServers = tangServers
+		}
+	} else if !model.TangServers.IsNull() {
+		result.TangServers = model.TangServers.ValueString()
+	}
+
+	return result
+}
+
+// validateDiskEncryption checks the Tang servers when the mode is tang: at
+// least one must be configured, either in tang_server_list or in the
+// tang_servers JSON string, and each needs an http(s) URL and a thumbprint.
+// The Assisted Service would otherwise only fail once the hosts boot.
+func (r *ClusterResource) validateDiskEncryption(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	model, ok := diskEncryptionBlock(data)
+	if !ok {
+		return
+	}
+
+	listPath := path.Root("disk_encryption").AtName("tang_server_list")
+	stringPath := path.Root("disk_encryption").AtName("tang_servers")
+
+	listServers, listKnown := tangServersFromList(model.TangServerList)
+	if len(listServers) > 0 && !model.TangServers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			stringPath,
+			"Conflicting Tang Servers",
+			"\"disk_encryption.tang_servers\" cannot be set together with \"disk_encryption.tang_server_list\". Move the servers into \"tang_server_list\".",
+		)
+		return
+	}
+
+	if model.Mode.IsUnknown() || model.Mode.ValueString() != diskEncryptionModeTang || !listKnown {
+		return
+	}
+
+	if len(listServers) > 0 {
+		for i, server := range listServers {
+			checkTangServer(server, listPath.AtListIndex(i).AtName("url"), listPath.AtListIndex(i).AtName("thumbprint"), resp)
+		}
+		return
+	}
+
+	if model.TangServers.IsUnknown() {
+		return
+	}
+	if model.TangServers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			listPath,
+			"Missing Tang Servers",
+			"Disk encryption mode \"tang\" requires at least one Tang server in \"disk_encryption.tang_server_list\".",
+		)
+		return
+	}
+
+	var servers []tangServer
+	if err := json.Unmarshal([]byte(model.TangServers.ValueString()), &servers); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			stringPath,
+			"Invalid Tang Servers",
+			fmt.Sprintf("\"disk_encryption.tang_servers\" must be a JSON array of objects with \"url\" and \"thumbprint\": %s", err),
+		)
+		return
+	}
+	if len(servers) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			stringPath,
+			"Missing Tang Servers",
+			"Disk encryption mode \"tang\" requires at least one Tang server.",
+		)
+		return
+	}
+	for _, server := range servers {
+		checkTangServer(server, stringPath, stringPath, resp)
+	}
+}
+
+// checkTangServer reports a Tang server without an http(s) URL or thumbprint
+func checkTangServer(server tangServer, urlPath, thumbprintPath path.Path, resp *resource.ValidateConfigResponse) {
+	u, err := url.Parse(server.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			urlPath,
+			"Invalid Tang Server URL",
+			fmt.Sprintf("Tang server URL must be a well-formed http or https URL such as http://tang.example.com:7500, got: %q", server.URL),
+		)
+	}
+	if server.Thumbprint == "" {
+		resp.Diagnostics.AddAttributeError(
+			thumbprintPath,
+			"Missing Tang Server Thumbprint",
+			fmt.Sprintf("Tang server %q requires the thumbprint of its signing key, as printed by \"tang-show-keys\".", server.URL),
+		)
+	}
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+var tangServerAttrTypes = map[string]attr.Type{
+	"url":        types.StringType,
+	"thumbprint": types.StringType,
+}
+
+// newDiskEncryptionObject builds a disk_encryption value with the given Tang servers
+func newDiskEncryptionObject(t *testing.T, mode string, tangServers types.String, servers []TangServerModel) types.Object {
+	t.Helper()
+
+	list := types.ListNull(types.ObjectType{AttrTypes: tangServerAttrTypes})
+	if servers != nil {
+		var diags diag.Diagnostics
+		list, diags = types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: tangServerAttrTypes}, servers)
+		if diags.HasError() {
+			t.Fatalf("Failed to build tang_server_list: %v", diags)
+		}
+	}
+
+	object, diags := types.ObjectValueFrom(context.Background(), map[string]attr.Type{
+		"enable_on":        types.StringType,
+		"mode":             types.StringType,
+		"tang_servers":     types.StringType,
+		"tang_server_list": types.ListType{ElemType: types.ObjectType{AttrTypes: tangServerAttrTypes}},
+	}, DiskEncryptionModel{
+		EnableOn:       types.StringValue("all"),
+		Mode:           types.StringValue(mode),
+		TangServers:    tangServers,
+		TangServerList: list,
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to build disk_encryption: %v", diags)
+	}
+	return object
+}
+
+// newDiskEncryptionValue builds a disk_encryption config value
+func newDiskEncryptionValue(t *testing.T, mode string, tangServers *string, servers [][2]string) tftypes.Value {
+	t.Helper()
+
+	objType := clusterConfigAttributeType(t, "disk_encryption").(tftypes.Object)
+	listType := objType.AttributeTypes["tang_server_list"].(tftypes.List)
+	serverType := listType.ElementType.(tftypes.Object)
+
+	list := tftypes.NewValue(listType, nil)
+	if servers != nil {
+		values := make([]tftypes.Value, len(servers))
+		for i, server := range servers {
+			values[i] = tftypes.NewValue(serverType, map[string]tftypes.Value{
+				"url":        tftypes.NewValue(tftypes.String, server[0]),
+				"thumbprint": tftypes.NewValue(tftypes.String, server[1]),
+			})
+		}
+		list = tftypes.NewValue(listType, values)
+	}
+
+	var tangServersValue tftypes.Value
+	if tangServers != nil {
+		tangServersValue = tftypes.NewValue(tftypes.String, *tangServers)
+	} else {
+		tangServersValue = tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(objType, map[string]tftypes.Value{
+		"enable_on":        tftypes.NewValue(tftypes.String, "all"),
+		"mode":             tftypes.NewValue(tftypes.String, mode),
+		"tang_servers":     tangServersValue,
+		"tang_server_list": list,
+	})
+}
+
+func TestClusterResource_diskEncryptionFromModel(t *testing.T) {
+	tests := []struct {
+		name                string
+		tangServers         types.String
+		servers             []TangServerModel
+		expectedTangServers string
+	}{
+		{
+			name:        "tang server list",
+			tangServers: types.StringNull(),
+			servers: []TangServerModel{
+				{URL: StringValue("http://tang1.example.com:7500"), Thumbprint: StringValue("PLjNyRdGw03zlRoGjQYMahSZGu9")},
+				{URL: StringValue("http://tang2.example.com:7500"), Thumbprint: StringValue("XKb7Fvwb9RBtRUG8W8u2nNjr9iE")},
+			},
+			expectedTangServers: `[{"url":"http://tang1.example.com:7500","thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"},{"url":"http://tang2.example.com:7500","thumbprint":"XKb7Fvwb9RBtRUG8W8u2nNjr9iE"}]`,
+		},
+		{
+			name:                "json string",
+			tangServers:         StringValue(`[{"url":"http://tang.example.com:7500","thumbprint":"abc"}]`),
+			expectedTangServers: `[{"url":"http://tang.example.com:7500","thumbprint":"abc"}]`,
+		},
+		{
+			name:                "empty list falls back to json string",
+			tangServers:         StringValue(`[{"url":"http://tang.example.com:7500","thumbprint":"abc"}]`),
+			servers:             []TangServerModel{},
+			expectedTangServers: `[{"url":"http://tang.example.com:7500","thumbprint":"abc"}]`,
+		},
+		{
+			name:        "no tang servers",
+			tangServers: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ClusterResource{}
+			model := ClusterResourceModel{
+				Name:           StringValue("test-cluster"),
+				DiskEncryption: newDiskEncryptionObject(t, "tang", tt.tangServers, tt.servers),
+			}
+
+			for name, result := range map[string]*models.DiskEncryption{
+				"create": r.modelToCreateParams(model).DiskEncryption,
+				"update": r.modelToUpdateParams(model).DiskEncryption,
+			} {
+				if result == nil {
+					t.Fatalf("Expected disk_encryption in %s params", name)
+				}
+				if result.EnableOn != "all" || result.Mode != "tang" {
+					t.Errorf("%s params: expected enable_on all and mode tang, got %+v", name, result)
+				}
+				if result.TangServers != tt.expectedTangServers {
+					t.Errorf("%s params: tang_servers = %s, want %s", name, result.TangServers, tt.expectedTangServers)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterResource_diskEncryptionFromModel_Unset(t *testing.T) {
+	model := ClusterResourceModel{
+		Name:           StringValue("test-cluster"),
+		DiskEncryption: types.ObjectNull(map[string]attr.Type{}),
+	}
+
+	if result := (&ClusterResource{}).modelToCreateParams(model).DiskEncryption; result != nil {
+		t.Errorf("Expected no disk_encryption, got %+v", result)
+	}
+}
+
+func TestClusterResource_ValidateConfig_DiskEncryption(t *testing.T) {
+	listPath := path.Root("disk_encryption").AtName("tang_server_list")
+	stringPath := path.Root("disk_encryption").AtName("tang_servers")
+	validJSON := `[{"url":"http://tang.example.com:7500","thumbprint":"abc"}]`
+	invalidJSON := `{"url":"http://tang.example.com:7500"}`
+	missingThumbprintJSON := `[{"url":"http://tang.example.com:7500"}]`
+	emptyJSON := `[]`
+
+	tests := []struct {
+		name           string
+		mode           string
+		tangServers    *string
+		servers        [][2]string
+		expectedErrors []path.Path
+	}{
+		{
+			name:    "tang server list",
+			mode:    "tang",
+			servers: [][2]string{{"http://tang.example.com:7500", "abc"}, {"https://tang2.example.com", "def"}},
+		},
+		{
+			name:        "json string",
+			mode:        "tang",
+			tangServers: &validJSON,
+		},
+		{
+			name: "tpmv2 without tang servers",
+			mode: "tpmv2",
+		},
+		{
+			name:           "tang without servers",
+			mode:           "tang",
+			expectedErrors: []path.Path{listPath},
+		},
+		{
+			name:           "tang with empty list",
+			mode:           "tang",
+			servers:        [][2]string{},
+			expectedErrors: []path.Path{listPath},
+		},
+		{
+			name:    "invalid url and empty thumbprint",
+			mode:    "tang",
+			servers: [][2]string{{"http://tang.example.com:7500", "abc"}, {"tang.example.com:7500", ""}},
+			expectedErrors: []path.Path{
+				listPath.AtListIndex(1).AtName("url"),
+				listPath.AtListIndex(1).AtName("thumbprint"),
+			},
+		},
+		{
+			name:           "list and json string",
+			mode:           "tang",
+			tangServers:    &validJSON,
+			servers:        [][2]string{{"http://tang.example.com:7500", "abc"}},
+			expectedErrors: []path.Path{stringPath},
+		},
+		{
+			name:           "invalid json string",
+			mode:           "tang",
+			tangServers:    &invalidJSON,
+			expectedErrors: []path.Path{stringPath},
+		},
+		{
+			name:           "json string without thumbprint",
+			mode:           "tang",
+			tangServers:    &missingThumbprintJSON,
+			expectedErrors: []path.Path{stringPath},
+		},
+		{
+			name:           "empty json string array",
+			mode:           "tang",
+			tangServers:    &emptyJSON,
+			expectedErrors: []path.Path{stringPath},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newClusterConfig(t, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"disk_encryption":   newDiskEncryptionValue(t, tt.mode, tt.tangServers, tt.servers),
+			})
+
+			resp := &resource.ValidateConfigResponse{}
+			(&ClusterResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors, got %d: %+v", len(tt.expectedErrors), len(errs), resp.Diagnostics)
+			}
+			for i, expected := range tt.expectedErrors {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Errorf("Expected error %d at %s, got %+v", i, expected, errs[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// lookupHost resolves names the hosts have not reported on with the
+// provider's own resolver
+var lookupHost = net.DefaultResolver.LookupHost
+
+// clusterDNSNames returns the names that must resolve for the cluster to be
+// reachable once installed. The ingress wildcard is checked through the
+// console route, the same name the service checks.
+func clusterDNSNames(cluster *models.Cluster) []string {
+	domain := fmt.Sprintf("%s.%s", cluster.Name, cluster.BaseDNSDomain)
+	return []string{
+		"api." + domain,
+		"console-openshift-console.apps." + domain,
+	}
+}
+
+// unresolvedClusterDNSNames returns the cluster DNS names that do not resolve.
+// The lookups the hosts report are preferred, as they use the DNS servers the
+// cluster will use. Names no host has looked up yet are resolved by the provider.
+func unresolvedClusterDNSNames(ctx context.Context, cluster *models.Cluster) []string {
+	reported := make(map[string]bool)
+	for i := range cluster.Hosts {
+		resolutions, err := cluster.Hosts[i].ParseDomainNameResolutions()
+		if err != nil {
+			tflog.Warn(ctx, "Ignoring invalid host domain name resolutions", map[string]interface{}{
+				"host_id": cluster.Hosts[i].ID,
+				"error":   err.Error(),
+			})
+			continue
+		}
+		if resolutions == nil {
+			continue
+		}
+		for _, resolution := range resolutions.Resolutions {
+			name := strings.TrimSuffix(resolution.DomainName, ".")
+			reported[name] = reported[name] || resolution.Resolved()
+		}
+	}
+
+	var unresolved []string
+	for _, name := range clusterDNSNames(cluster) {
+		resolved, ok := reported[name]
+		if !ok {
+			addrs, err := lookupHost(ctx, name)
+			resolved = err == nil && len(addrs) > 0
+		}
+		if !resolved {
+			unresolved = append(unresolved, name)
+		}
+	}
+	return unresolved
+}
+
+// checkDNSResolution reports an error listing the cluster DNS names that do
+// not resolve
+func (r *ClusterInstallationResource) checkDNSResolution(ctx context.Context, clusterID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cluster, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		diags.AddError(
+			"Error retrieving cluster",
+			fmt.Sprintf("Could not get cluster %s: %s", clusterID, err),
+		)
+		return diags
+	}
+
+	if unresolved := unresolvedClusterDNSNames(ctx, cluster); len(unresolved) > 0 {
+		diags.AddError(
+			"Cluster DNS Names Not Resolved",
+			fmt.Sprintf("The following DNS names of cluster %s do not resolve:\n\n- %s\n\nCreate the DNS records, or set verify_dns to false, before installing.",
+				clusterID, strings.Join(unresolved, "\n- ")),
+		)
+	}
+
+	return diags
+}
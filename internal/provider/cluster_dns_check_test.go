@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+const (
+	testAPIName  = "api.test-cluster.example.com"
+	testAppsName = "console-openshift-console.apps.test-cluster.example.com"
+)
+
+func testDomainResolutions(t *testing.T, resolutions ...models.DomainResolution) string {
+	t.Helper()
+
+	data, err := json.Marshal(models.DomainResolutions{Resolutions: resolutions})
+	if err != nil {
+		t.Fatalf("Failed to encode domain resolutions: %v", err)
+	}
+	return string(data)
+}
+
+func TestUnresolvedClusterDNSNames(t *testing.T) {
+	originalLookup := lookupHost
+	defer func() { lookupHost = originalLookup }()
+
+	tests := []struct {
+		name        string
+		resolutions []string
+		resolver    map[string][]string
+		expected    []string
+	}{
+		{
+			name: "all names resolved by the hosts",
+			resolutions: []string{testDomainResolutions(t,
+				models.DomainResolution{DomainName: testAPIName, IPv4Addresses: []string{"192.168.1.100"}},
+				models.DomainResolution{DomainName: testAppsName + ".", Cnames: []string{"ingress.example.com"}},
+			)},
+		},
+		{
+			name: "missing ingress record",
+			resolutions: []string{testDomainResolutions(t,
+				models.DomainResolution{DomainName: testAPIName, IPv4Addresses: []string{"192.168.1.100"}},
+				models.DomainResolution{DomainName: testAppsName},
+			)},
+			resolver: map[string][]string{testAppsName: {"192.168.1.101"}},
+			expected: []string{testAppsName},
+		},
+		{
+			name: "resolved by one of the hosts",
+			resolutions: []string{
+				testDomainResolutions(t, models.DomainResolution{DomainName: testAPIName}),
+				testDomainResolutions(t, models.DomainResolution{DomainName: testAPIName, IPv6Addresses: []string{"fd00::100"}}),
+			},
+			resolver: map[string][]string{testAppsName: {"192.168.1.101"}},
+		},
+		{
+			name:     "no host lookups falls back to the provider resolver",
+			resolver: map[string][]string{testAPIName: {"192.168.1.100"}},
+			expected: []string{testAppsName},
+		},
+		{
+			name:        "invalid host lookups are ignored",
+			resolutions: []string{"not json"},
+			expected:    []string{testAPIName, testAppsName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookupHost = func(ctx context.Context, name string) ([]string, error) {
+				if addrs, ok := tt.resolver[name]; ok {
+					return addrs, nil
+				}
+				return nil, errors.New("no such host")
+			}
+
+			cluster := &models.Cluster{Name: "test-cluster", BaseDNSDomain: "example.com"}
+			for _, resolutions := range tt.resolutions {
+				cluster.Hosts = append(cluster.Hosts, models.Host{ID: "host", DomainNameResolutions: resolutions})
+			}
+
+			unresolved := unresolvedClusterDNSNames(context.Background(), cluster)
+			if strings.Join(unresolved, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected unresolved names %v, got %v", tt.expected, unresolved)
+			}
+		})
+	}
+}
+
+func TestClusterInstallationResource_CheckDNSResolution(t *testing.T) {
+	originalLookup := lookupHost
+	lookupHost = func(ctx context.Context, name string) ([]string, error) {
+		t.Errorf("Unexpected lookup of %s, the hosts reported all names", name)
+		return nil, errors.New("no such host")
+	}
+	defer func() { lookupHost = originalLookup }()
+
+	cluster := models.Cluster{
+		ID:            "test-cluster-id",
+		Name:          "test-cluster",
+		BaseDNSDomain: "example.com",
+		Hosts: []models.Host{{
+			ID: "master-0",
+			DomainNameResolutions: testDomainResolutions(t,
+				models.DomainResolution{DomainName: testAPIName},
+				models.DomainResolution{DomainName: testAppsName, IPv4Addresses: []string{"192.168.1.101"}},
+			),
+		}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(cluster)
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	diags := r.checkDNSResolution(context.Background(), "test-cluster-id")
+	if !diags.HasError() {
+		t.Fatal("Expected an error for the unresolved API name")
+	}
+	detail := diags.Errors()[0].Detail()
+	if !strings.Contains(detail, "- "+testAPIName) || strings.Contains(detail, testAppsName) {
+		t.Errorf("Expected only the API name to be listed, got %q", detail)
+	}
+}
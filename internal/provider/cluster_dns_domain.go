@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// dnsLabelPattern matches a single DNS label: alphanumerics and hyphens, not
+// starting or ending with a hyphen, at most 63 characters
+var dnsLabelPattern = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// maxDomainLength is the maximum length of a fully qualified domain name
+const maxDomainLength = 253
+
+// validateClusterDomain checks that the cluster domain <name>.<base_dns_domain>,
+// under which the API and ingress records are created, is a valid DNS name
+func validateClusterDomain(name, baseDNSDomain string) error {
+	if baseDNSDomain == "" {
+		return fmt.Errorf("base_dns_domain must not be empty")
+	}
+
+	domain := strings.TrimSuffix(baseDNSDomain, ".")
+	for _, label := range strings.Split(domain, ".") {
+		if !dnsLabelPattern.MatchString(label) {
+			return fmt.Errorf("%q is not a valid DNS label in base_dns_domain %q; labels must be 1-63 letters, digits or hyphens and cannot start or end with a hyphen", label, baseDNSDomain)
+		}
+	}
+
+	if name != "" {
+		domain = name + "." + domain
+	}
+	if len(domain) > maxDomainLength {
+		return fmt.Errorf("cluster domain %q is %d characters long, the maximum is %d", domain, len(domain), maxDomainLength)
+	}
+
+	return nil
+}
+
+// validateBaseDNSDomain rejects a base_dns_domain that does not form a valid
+// cluster domain, which the API would otherwise only report at installation
+func (r *ClusterResource) validateBaseDNSDomain(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.BaseDNSDomain.IsNull() || data.BaseDNSDomain.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if err := validateClusterDomain(data.Name.ValueString(), data.BaseDNSDomain.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_dns_domain"),
+			"Invalid Base DNS Domain",
+			fmt.Sprintf("The cluster domain cannot be built from \"name\" and \"base_dns_domain\": %s.", err),
+		)
+	}
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateClusterDomain(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterName   string
+		baseDNSDomain string
+		expectError   string
+	}{
+		{name: "valid", clusterName: "test-cluster", baseDNSDomain: "example.com"},
+		{name: "trailing dot", clusterName: "test-cluster", baseDNSDomain: "example.com."},
+		{name: "empty", clusterName: "test-cluster", baseDNSDomain: "", expectError: "must not be empty"},
+		{name: "empty label", clusterName: "test-cluster", baseDNSDomain: "example..com", expectError: `"" is not a valid DNS label`},
+		{name: "invalid character", clusterName: "test-cluster", baseDNSDomain: "exa_mple.com", expectError: `"exa_mple" is not a valid DNS label`},
+		{name: "leading hyphen", clusterName: "test-cluster", baseDNSDomain: "-example.com", expectError: "not a valid DNS label"},
+		{name: "label too long", clusterName: "test-cluster", baseDNSDomain: strings.Repeat("a", 64) + ".com", expectError: "not a valid DNS label"},
+		{name: "domain too long", clusterName: "test-cluster", baseDNSDomain: strings.Repeat(strings.Repeat("a", 60)+".", 4) + "com", expectError: "the maximum is 253"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateClusterDomain(tt.clusterName, tt.baseDNSDomain)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_BaseDNSDomain(t *testing.T) {
+	tests := []struct {
+		name          string
+		baseDNSDomain tftypes.Value
+		expectError   bool
+	}{
+		{name: "provided", baseDNSDomain: tftypes.NewValue(tftypes.String, "example.com")},
+		{name: "omitted", baseDNSDomain: tftypes.NewValue(tftypes.String, nil)},
+		{name: "unknown", baseDNSDomain: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		{name: "empty", baseDNSDomain: tftypes.NewValue(tftypes.String, ""), expectError: true},
+		{name: "invalid", baseDNSDomain: tftypes.NewValue(tftypes.String, "example_com"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newClusterConfig(t, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"base_dns_domain":   tt.baseDNSDomain,
+			})
+
+			resp := &resource.ValidateConfigResponse{}
+			(&ClusterResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("Expected error %v, got %+v", tt.expectError, resp.Diagnostics)
+			}
+			if tt.expectError && resp.Diagnostics.Errors()[0].Summary() != "Invalid Base DNS Domain" {
+				t.Errorf("Unexpected error %s", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestClusterResource_Create_BaseDNSDomain(t *testing.T) {
+	tests := []struct {
+		name            string
+		configured      tftypes.Value
+		derived         string
+		expectedDomain  string
+		expectedWarning bool
+	}{
+		{
+			name:           "provided",
+			configured:     tftypes.NewValue(tftypes.String, "example.com"),
+			expectedDomain: "example.com",
+		},
+		{
+			name:           "omitted and derived by the service",
+			configured:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			derived:        "assisted.example.com",
+			expectedDomain: "assisted.example.com",
+		},
+		{
+			name:            "omitted and not derived",
+			configured:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectedWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var params models.ClusterCreateParams
+				if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+					t.Errorf("Failed to decode create params: %v", err)
+				}
+				domain := params.BaseDNSDomain
+				if domain == "" {
+					domain = tt.derived
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(models.Cluster{
+					ID:               "test-cluster-id",
+					Name:             params.Name,
+					OpenshiftVersion: params.OpenshiftVersion,
+					BaseDNSDomain:    domain,
+					Status:           "insufficient",
+				})
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"pull_secret":       tftypes.NewValue(tftypes.String, "pull-secret"),
+				"base_dns_domain":   tt.configured,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var data ClusterResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			if tt.expectedDomain == "" {
+				if !data.BaseDNSDomain.IsNull() {
+					t.Errorf("Expected base_dns_domain to be null, got %s", data.BaseDNSDomain)
+				}
+			} else if data.BaseDNSDomain.ValueString() != tt.expectedDomain {
+				t.Errorf("Expected base_dns_domain %s, got %s", tt.expectedDomain, data.BaseDNSDomain)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.expectedWarning {
+				t.Errorf("Expected warning %v, got %+v", tt.expectedWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
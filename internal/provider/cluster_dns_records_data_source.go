@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterDNSRecordsDataSource{}
+
+func NewClusterDNSRecordsDataSource() datasource.DataSource {
+	return &ClusterDNSRecordsDataSource{}
+}
+
+// ClusterDNSRecordsDataSource defines the data source implementation.
+type ClusterDNSRecordsDataSource struct {
+	client *client.Client
+}
+
+// ClusterDNSRecordsDataSourceModel describes the data source data model.
+type ClusterDNSRecordsDataSourceModel struct {
+	ID            types.String     `tfsdk:"id"`
+	ClusterID     types.String     `tfsdk:"cluster_id"`
+	ClusterName   types.String     `tfsdk:"cluster_name"`
+	BaseDNSDomain types.String     `tfsdk:"base_dns_domain"`
+	ClusterDomain types.String     `tfsdk:"cluster_domain"`
+	Records       []DNSRecordModel `tfsdk:"records"`
+}
+
+// DNSRecordModel describes a single DNS record required by the cluster.
+type DNSRecordModel struct {
+	Purpose types.String `tfsdk:"purpose"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func (d *ClusterDNSRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_dns_records"
+}
+
+func (d *ClusterDNSRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Derives the DNS records a cluster expects (`api`, `api-int` and `*.apps`) from the cluster name, base DNS domain and VIPs. Use this to configure external DNS before installation.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for this data source instance",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster to derive DNS records for",
+				Required:            true,
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cluster",
+				Computed:            true,
+			},
+			"base_dns_domain": schema.StringAttribute{
+				MarkdownDescription: "Base DNS domain of the cluster",
+				Computed:            true,
+			},
+			"cluster_domain": schema.StringAttribute{
+				MarkdownDescription: "Cluster domain (`<cluster_name>.<base_dns_domain>`) under which all records are created",
+				Computed:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records required by the cluster",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"purpose": schema.StringAttribute{
+							MarkdownDescription: "What the record is used for (api, api-int, ingress)",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Fully qualified record name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type (A, AAAA or CNAME)",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value. Null when the target is not yet known, for example with user-managed networking before hosts are discovered.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterDNSRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterDNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterDNSRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := d.client.GetCluster(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster, got error: %s", err),
+		)
+		return
+	}
+
+	if cluster.BaseDNSDomain == "" {
+		resp.Diagnostics.AddError(
+			"Missing Base DNS Domain",
+			fmt.Sprintf("Cluster %s has no base_dns_domain set, so its DNS records cannot be derived.", cluster.ID),
+		)
+		return
+	}
+
+	clusterDomain := fmt.Sprintf("%s.%s", cluster.Name, cluster.BaseDNSDomain)
+
+	data.ID = data.ClusterID // Use cluster_id as the unique identifier
+	data.ClusterName = types.StringValue(cluster.Name)
+	data.BaseDNSDomain = types.StringValue(cluster.BaseDNSDomain)
+	data.ClusterDomain = types.StringValue(clusterDomain)
+	data.Records = clusterDNSRecords(cluster, clusterDomain)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// clusterDNSRecords derives the api, api-int and wildcard apps records for a cluster
+func clusterDNSRecords(cluster *models.Cluster, clusterDomain string) []DNSRecordModel {
+	apiVips := make([]string, len(cluster.APIVips))
+	for i, vip := range cluster.APIVips {
+		apiVips[i] = vip.IP
+	}
+
+	ingressVips := make([]string, len(cluster.IngressVips))
+	for i, vip := range cluster.IngressVips {
+		ingressVips[i] = vip.IP
+	}
+
+	apiName := "api." + clusterDomain
+
+	// api_vip_dns_name is only a CNAME target when it points somewhere else
+	apiAlias := ""
+	if cluster.APIVipDNSName != "" && cluster.APIVipDNSName != apiName {
+		apiAlias = cluster.APIVipDNSName
+	}
+
+	var records []DNSRecordModel
+	records = append(records, dnsRecordsFor("api", apiName, apiVips, apiAlias)...)
+	records = append(records, dnsRecordsFor("api-int", "api-int."+clusterDomain, apiVips, apiAlias)...)
+	records = append(records, dnsRecordsFor("ingress", "*.apps."+clusterDomain, ingressVips, "")...)
+
+	return records
+}
+
+// dnsRecordsFor returns one A/AAAA record per address, a CNAME when only an
+// alias is known, or a single A record with a null value otherwise.
+func dnsRecordsFor(purpose, name string, addresses []string, alias string) []DNSRecordModel {
+	if len(addresses) == 0 {
+		record := DNSRecordModel{
+			Purpose: types.StringValue(purpose),
+			Name:    types.StringValue(name),
+			Type:    types.StringValue("A"),
+			Value:   types.StringNull(),
+		}
+		if alias != "" {
+			record.Type = types.StringValue("CNAME")
+			record.Value = types.StringValue(alias)
+		}
+		return []DNSRecordModel{record}
+	}
+
+	records := make([]DNSRecordModel, len(addresses))
+	for i, address := range addresses {
+		recordType := "A"
+		if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+			recordType = "AAAA"
+		}
+		records[i] = DNSRecordModel{
+			Purpose: types.StringValue(purpose),
+			Name:    types.StringValue(name),
+			Type:    types.StringValue(recordType),
+			Value:   types.StringValue(address),
+		}
+	}
+
+	return records
+}
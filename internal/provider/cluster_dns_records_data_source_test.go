@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterDNSRecordsDataSource_Metadata(t *testing.T) {
+	ds := NewClusterDNSRecordsDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	if metadataResp.TypeName != "openshift_assisted_installer_cluster_dns_records" {
+		t.Errorf("Expected type name 'openshift_assisted_installer_cluster_dns_records', got '%s'", metadataResp.TypeName)
+	}
+}
+
+func TestClusterDNSRecordsDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  models.Cluster
+		expected []DNSRecordModel
+	}{
+		{
+			name: "dual stack VIPs",
+			cluster: models.Cluster{
+				ID:            "test-cluster-id",
+				Name:          "test-cluster",
+				BaseDNSDomain: "example.com",
+				APIVips:       []models.APIVip{{IP: "192.168.1.100"}, {IP: "fd00::100"}},
+				IngressVips:   []models.IngressVip{{IP: "192.168.1.101"}},
+			},
+			expected: []DNSRecordModel{
+				{Purpose: StringValue("api"), Name: StringValue("api.test-cluster.example.com"), Type: StringValue("A"), Value: StringValue("192.168.1.100")},
+				{Purpose: StringValue("api"), Name: StringValue("api.test-cluster.example.com"), Type: StringValue("AAAA"), Value: StringValue("fd00::100")},
+				{Purpose: StringValue("api-int"), Name: StringValue("api-int.test-cluster.example.com"), Type: StringValue("A"), Value: StringValue("192.168.1.100")},
+				{Purpose: StringValue("api-int"), Name: StringValue("api-int.test-cluster.example.com"), Type: StringValue("AAAA"), Value: StringValue("fd00::100")},
+				{Purpose: StringValue("ingress"), Name: StringValue("*.apps.test-cluster.example.com"), Type: StringValue("A"), Value: StringValue("192.168.1.101")},
+			},
+		},
+		{
+			name: "user managed networking with API DNS name",
+			cluster: models.Cluster{
+				ID:            "test-cluster-id",
+				Name:          "sno",
+				BaseDNSDomain: "example.com",
+				APIVipDNSName: "lb.example.com",
+			},
+			expected: []DNSRecordModel{
+				{Purpose: StringValue("api"), Name: StringValue("api.sno.example.com"), Type: StringValue("CNAME"), Value: StringValue("lb.example.com")},
+				{Purpose: StringValue("api-int"), Name: StringValue("api-int.sno.example.com"), Type: StringValue("CNAME"), Value: StringValue("lb.example.com")},
+				{Purpose: StringValue("ingress"), Name: StringValue("*.apps.sno.example.com"), Type: StringValue("A")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Expected path /v2/clusters/test-cluster-id, got %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tt.cluster)
+			}))
+			defer server.Close()
+
+			ds := &ClusterDNSRecordsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			})
+			ds.Read(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state ClusterDNSRecordsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			expectedDomain := tt.cluster.Name + "." + tt.cluster.BaseDNSDomain
+			if state.ClusterDomain.ValueString() != expectedDomain {
+				t.Errorf("Expected cluster_domain %q, got %q", expectedDomain, state.ClusterDomain.ValueString())
+			}
+			if len(state.Records) != len(tt.expected) {
+				t.Fatalf("Expected %d records, got %d: %+v", len(tt.expected), len(state.Records), state.Records)
+			}
+			for i, expected := range tt.expected {
+				got := state.Records[i]
+				if !got.Purpose.Equal(expected.Purpose) || !got.Name.Equal(expected.Name) || !got.Type.Equal(expected.Type) || !got.Value.Equal(expected.Value) {
+					t.Errorf("Record %d: expected %+v, got %+v", i, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterDNSRecordsDataSource_Read_MissingBaseDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Name: "test-cluster"})
+	}))
+	defer server.Close()
+
+	ds := &ClusterDNSRecordsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error when the cluster has no base_dns_domain")
+	}
+}
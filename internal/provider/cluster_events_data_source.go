@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -215,16 +217,14 @@ func (d *ClusterEventsDataSource) Read(ctx context.Context, req datasource.ReadR
 		params["cluster_level"] = fmt.Sprintf("%t", data.ClusterLevel.ValueBool())
 	}
 
-	// Handle list parameters (severities and categories)
+	// The API takes list parameters as comma-separated values
 	if !data.Severities.IsNull() && !data.Severities.IsUnknown() {
 		var severities []string
 		resp.Diagnostics.Append(data.Severities.ElementsAs(ctx, &severities, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		for _, severity := range severities {
-			params["severities"] = severity // Note: API might expect multiple values differently
-		}
+		params["severities"] = strings.Join(severities, ",")
 	}
 
 	if !data.Categories.IsNull() && !data.Categories.IsUnknown() {
@@ -233,9 +233,7 @@ func (d *ClusterEventsDataSource) Read(ctx context.Context, req datasource.ReadR
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		for _, category := range categories {
-			params["categories"] = category // Note: API might expect multiple values differently
-		}
+		params["categories"] = strings.Join(categories, ",")
 	}
 
 	// Get cluster ID - could be from filter or required
@@ -255,8 +253,20 @@ func (d *ClusterEventsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Map response to model
-	events := make([]EventModel, len(eventsResp.Events))
-	for i, event := range eventsResp.Events {
+	events := eventModels(eventsResp.Events)
+
+	// Set computed values
+	data.ID = types.StringValue(fmt.Sprintf("events-%s", clusterID)) // Generate a unique ID
+	data.Events = events
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// eventModels maps API events to the nested events attribute
+func eventModels(apiEvents []models.Event) []EventModel {
+	events := make([]EventModel, len(apiEvents))
+	for i, event := range apiEvents {
 		events[i] = EventModel{
 			Name:       types.StringValue(event.Name),
 			ClusterID:  types.StringValue(event.ClusterID),
@@ -270,11 +280,5 @@ func (d *ClusterEventsDataSource) Read(ctx context.Context, req datasource.ReadR
 			Props:      types.StringValue(event.Props),
 		}
 	}
-
-	// Set computed values
-	data.ID = types.StringValue(fmt.Sprintf("events-%s", clusterID)) // Generate a unique ID
-	data.Events = events
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return events
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -44,102 +43,95 @@ func TestClusterEventsDataSource_Schema(t *testing.T) {
 	}
 }
 
-// SkipTestClusterEventsDataSource_Read requires full Terraform framework
-// This is an integration test that tests the Read method directly.
-// func TestClusterEventsDataSource_Read(t *testing.T) {
-func SkipTestClusterEventsDataSource_Read(t *testing.T) {
-	t.Skip("Integration test - requires full Terraform Plugin Framework")
-	// Mock events response
-	mockEvents := models.EventsResponse{
-		Events: []models.Event{
-			{
-				Name:      "Cluster validation",
-				ClusterID: "test-cluster-id",
-				Severity:  "info",
-				Category:  "user",
-				Message:   "Cluster validation passed",
-				EventTime: time.Now(),
-				RequestID: "request-123",
-			},
-			{
-				Name:      "Host discovery",
-				ClusterID: "test-cluster-id",
-				HostID:    "host-456",
-				Severity:  "info",
-				Category:  "user",
-				Message:   "Host discovered successfully",
-				EventTime: time.Now().Add(-5 * time.Minute),
-				RequestID: "request-124",
-			},
+func TestClusterEventsDataSource_Read(t *testing.T) {
+	eventTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mockEvents := []models.Event{
+		{
+			Name:      "host_install_failed",
+			ClusterID: "test-cluster-id",
+			HostID:    "host-456",
+			Severity:  "error",
+			Category:  "user",
+			Message:   "Host failed to install",
+			EventTime: eventTime,
+		},
+		{
+			Name:      "cluster_validation_failed",
+			ClusterID: "test-cluster-id",
+			Severity:  "critical",
+			Category:  "user",
+			Message:   "Cluster validation failed",
+			EventTime: eventTime.Add(-5 * time.Minute),
 		},
 	}
 
-	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			t.Errorf("Expected GET request, got %s", r.Method)
 		}
-
-		expectedPath := "/v2/events"
-		if r.URL.Path != expectedPath {
-			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		if r.URL.Path != "/v2/events" {
+			t.Errorf("Expected path /v2/events, got %s", r.URL.Path)
 		}
 
-		// Check query parameters
 		query := r.URL.Query()
-		if query.Get("cluster_id") != "test-cluster-id" {
-			t.Errorf("Expected cluster_id=test-cluster-id, got %s", query.Get("cluster_id"))
+		expected := map[string]string{
+			"cluster_id": "test-cluster-id",
+			"severities": "error,critical",
+			"host_id":    "host-456",
+			"limit":      "50",
 		}
-
-		// Check authentication header
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			t.Error("Missing Authorization header")
+		for key, value := range expected {
+			if got := query.Get(key); got != value {
+				t.Errorf("Expected query parameter %s=%s, got %q", key, value, got)
+			}
 		}
 
+		// The events API returns a bare list
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(mockEvents)
 	}))
 	defer server.Close()
 
-	// Create client and data source
-	testClient := client.NewClient(client.ClientConfig{
-		BaseURL:      server.URL,
-		OfflineToken: "test-token",
-	})
+	ds := &ClusterEventsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
 
-	dataSource := NewClusterEventsDataSource().(*ClusterEventsDataSource)
-	dataSource.Configure(context.Background(), datasource.ConfigureRequest{
-		ProviderData: testClient,
-	}, &datasource.ConfigureResponse{})
-
-	// Test data source read
-	req := datasource.ReadRequest{}
-	resp := &datasource.ReadResponse{}
-
-	// Set up request config
-	var data ClusterEventsDataSourceModel
-	data.ClusterID = types.StringValue("test-cluster-id")
-	req.Config.Raw = tftypes.NewValue(tftypes.Object{
-		AttributeTypes: map[string]tftypes.Type{
-			"cluster_id": tftypes.String,
-		},
-	}, map[string]tftypes.Value{
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
 		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"host_id":    tftypes.NewValue(tftypes.String, "host-456"),
+		"limit":      tftypes.NewValue(tftypes.Number, 50),
+		"severities": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "error"),
+			tftypes.NewValue(tftypes.String, "critical"),
+		}),
 	})
-
-	dataSource.Read(context.Background(), req, resp)
+	ds.Read(context.Background(), req, resp)
 
 	if resp.Diagnostics.HasError() {
-		t.Fatalf("Read method diagnostics: %+v", resp.Diagnostics)
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
 	}
 
-	// Verify the response contains events
 	var result ClusterEventsDataSourceModel
-	resp.State.Get(context.Background(), &result)
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
 
 	if len(result.Events) != 2 {
-		t.Errorf("Expected 2 events, got %d", len(result.Events))
+		t.Fatalf("Expected 2 events, got %d", len(result.Events))
+	}
+	event := result.Events[0]
+	if event.Name.ValueString() != "host_install_failed" || event.Severity.ValueString() != "error" {
+		t.Errorf("Unexpected first event: %+v", event)
+	}
+	if event.HostID.ValueString() != "host-456" {
+		t.Errorf("Expected host_id host-456, got %s", event.HostID)
+	}
+	if event.EventTime.ValueString() != "2025-06-01T12:00:00Z" {
+		t.Errorf("Expected event_time 2025-06-01T12:00:00Z, got %s", event.EventTime)
 	}
 }
 
@@ -5,8 +5,12 @@ import (
 	"fmt"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -24,11 +28,13 @@ type ClusterFilesDataSource struct {
 
 // ClusterFilesDataSourceModel describes the data source data model.
 type ClusterFilesDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ClusterID types.String `tfsdk:"cluster_id"`
-	FileName  types.String `tfsdk:"file_name"`
-	LogsType  types.String `tfsdk:"logs_type"`
-	Content   types.String `tfsdk:"content"`
+	ID              types.String   `tfsdk:"id"`
+	ClusterID       types.String   `tfsdk:"cluster_id"`
+	FileName        types.String   `tfsdk:"file_name"`
+	LogsType        types.String   `tfsdk:"logs_type"`
+	MaxInStateBytes types.Int64    `tfsdk:"max_in_state_bytes"`
+	Content         types.String   `tfsdk:"content"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *ClusterFilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,10 +63,18 @@ func (d *ClusterFilesDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Type of logs when file_name is 'logs' (controller, host, etc.)",
 				Optional:            true,
 			},
+			"max_in_state_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in bytes of a file stored in `content`. Larger files are rejected with an error instead of bloating the state. Unlimited when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"content": schema.StringAttribute{
 				MarkdownDescription: "Raw file content as a string",
 				Computed:            true,
 			},
+			"timeouts": timeouts.Attributes(ctx),
 		},
 	}
 }
@@ -102,6 +116,17 @@ func (d *ClusterFilesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		params["logs_type"] = data.LogsType.ValueString()
 	}
 
+	// Bound the download by the read timeout rather than the client's
+	// default request timeout, large downloads can take several minutes
+	readTimeout, diags := data.Timeouts.Read(ctx, client.DefaultDownloadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Download file from API
 	fileContent, err := d.client.DownloadClusterFiles(ctx, data.ClusterID.ValueString(), data.FileName.ValueString(), params)
 	if err != nil {
@@ -112,6 +137,18 @@ func (d *ClusterFilesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	// The file is always stored in state, logs can be downloaded to a file
+	// with the cluster logs data source instead
+	if exceedsStateLimit(data.MaxInStateBytes, len(fileContent)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_in_state_bytes"),
+			"Cluster File Too Large for State",
+			fmt.Sprintf("The file '%s' of cluster %s is %d bytes, more than the %d bytes allowed by max_in_state_bytes. For logs, use the openshift_assisted_installer_cluster_logs data source with output_path to write them to a file instead of storing them in state.",
+				data.FileName.ValueString(), data.ClusterID.ValueString(), len(fileContent), data.MaxInStateBytes.ValueInt64()),
+		)
+		return
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	data.ID = types.StringValue(fmt.Sprintf("file-%s-%s", data.ClusterID.ValueString(), data.FileName.ValueString()))
 	data.Content = types.StringValue(string(fileContent))
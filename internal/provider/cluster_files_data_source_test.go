@@ -8,6 +8,9 @@ import (
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestClusterFilesDataSource_Schema(t *testing.T) {
@@ -128,3 +131,56 @@ data:
 		t.Error("Expected client to be set after Configure")
 	}
 }
+
+func TestClusterFilesDataSource_ReadMaxInStateBytes(t *testing.T) {
+	fileContent := `{"clusterName":"test-cluster"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte(fileContent))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		limit       int
+		expectError bool
+	}{
+		{name: "under limit", limit: len(fileContent)},
+		{name: "over limit", limit: len(fileContent) - 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &ClusterFilesDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"cluster_id":         tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"file_name":          tftypes.NewValue(tftypes.String, "metadata.json"),
+				"max_in_state_bytes": tftypes.NewValue(tftypes.Number, tt.limit),
+			})
+			ds.Read(context.Background(), req, resp)
+
+			if tt.expectError {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Cluster File Too Large for State" {
+					t.Errorf("Expected Cluster File Too Large for State error, got %+v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics: %+v", resp.Diagnostics)
+			}
+			var content types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("content"), &content)...)
+			if content.ValueString() != fileContent {
+				t.Errorf("content = %q, want %q", content.ValueString(), fileContent)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultClusterNetworkCIDR is the cluster_network_cidr default, which
+// cluster_network_host_prefix applies to when the CIDR is not configured
+const defaultClusterNetworkCIDR = "10.128.0.0/14"
+
+// validateHostPrefixes requires the host prefix of each cluster network to
+// split the network into host subnets: longer than the network's prefix and
+// no longer than its addresses. Each node is assigned one such subnet.
+func (r *ClusterResource) validateHostPrefixes(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	cidr := data.ClusterNetworkCIDR
+	if cidr.IsNull() {
+		cidr = types.StringValue(defaultClusterNetworkCIDR)
+	}
+	checkHostPrefix(cidr, data.ClusterNetworkHostPrefix, path.Root("cluster_network_host_prefix"), resp)
+
+	if data.ClusterNetworks.IsNull() || data.ClusterNetworks.IsUnknown() {
+		return
+	}
+
+	var networks []ClusterNetworkModel
+	if diags := data.ClusterNetworks.ElementsAs(context.Background(), &networks, false); diags.HasError() {
+		return
+	}
+	for i, network := range networks {
+		checkHostPrefix(network.CIDR, network.HostPrefix, path.Root("cluster_networks").AtListIndex(i).AtName("host_prefix"), resp)
+	}
+}
+
+// checkHostPrefix reports an error at attrPath when hostPrefix does not fit
+// the network cidr. Invalid CIDRs are reported by their own validator.
+func checkHostPrefix(cidr types.String, hostPrefix types.Int64, attrPath path.Path, resp *resource.ValidateConfigResponse) {
+	if cidr.IsNull() || cidr.IsUnknown() || hostPrefix.IsNull() || hostPrefix.IsUnknown() {
+		return
+	}
+
+	network, err := netip.ParsePrefix(cidr.ValueString())
+	if err != nil {
+		return
+	}
+
+	prefix := hostPrefix.ValueInt64()
+	if prefix <= int64(network.Bits()) || prefix > int64(network.Addr().BitLen()) {
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid Host Prefix",
+			fmt.Sprintf("The host prefix /%d does not fit the cluster network %s. It must be longer than /%d and at most /%d, e.g. /23 for IPv4 or /64 for IPv6.",
+				prefix, cidr.ValueString(), network.Bits(), network.Addr().BitLen()),
+		)
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterResource_ValidateConfig_HostPrefix(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	clusterNetworksType := clusterConfigAttributeType(t, "cluster_networks").(tftypes.List)
+	clusterNetworks := func(networks ...map[string]tftypes.Value) tftypes.Value {
+		elements := make([]tftypes.Value, len(networks))
+		for i, network := range networks {
+			elements[i] = tftypes.NewValue(clusterNetworksType.ElementType, network)
+		}
+		return tftypes.NewValue(clusterNetworksType, elements)
+	}
+	network := func(cidr string, hostPrefix int) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"cidr":        tftypes.NewValue(tftypes.String, cidr),
+			"host_prefix": tftypes.NewValue(tftypes.Number, hostPrefix),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		values         map[string]tftypes.Value
+		expectedErrors []path.Path
+	}{
+		{
+			name: "valid host prefix",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 23),
+			},
+		},
+		{
+			name: "host prefix of the default CIDR",
+			values: map[string]tftypes.Value{
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 12),
+			},
+			expectedErrors: []path.Path{path.Root("cluster_network_host_prefix")},
+		},
+		{
+			name: "host prefix equal to the CIDR prefix",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 14),
+			},
+			expectedErrors: []path.Path{path.Root("cluster_network_host_prefix")},
+		},
+		{
+			name: "host prefix longer than an IPv4 address",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 40),
+			},
+			expectedErrors: []path.Path{path.Root("cluster_network_host_prefix")},
+		},
+		{
+			name: "unknown CIDR",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 40),
+			},
+		},
+		{
+			name: "dual-stack cluster networks",
+			values: map[string]tftypes.Value{
+				"cluster_networks": clusterNetworks(network("10.128.0.0/14", 23), network("fd01::/48", 64)),
+			},
+		},
+		{
+			name: "invalid IPv6 host prefix",
+			values: map[string]tftypes.Value{
+				"cluster_networks": clusterNetworks(network("10.128.0.0/14", 23), network("fd01::/48", 40)),
+			},
+			expectedErrors: []path.Path{path.Root("cluster_networks").AtListIndex(1).AtName("host_prefix")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors, got diagnostics: %+v", len(tt.expectedErrors), resp.Diagnostics)
+			}
+			for i, expected := range tt.expectedErrors {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Errorf("Expected error on %s, got %+v", expected, errs[i])
+				}
+			}
+		})
+	}
+}
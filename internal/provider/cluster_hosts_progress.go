@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+var clusterHostProgressAttrTypes = map[string]attr.Type{
+	"id":                      types.StringType,
+	"hostname":                types.StringType,
+	"current_stage":           types.StringType,
+	"installation_percentage": types.Int64Type,
+}
+
+// ClusterHostProgressModel describes the installation progress of a host of the cluster
+type ClusterHostProgressModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Hostname               types.String `tfsdk:"hostname"`
+	CurrentStage           types.String `tfsdk:"current_stage"`
+	InstallationPercentage types.Int64  `tfsdk:"installation_percentage"`
+}
+
+// hostsProgressValue converts the hosts of a cluster to the hosts_progress
+// list, ordered by hostname. The list is null while the cluster has no hosts.
+func hostsProgressValue(ctx context.Context, hosts []models.Host) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: clusterHostProgressAttrTypes}
+	if len(hosts) == 0 {
+		return types.ListNull(elemType), nil
+	}
+
+	progress := make([]ClusterHostProgressModel, len(hosts))
+	for i := range hosts {
+		host := &hosts[i]
+		entry := ClusterHostProgressModel{
+			ID:                     types.StringValue(host.ID),
+			Hostname:               types.StringValue(hostDisplayName(host)),
+			CurrentStage:           types.StringNull(),
+			InstallationPercentage: types.Int64Value(0),
+		}
+		if host.Progress != nil {
+			entry.CurrentStage = stringValueOrNull(host.Progress.CurrentStage)
+			entry.InstallationPercentage = types.Int64Value(host.Progress.InstallationPercentage)
+		}
+		progress[i] = entry
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		if a, b := progress[i].Hostname.ValueString(), progress[j].Hostname.ValueString(); a != b {
+			return a < b
+		}
+		return progress[i].ID.ValueString() < progress[j].ID.ValueString()
+	})
+
+	return types.ListValueFrom(ctx, elemType, progress)
+}
+
+// hostProgressTracker follows the hosts of a cluster while it installs,
+// logging each host's stage transitions
+type hostProgressTracker struct {
+	client *client.Client
+	stages map[string]string
+	hosts  []models.Host
+}
+
+func newHostProgressTracker(c *client.Client) *hostProgressTracker {
+	return &hostProgressTracker{
+		client: c,
+		stages: make(map[string]string),
+	}
+}
+
+// poll refreshes the hosts of the cluster from their infra-envs. Failing to
+// list hosts only costs visibility, so errors are logged and the previous
+// hosts are kept.
+func (t *hostProgressTracker) poll(ctx context.Context, cluster *models.Cluster) {
+	infraEnvIDs := make(map[string]bool)
+	for _, host := range cluster.Hosts {
+		if host.InfraEnvID != "" {
+			infraEnvIDs[host.InfraEnvID] = true
+		}
+	}
+	if len(infraEnvIDs) == 0 {
+		return
+	}
+
+	var hosts []models.Host
+	for infraEnvID := range infraEnvIDs {
+		infraEnvHosts, err := t.client.ListHosts(ctx, infraEnvID)
+		if err != nil {
+			tflog.Warn(ctx, "Could not list hosts to track installation progress", map[string]interface{}{
+				"cluster_id":   cluster.ID,
+				"infra_env_id": infraEnvID,
+				"error":        err.Error(),
+			})
+			return
+		}
+		for _, host := range infraEnvHosts {
+			if host.ClusterID == cluster.ID {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	for i := range hosts {
+		host := &hosts[i]
+		stage := ""
+		if host.Progress != nil {
+			stage = host.Progress.CurrentStage
+		}
+		if previous, seen := t.stages[host.ID]; stage != "" && (!seen || previous != stage) {
+			tflog.Info(ctx, "Host installation stage changed", map[string]interface{}{
+				"cluster_id":              cluster.ID,
+				"host_id":                 host.ID,
+				"hostname":                hostDisplayName(host),
+				"previous_stage":          previous,
+				"current_stage":           stage,
+				"installation_percentage": host.Progress.InstallationPercentage,
+			})
+		}
+		t.stages[host.ID] = stage
+	}
+	t.hosts = hosts
+}
+
+// value returns the last observed progress, falling back to the hosts of the
+// cluster when the tracker has not listed any
+func (t *hostProgressTracker) value(ctx context.Context, cluster *models.Cluster) (types.List, diag.Diagnostics) {
+	if len(t.hosts) > 0 || cluster == nil {
+		return hostsProgressValue(ctx, t.hosts)
+	}
+	return hostsProgressValue(ctx, cluster.Hosts)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+const testIgnitionCACert = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUTestCertificateForIgnitionEndpoint
+-----END CERTIFICATE-----`
+
+func TestClusterResource_IgnitionEndpoint_modelToCreateParams(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:             StringValue("test-cluster"),
+		OpenshiftVersion: StringValue("4.15.20"),
+		PullSecret:       StringValue("pull-secret"),
+		IgnitionEndpoint: createIgnitionEndpointObject(IgnitionEndpointModel{
+			URL:       StringValue("https://ignition.example.com/config"),
+			CACertPEM: StringValue(testIgnitionCACert),
+		}),
+	}
+
+	result := resource.modelToCreateParams(model)
+
+	if result.IgnitionEndpoint == nil {
+		t.Fatal("Expected ignition_endpoint to be set on create params")
+	}
+	if result.IgnitionEndpoint.URL != "https://ignition.example.com/config" {
+		t.Errorf("Expected url to be forwarded, got %q", result.IgnitionEndpoint.URL)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result.IgnitionEndpoint.CACertificate)
+	if err != nil {
+		t.Fatalf("Expected ca_certificate to be base64 encoded: %v", err)
+	}
+	if string(decoded) != normalizePEMCertificate(testIgnitionCACert) {
+		t.Errorf("Expected decoded certificate to match PEM, got %q", string(decoded))
+	}
+
+	update := resource.modelToUpdateParams(model)
+	if update.IgnitionEndpoint == nil || update.IgnitionEndpoint.CACertificate != result.IgnitionEndpoint.CACertificate {
+		t.Errorf("Expected ignition_endpoint to be included in update params, got %+v", update.IgnitionEndpoint)
+	}
+}
+
+func TestClusterResource_IgnitionEndpoint_Unset(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:             StringValue("test-cluster"),
+		OpenshiftVersion: StringValue("4.15.20"),
+		PullSecret:       StringValue("pull-secret"),
+	}
+
+	if result := resource.modelToCreateParams(model); result.IgnitionEndpoint != nil {
+		t.Errorf("Expected no ignition_endpoint, got %+v", result.IgnitionEndpoint)
+	}
+
+	// A cluster without an ignition endpoint must leave the block null
+	resource.updateModelFromCluster(&model, &models.Cluster{ID: "cluster-id", IgnitionEndpoint: &models.IgnitionEndpoint{}})
+	if !model.IgnitionEndpoint.IsNull() {
+		t.Errorf("Expected ignition_endpoint to remain null, got %v", model.IgnitionEndpoint)
+	}
+}
+
+func TestClusterResource_IgnitionEndpoint_RoundTrip(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:             StringValue("test-cluster"),
+		OpenshiftVersion: StringValue("4.15.20"),
+		PullSecret:       StringValue("pull-secret"),
+		IgnitionEndpoint: createIgnitionEndpointObject(IgnitionEndpointModel{
+			URL:       StringValue("https://ignition.example.com/config"),
+			CACertPEM: StringValue(testIgnitionCACert),
+		}),
+	}
+
+	// Simulate the API echoing the create request back as the cluster
+	body, err := json.Marshal(resource.modelToCreateParams(model))
+	if err != nil {
+		t.Fatalf("Failed to marshal create params: %v", err)
+	}
+	var cluster models.Cluster
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		t.Fatalf("Failed to unmarshal cluster: %v", err)
+	}
+
+	resource.updateModelFromCluster(&model, &cluster)
+
+	var endpoint IgnitionEndpointModel
+	if diags := model.IgnitionEndpoint.As(context.Background(), &endpoint, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("Failed to read ignition_endpoint: %v", diags)
+	}
+
+	if endpoint.URL.ValueString() != "https://ignition.example.com/config" {
+		t.Errorf("Expected url to round-trip, got %q", endpoint.URL.ValueString())
+	}
+	if endpoint.CACertPEM.ValueString() != testIgnitionCACert {
+		t.Errorf("Expected ca_cert_pem to round-trip unchanged, got %q", endpoint.CACertPEM.ValueString())
+	}
+}
+
+// Helper function to create an ignition endpoint object for testing
+func createIgnitionEndpointObject(endpoint IgnitionEndpointModel) types.Object {
+	objValue, _ := types.ObjectValueFrom(context.Background(), ignitionEndpointAttrTypes, endpoint)
+	return objValue
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// emptyInstallConfigOverrides is sent to remove the overrides of a cluster,
+// the service rejects an empty document
+const emptyInstallConfigOverrides = "{}"
+
+// applyInstallConfigOverrides sends the configured install-config overrides
+// to the cluster, or removes them when unset, and records them on cluster so
+// that the state reflects what was sent
+func (r *ClusterResource) applyInstallConfigOverrides(ctx context.Context, cluster *models.Cluster, data ClusterResourceModel) error {
+	overrides := emptyInstallConfigOverrides
+	if !data.InstallConfigOverrides.IsNull() && !data.InstallConfigOverrides.IsUnknown() {
+		overrides = data.InstallConfigOverrides.ValueString()
+	}
+
+	tflog.Info(ctx, "Updating cluster install-config overrides", map[string]interface{}{
+		"id": cluster.ID,
+	})
+
+	if err := r.client.UpdateInstallConfig(ctx, cluster.ID, overrides); err != nil {
+		return err
+	}
+
+	cluster.InstallConfigOverrides = overrides
+	return nil
+}
+
+// installConfigOverridesValue returns the install-config overrides of the
+// cluster, keeping the configured value when the service only reformatted the
+// JSON. Removed overrides are stored as an empty document and read as null.
+func installConfigOverridesValue(configured types.String, overrides string) types.String {
+	if strings.TrimSpace(overrides) == "" || jsonEquivalent(overrides, emptyInstallConfigOverrides) {
+		return types.StringNull()
+	}
+	if !configured.IsNull() && !configured.IsUnknown() && jsonEquivalent(configured.ValueString(), overrides) {
+		return configured
+	}
+	return types.StringValue(overrides)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testInstallConfigOverrides = `{"fips": true, "capabilities": {"baselineCapabilitySet": "None"}}`
+
+func TestInstallConfigOverridesValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		overrides  string
+		expected   types.String
+	}{
+		{
+			name:       "none",
+			configured: types.StringNull(),
+			expected:   types.StringNull(),
+		},
+		{
+			name:       "removed",
+			configured: types.StringNull(),
+			overrides:  "{}",
+			expected:   types.StringNull(),
+		},
+		{
+			name:       "reformatted by the service",
+			configured: types.StringValue(testInstallConfigOverrides),
+			overrides:  `{"capabilities":{"baselineCapabilitySet":"None"},"fips":true}`,
+			expected:   types.StringValue(testInstallConfigOverrides),
+		},
+		{
+			name:       "changed outside terraform",
+			configured: types.StringValue(testInstallConfigOverrides),
+			overrides:  `{"fips":false}`,
+			expected:   types.StringValue(`{"fips":false}`),
+		},
+		{
+			name:       "imported",
+			configured: types.StringNull(),
+			overrides:  `{"fips":true}`,
+			expected:   types.StringValue(`{"fips":true}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := installConfigOverridesValue(tt.configured, tt.overrides)
+			if !value.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestClusterResource_InstallConfigOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters":
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Name: "test-cluster", OpenshiftVersion: "4.16", Status: "insufficient"})
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/clusters/test-cluster-id":
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Name: "test-cluster", OpenshiftVersion: "4.16", Status: "insufficient"})
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/clusters/test-cluster-id/install-config":
+			var overrides string
+			if err := json.NewDecoder(r.Body).Decode(&overrides); err != nil {
+				t.Errorf("Failed to decode install-config overrides: %v", err)
+			}
+			sent = append(sent, overrides)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ClusterResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"name":                     tftypes.NewValue(tftypes.String, "test-cluster"),
+		"openshift_version":        tftypes.NewValue(tftypes.String, "4.16"),
+		"pull_secret":              tftypes.NewValue(tftypes.String, "pull-secret"),
+		"install_config_overrides": tftypes.NewValue(tftypes.String, testInstallConfigOverrides),
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", createResp.Diagnostics)
+	}
+
+	if len(sent) != 1 || sent[0] != testInstallConfigOverrides {
+		t.Fatalf("Expected the overrides to be sent after create, got %q", sent)
+	}
+
+	var data ClusterResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &data)...)
+	if data.InstallConfigOverrides.ValueString() != testInstallConfigOverrides {
+		t.Errorf("Expected install_config_overrides in state, got %s", data.InstallConfigOverrides)
+	}
+
+	// Removing the overrides sends an empty document
+	removed := createResp.State
+	if diags := removed.SetAttribute(ctx, path.Root("install_config_overrides"), types.StringNull()); diags.HasError() {
+		t.Fatalf("Failed to build plan: %+v", diags)
+	}
+	resp := &resource.UpdateResponse{State: removed}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: removed.Schema, Raw: removed.Raw},
+		State: createResp.State,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if len(sent) != 2 || sent[1] != "{}" {
+		t.Fatalf("Expected the overrides to be removed on update, got %q", sent)
+	}
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if !data.InstallConfigOverrides.IsNull() {
+		t.Errorf("Expected install_config_overrides to be null, got %s", data.InstallConfigOverrides)
+	}
+}
@@ -2,23 +2,81 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 )
 
 var _ resource.Resource = &ClusterInstallationResource{}
+var _ resource.ResourceWithImportState = &ClusterInstallationResource{}
+
+// installationPollInterval is how often the cluster is polled while waiting,
+// unless poll_interval is set
+var installationPollInterval = 30 * time.Second
+
+// minPollInterval keeps a configured poll_interval from flooding the API
+const minPollInterval = 5 * time.Second
+
+// clusterPreInstallStatuses are the cluster statuses before an installation
+// has been triggered
+var clusterPreInstallStatuses = map[string]bool{
+	"insufficient":      true,
+	"ready":             true,
+	"pending-for-input": true,
+}
+
+// clusterInstallingStatuses are the cluster statuses once an installation
+// has been triggered and is progressing
+var clusterInstallingStatuses = map[string]bool{
+	"preparing-for-installation":     true,
+	"installing":                     true,
+	"installing-pending-user-action": true,
+	"finalizing":                     true,
+	"installed":                      true,
+}
+
+// validationFailurePolls is how many consecutive polls blocking validations
+// must fail, once the expected hosts are discovered, before the wait for the
+// cluster to be ready is given up. Validations flap while hosts settle.
+var validationFailurePolls = 3
+
+// installTriggerAttempts bounds how often the install action is sent
+const installTriggerAttempts = 3
+
+// installRetryWait is the wait between install attempts, with up to 50% jitter
+var installRetryWait = 10 * time.Second
+
+// operatorStatusAvailable is the monitored operator status once it is installed
+const operatorStatusAvailable = "available"
+
+var finalizingOperatorAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"namespace":   types.StringType,
+	"version":     types.StringType,
+	"status":      types.StringType,
+	"status_info": types.StringType,
+}
 
 func NewClusterInstallationResource() resource.Resource {
 	return &ClusterInstallationResource{}
@@ -29,15 +87,34 @@ type ClusterInstallationResource struct {
 }
 
 type ClusterInstallationResourceModel struct {
-	Timeouts           timeouts.Value `tfsdk:"timeouts"`
-	ID                 types.String   `tfsdk:"id"`
-	ClusterID          types.String   `tfsdk:"cluster_id"`
-	WaitForHosts       types.Bool     `tfsdk:"wait_for_hosts"`
-	ExpectedHostCount  types.Int64    `tfsdk:"expected_host_count"`
-	Status             types.String   `tfsdk:"status"`
-	StatusInfo         types.String   `tfsdk:"status_info"`
-	InstallStartedAt   types.String   `tfsdk:"install_started_at"`
-	InstallCompletedAt types.String   `tfsdk:"install_completed_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	ID                  types.String   `tfsdk:"id"`
+	ClusterID           types.String   `tfsdk:"cluster_id"`
+	WaitForHosts        types.Bool     `tfsdk:"wait_for_hosts"`
+	FailOnValidation    types.Bool     `tfsdk:"fail_on_validation_errors"`
+	VerifyDNS           types.Bool     `tfsdk:"verify_dns"`
+	ExpectedHostCount   types.Int64    `tfsdk:"expected_host_count"`
+	WaitForCompletion   types.Bool     `tfsdk:"wait_for_completion"`
+	PollInterval        types.String   `tfsdk:"poll_interval"`
+	CancelOnDestroy     types.Bool     `tfsdk:"cancel_on_destroy"`
+	APIVips             types.List     `tfsdk:"api_vips"`
+	IngressVips         types.List     `tfsdk:"ingress_vips"`
+	MachineNetworks     types.List     `tfsdk:"machine_networks"`
+	Status              types.String   `tfsdk:"status"`
+	StatusInfo          types.String   `tfsdk:"status_info"`
+	InstallStartedAt    types.String   `tfsdk:"install_started_at"`
+	InstallCompletedAt  types.String   `tfsdk:"install_completed_at"`
+	FinalizingOperators types.List     `tfsdk:"finalizing_operators"`
+	HostsProgress       types.List     `tfsdk:"hosts_progress"`
+}
+
+// FinalizingOperatorModel describes the state of an operator monitored during finalizing
+type FinalizingOperatorModel struct {
+	Name       types.String `tfsdk:"name"`
+	Namespace  types.String `tfsdk:"namespace"`
+	Version    types.String `tfsdk:"version"`
+	Status     types.String `tfsdk:"status"`
+	StatusInfo types.String `tfsdk:"status_info"`
 }
 
 func (r *ClusterInstallationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,12 +158,60 @@ Example usage with separate modules:
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"fail_on_validation_errors": schema.BoolAttribute{
+				MarkdownDescription: "Whether to stop waiting for the cluster to be ready, and fail, when blocking cluster validations keep failing after the expected hosts are discovered. The error lists the failing validations. When false the resource waits until the timeout. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"verify_dns": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check, before triggering the installation, that the API (`api.<name>.<base_dns_domain>`) and ingress (`*.apps.<name>.<base_dns_domain>`) DNS names resolve, and fail with the names that do not. The lookups reported by the hosts are used, names the hosts have not looked up are resolved by the provider. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"expected_host_count": schema.Int64Attribute{
 				MarkdownDescription: "Number of hosts expected to be discovered before installation can begin. Required if wait_for_hosts is true. Defaults to 3 for multi-node clusters.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(3),
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the installation to complete. When false the resource returns as soon as installation has been triggered; use the `openshift_assisted_installer_cluster_installation_status` data source to wait for completion separately. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How often the cluster is polled while waiting for hosts, for the cluster to be ready and for the installation to complete, as a duration such as `10s` or `1m`. Must be at least `5s`. Defaults to `30s`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30s"),
+				Validators: []validator.String{
+					validDuration(minPollInterval),
+				},
+			},
+			"cancel_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource cancels an installation that is still running and resets the cluster, so it can be installed again. Failed and cancelled installations are reset too, installed clusters are left as they are. Defaults to false, destroying the resource leaves the cluster untouched.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"api_vips": schema.ListAttribute{
+				MarkdownDescription: "API virtual IPs to set once the expected hosts are discovered, before installation is triggered. Use this instead of `api_vips` on the cluster when the VIPs depend on the network the hosts are on.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ingress_vips": schema.ListAttribute{
+				MarkdownDescription: "Ingress virtual IPs to set once the expected hosts are discovered, before installation is triggered.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"machine_networks": schema.ListAttribute{
+				MarkdownDescription: "Machine network CIDRs to set once the expected hosts are discovered, before installation is triggered.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Current installation status",
 				Computed:            true,
@@ -103,6 +228,58 @@ Example usage with separate modules:
 				MarkdownDescription: "Timestamp when installation completed",
 				Computed:            true,
 			},
+			"finalizing_operators": schema.ListNestedAttribute{
+				MarkdownDescription: "Operators monitored while the cluster is finalizing, with their installation state. Operators whose status is not `available` are still pending.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Operator name",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace the operator is installed in",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Operator version",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Operator status (progressing, available, failed)",
+							Computed:            true,
+						},
+						"status_info": schema.StringAttribute{
+							MarkdownDescription: "Detailed operator status information",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"hosts_progress": schema.ListNestedAttribute{
+				MarkdownDescription: "Installation progress of each host of the cluster, ordered by hostname. Refreshed while waiting for the installation to complete, with stage transitions logged, so hosts stuck in a stage can be identified.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Host ID",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Host name, the host ID when the host reports none",
+							Computed:            true,
+						},
+						"current_stage": schema.StringAttribute{
+							MarkdownDescription: "Current installation stage of the host, such as `Writing image to disk` or `Rebooting`",
+							Computed:            true,
+						},
+						"installation_percentage": schema.Int64Attribute{
+							MarkdownDescription: "Installation progress of the host in percent",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -142,9 +319,11 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 	defer cancel()
 
 	clusterID := data.ClusterID.ValueString()
+	interval := pollInterval(data.PollInterval)
 
 	// Set the ID immediately (same as cluster ID for this resource)
 	data.ID = types.StringValue(clusterID)
+	data.HostsProgress = types.ListNull(types.ObjectType{AttrTypes: clusterHostProgressAttrTypes})
 
 	tflog.Info(ctx, "Starting cluster installation", map[string]interface{}{
 		"cluster_id": clusterID,
@@ -168,6 +347,10 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 		data.Status = types.StringValue(cluster.Status)
 		data.StatusInfo = types.StringValue(cluster.StatusInfo)
 		data.InstallCompletedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+		resp.Diagnostics.Append(diags...)
+		data.HostsProgress, diags = hostsProgressValue(ctx, cluster.Hosts)
+		resp.Diagnostics.Append(diags...)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -177,16 +360,58 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 			"cluster_id": clusterID,
 			"status":     cluster.Status,
 		})
+		data.InstallStartedAt = timestampValue(cluster.InstallStartedAt)
 	} else {
+		expectedHosts := int(data.ExpectedHostCount.ValueInt64())
+
+		// Networking that depends on the hosts is applied once they are discovered
+		var apiVips, ingressVips, machineNetworks []string
+		resp.Diagnostics.Append(data.APIVips.ElementsAs(ctx, &apiVips, false)...)
+		resp.Diagnostics.Append(data.IngressVips.ElementsAs(ctx, &ingressVips, false)...)
+		resp.Diagnostics.Append(data.MachineNetworks.ElementsAs(ctx, &machineNetworks, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(apiVips) > 0 || len(ingressVips) > 0 || len(machineNetworks) > 0 {
+			if data.WaitForHosts.ValueBool() {
+				err = r.waitForHostsDiscovered(ctx, clusterID, expectedHosts, interval)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error waiting for hosts",
+						fmt.Sprintf("Hosts of cluster %s were not discovered: %s", clusterID, err),
+					)
+					return
+				}
+			}
+
+			tflog.Info(ctx, "Applying cluster networking after host discovery", map[string]interface{}{
+				"cluster_id":       clusterID,
+				"api_vips":         apiVips,
+				"ingress_vips":     ingressVips,
+				"machine_networks": machineNetworks,
+			})
+
+			networkingCtx, deprecations := client.CollectDeprecations(ctx)
+			_, err = r.client.UpdateClusterNetworking(networkingCtx, clusterID, apiVips, ingressVips, machineNetworks)
+			addDeprecationWarnings(deprecations, &resp.Diagnostics)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating cluster networking",
+					fmt.Sprintf("Could not set the networking of cluster %s: %s", clusterID, err),
+				)
+				return
+			}
+		}
+
 		// Wait for hosts if requested
 		if data.WaitForHosts.ValueBool() {
-			expectedHosts := int(data.ExpectedHostCount.ValueInt64())
 			tflog.Info(ctx, "Waiting for hosts to be ready", map[string]interface{}{
 				"cluster_id":     clusterID,
 				"expected_hosts": expectedHosts,
 			})
 
-			err = r.waitForClusterReady(ctx, clusterID, expectedHosts)
+			err = r.waitForClusterReady(ctx, clusterID, expectedHosts, data.FailOnValidation.ValueBool(), interval)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error waiting for cluster to be ready",
@@ -196,6 +421,22 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 			}
 		}
 
+		// Installations without DNS records fail late, once the API is expected to be reachable
+		if data.VerifyDNS.ValueBool() {
+			resp.Diagnostics.Append(r.checkDNSResolution(ctx, clusterID)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Operators fail late in the installation when the hosts cannot run them
+		if len(cluster.OLMOperators) > 0 {
+			resp.Diagnostics.Append(r.checkOperatorRequirements(ctx, clusterID)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		// Trigger installation
 		tflog.Info(ctx, "Triggering cluster installation", map[string]interface{}{
 			"cluster_id": clusterID,
@@ -203,7 +444,7 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 
 		data.InstallStartedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
-		err = r.client.InstallCluster(ctx, clusterID)
+		err = triggerInstallation(ctx, r.client, clusterID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error triggering installation",
@@ -213,22 +454,70 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 		}
 	}
 
+	if !data.WaitForCompletion.ValueBool() {
+		// Return without waiting, completion is tracked by the installation status data source
+		cluster, err = r.client.GetCluster(ctx, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error retrieving cluster after triggering installation",
+				fmt.Sprintf("Could not get cluster %s after triggering installation: %s", clusterID, err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Cluster installation triggered, not waiting for completion", map[string]interface{}{
+			"cluster_id": clusterID,
+			"status":     cluster.Status,
+		})
+
+		data.Status = types.StringValue(cluster.Status)
+		data.StatusInfo = types.StringValue(cluster.StatusInfo)
+		data.InstallCompletedAt = timestampValue(cluster.InstallCompletedAt)
+		data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+		resp.Diagnostics.Append(diags...)
+		data.HostsProgress, diags = hostsProgressValue(ctx, cluster.Hosts)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	// Wait for installation to complete
 	tflog.Info(ctx, "Waiting for installation to complete", map[string]interface{}{
 		"cluster_id": clusterID,
 		"timeout":    createTimeout.String(),
 	})
 
-	err = r.waitForInstallationComplete(ctx, clusterID, createTimeout)
+	progress := newHostProgressTracker(r.client)
+	err = waitForInstallationComplete(ctx, r.client, clusterID, createTimeout, interval, progress)
 	if err != nil {
-		// Still save state even if installation fails/times out
-		cluster, _ = r.client.GetCluster(ctx, clusterID)
-		data.Status = types.StringValue(cluster.Status)
-		data.StatusInfo = types.StringValue(cluster.StatusInfo)
+		// Still save state even if installation fails/times out. The wait
+		// context may have expired, so use a fresh one for the final read.
+		readCtx, readCancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+		defer readCancel()
+
+		currentStatus := "unknown"
+		data.FinalizingOperators = types.ListNull(types.ObjectType{AttrTypes: finalizingOperatorAttrTypes})
+		// GetCluster returns no cluster on error
+		cluster, _ := r.client.GetCluster(readCtx, clusterID)
+
+		// The last observed host progress shows which hosts held up the installation
+		data.HostsProgress, diags = progress.value(ctx, cluster)
+		resp.Diagnostics.Append(diags...)
+		if cluster != nil {
+			currentStatus = cluster.Status
+			data.Status = types.StringValue(cluster.Status)
+			data.StatusInfo = types.StringValue(cluster.StatusInfo)
+			data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+			resp.Diagnostics.Append(diags...)
+
+			if pending := pendingOperators(cluster); cluster.Status == "finalizing" && len(pending) > 0 {
+				err = fmt.Errorf("%w (operators still pending: %s)", err, strings.Join(pending, ", "))
+			}
+		}
 
 		resp.Diagnostics.AddError(
 			"Installation did not complete",
-			fmt.Sprintf("Cluster %s installation did not complete: %s. Current status: %s", clusterID, err, cluster.Status),
+			fmt.Sprintf("Cluster %s installation did not complete: %s. Current status: %s", clusterID, err, currentStatus),
 		)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
@@ -247,6 +536,10 @@ func (r *ClusterInstallationResource) Create(ctx context.Context, req resource.C
 	data.Status = types.StringValue(cluster.Status)
 	data.StatusInfo = types.StringValue(cluster.StatusInfo)
 	data.InstallCompletedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+	resp.Diagnostics.Append(diags...)
+	data.HostsProgress, diags = progress.value(ctx, cluster)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Info(ctx, "Cluster installation completed successfully", map[string]interface{}{
 		"cluster_id": clusterID,
@@ -267,6 +560,11 @@ func (r *ClusterInstallationResource) Read(ctx context.Context, req resource.Rea
 
 	cluster, err := r.client.GetCluster(ctx, clusterID)
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Cluster not found", fmt.Sprintf("Cluster %s no longer exists and its installation will be removed from state", clusterID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading cluster",
 			fmt.Sprintf("Could not read cluster %s: %s", clusterID, err),
@@ -277,28 +575,177 @@ func (r *ClusterInstallationResource) Read(ctx context.Context, req resource.Rea
 	data.Status = types.StringValue(cluster.Status)
 	data.StatusInfo = types.StringValue(cluster.StatusInfo)
 
+	// Prefer the timestamps the service recorded, imported installations have none in state
+	if !cluster.InstallStartedAt.IsZero() {
+		data.InstallStartedAt = timestampValue(cluster.InstallStartedAt)
+	}
+	if !cluster.InstallCompletedAt.IsZero() {
+		data.InstallCompletedAt = timestampValue(cluster.InstallCompletedAt)
+	}
+
+	var diags diag.Diagnostics
+	data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+	resp.Diagnostics.Append(diags...)
+	data.HostsProgress, diags = hostsProgressValue(ctx, cluster.Hosts)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ClusterInstallationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Installation cannot be updated - it's a one-time action
-	resp.Diagnostics.AddError(
-		"Installation cannot be updated",
-		"The cluster installation is a one-time action and cannot be modified. To reinstall, delete and recreate the installation resource.",
-	)
+	// The installation is a one-time action and changing cluster_id replaces
+	// the resource, so the remaining settings only apply to a new installation.
+	// Record them without installing again, keeping the observed state.
+	var data, state ClusterInstallationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.Status = state.Status
+	data.StatusInfo = state.StatusInfo
+	data.InstallStartedAt = state.InstallStartedAt
+	data.InstallCompletedAt = state.InstallCompletedAt
+	data.FinalizingOperators = state.FinalizingOperators
+	data.HostsProgress = state.HostsProgress
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ClusterInstallationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Deletion is a no-op - we don't uninstall clusters
-	// The cluster itself is managed by the cluster resource
-	tflog.Info(ctx, "Cluster installation resource deleted (no-op - cluster remains installed)")
+	var data ClusterInstallationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We don't uninstall clusters, the cluster itself is managed by the cluster resource
+	if !data.CancelOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Cluster installation resource deleted (no-op - cluster remains installed)")
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+
+	cluster, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading cluster",
+			fmt.Sprintf("Could not read cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	status := cluster.Status
+	if clusterInstallingStatuses[status] && status != "installed" {
+		tflog.Info(ctx, "Cancelling cluster installation", map[string]interface{}{
+			"cluster_id": clusterID,
+			"status":     status,
+		})
+		if err := r.client.CancelClusterInstallation(ctx, clusterID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error cancelling installation",
+				fmt.Sprintf("Could not cancel the installation of cluster %s: %s", clusterID, err),
+			)
+			return
+		}
+		status = "cancelled"
+	}
+
+	if status == "cancelled" || status == "error" {
+		tflog.Info(ctx, "Resetting cluster installation", map[string]interface{}{
+			"cluster_id": clusterID,
+			"status":     status,
+		})
+		if err := r.client.ResetCluster(ctx, clusterID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error resetting cluster",
+				fmt.Sprintf("Could not reset cluster %s: %s", clusterID, err),
+			)
+		}
+	}
+}
+
+// ImportState imports the installation of a cluster by cluster ID. Only
+// clusters whose installation has been triggered can be imported, the
+// installation is never started by an import.
+func (r *ClusterInstallationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cluster, err := r.client.GetCluster(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading cluster",
+			fmt.Sprintf("Could not read cluster %s: %s", req.ID, err),
+		)
+		return
+	}
+
+	if clusterPreInstallStatuses[cluster.Status] {
+		resp.Diagnostics.AddError(
+			"Cluster Installation Not Started",
+			fmt.Sprintf("Cluster %s is in status %s and has not been installed. Create the installation resource instead of importing it.", req.ID, cluster.Status),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), req.ID)...)
+
+	// Defaults are not applied on import, set them so a default configuration shows no changes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_hosts"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("expected_host_count"), int64(3))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("poll_interval"), "30s")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fail_on_validation_errors"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cancel_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_dns"), false)...)
+}
+
+// waitForHostsDiscovered polls the cluster until the expected number of hosts
+// are registered, whatever their status
+func (r *ClusterInstallationResource) waitForHostsDiscovered(ctx context.Context, clusterID string, expectedHosts int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while waiting for hosts to be discovered")
+		case <-ticker.C:
+			cluster, err := r.client.GetCluster(ctx, clusterID)
+			if err != nil {
+				return fmt.Errorf("failed to get cluster status: %w", err)
+			}
+
+			tflog.Debug(ctx, "Checking discovered hosts", map[string]interface{}{
+				"cluster_id":     clusterID,
+				"host_count":     cluster.HostCount,
+				"expected_hosts": expectedHosts,
+			})
+
+			if cluster.HostCount >= expectedHosts {
+				return nil
+			}
+
+			if cluster.Status == "error" {
+				return fmt.Errorf("cluster is in error state: %s", cluster.StatusInfo)
+			}
+		}
+	}
 }
 
 // Helper function to wait for cluster to be ready for installation
-func (r *ClusterInstallationResource) waitForClusterReady(ctx context.Context, clusterID string, expectedHosts int) error {
-	ticker := time.NewTicker(30 * time.Second)
+func (r *ClusterInstallationResource) waitForClusterReady(ctx context.Context, clusterID string, expectedHosts int, failOnValidation bool, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	var failingPolls int
 	for {
 		select {
 		case <-ctx.Done():
@@ -331,13 +778,106 @@ func (r *ClusterInstallationResource) waitForClusterReady(ctx context.Context, c
 			if cluster.Status == "error" {
 				return fmt.Errorf("cluster is in error state: %s", cluster.StatusInfo)
 			}
+
+			// Validations fail while hosts are still being discovered, so they
+			// are only checked once all expected hosts are there
+			if !failOnValidation || cluster.HostCount < expectedHosts {
+				failingPolls = 0
+				continue
+			}
+
+			validations, err := r.client.GetClusterValidations(ctx, clusterID)
+			if err != nil {
+				return fmt.Errorf("failed to get cluster validations: %w", err)
+			}
+
+			failing := failingBlockingValidations(validations.ValidationsInfo)
+			if len(failing) == 0 {
+				failingPolls = 0
+				continue
+			}
+
+			failingPolls++
+			tflog.Debug(ctx, "Blocking cluster validations are failing", map[string]interface{}{
+				"cluster_id":  clusterID,
+				"validations": failing,
+				"polls":       failingPolls,
+			})
+			if failingPolls >= validationFailurePolls {
+				return fmt.Errorf("blocking validations are failing:\n  - %s", strings.Join(failing, "\n  - "))
+			}
+		}
+	}
+}
+
+// failingBlockingValidations describes the failing blocking validations as
+// "<validation id>: <message>", ordered by validation group
+func failingBlockingValidations(groups models.ValidationGroups) []string {
+	var failing []string
+	for _, groupName := range sortedValidationGroups(groups) {
+		for _, validation := range groups[groupName] {
+			result := classifyValidation(validation)
+			if validation.Status != string(models.ValidationStatusFailure) || result.ValidationType != "blocking" {
+				continue
+			}
+			failing = append(failing, fmt.Sprintf("%s: %s", result.ValidationID, validation.Message))
+		}
+	}
+	return failing
+}
+
+// triggerInstallation sends the install action. A cluster that has just
+// changed state can briefly reject it, so transient failures are retried a
+// few times with jitter. The cluster is checked between attempts and no
+// further attempt is made once it has started installing.
+func triggerInstallation(ctx context.Context, c *client.Client, clusterID string) error {
+	for attempt := 1; ; attempt++ {
+		err := c.InstallCluster(ctx, clusterID)
+		if err == nil || attempt >= installTriggerAttempts || !retryableInstallError(err) {
+			return err
+		}
+
+		tflog.Warn(ctx, "Triggering cluster installation failed, retrying", map[string]interface{}{
+			"cluster_id": clusterID,
+			"attempt":    attempt,
+			"error":      err.Error(),
+		})
+
+		wait := installRetryWait/2 + time.Duration(rand.Int63n(int64(installRetryWait/2)+1))
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		cluster, getErr := c.GetCluster(ctx, clusterID)
+		if getErr == nil && clusterInstallingStatuses[cluster.Status] {
+			tflog.Info(ctx, "Cluster started installing after a failed install request", map[string]interface{}{
+				"cluster_id": clusterID,
+				"status":     cluster.Status,
+			})
+			return nil
 		}
 	}
 }
 
-// Helper function to wait for installation to complete
-func (r *ClusterInstallationResource) waitForInstallationComplete(ctx context.Context, clusterID string, timeout time.Duration) error {
-	ticker := time.NewTicker(30 * time.Second)
+// retryableInstallError reports whether an install request may succeed when
+// sent again: network errors, a conflict with a state transition in progress
+// and server errors. Other API errors, such as failed validations, are final.
+func retryableInstallError(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	return apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// waitForInstallationComplete polls the cluster until it is installed, fails or the timeout expires.
+// When progress is set, the hosts of the cluster are tracked on every poll.
+func waitForInstallationComplete(ctx context.Context, c *client.Client, clusterID string, timeout, interval time.Duration, progress *hostProgressTracker) error {
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	deadline := time.Now().Add(timeout)
@@ -351,7 +891,7 @@ func (r *ClusterInstallationResource) waitForInstallationComplete(ctx context.Co
 				return fmt.Errorf("installation timeout exceeded (%v)", timeout)
 			}
 
-			cluster, err := r.client.GetCluster(ctx, clusterID)
+			cluster, err := c.GetCluster(ctx, clusterID)
 			if err != nil {
 				return fmt.Errorf("failed to get cluster status: %w", err)
 			}
@@ -362,14 +902,25 @@ func (r *ClusterInstallationResource) waitForInstallationComplete(ctx context.Co
 				"status_info": cluster.StatusInfo,
 			})
 
+			if progress != nil {
+				progress.poll(ctx, cluster)
+			}
+
 			switch cluster.Status {
 			case "installed":
 				return nil
 			case "error", "cancelled":
 				return fmt.Errorf("installation failed with status %s: %s", cluster.Status, cluster.StatusInfo)
-			case "installing", "finalizing":
+			case "installing":
 				// Continue waiting
 				continue
+			case "finalizing":
+				// Operators are installed while finalizing, report which are pending
+				tflog.Info(ctx, "Cluster finalizing", map[string]interface{}{
+					"cluster_id":        clusterID,
+					"pending_operators": pendingOperators(cluster),
+				})
+				continue
 			default:
 				tflog.Warn(ctx, "Unexpected cluster status during installation", map[string]interface{}{
 					"status": cluster.Status,
@@ -378,3 +929,56 @@ func (r *ClusterInstallationResource) waitForInstallationComplete(ctx context.Co
 		}
 	}
 }
+
+// finalizingOperatorsFromCluster converts the cluster's monitored operators to the finalizing_operators list
+func finalizingOperatorsFromCluster(ctx context.Context, cluster *models.Cluster) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: finalizingOperatorAttrTypes}
+	if len(cluster.MonitoredOperators) == 0 {
+		return types.ListNull(elemType), nil
+	}
+
+	operators := make([]FinalizingOperatorModel, len(cluster.MonitoredOperators))
+	for i, op := range cluster.MonitoredOperators {
+		operators[i] = FinalizingOperatorModel{
+			Name:       types.StringValue(op.Name),
+			Namespace:  types.StringValue(op.Namespace),
+			Version:    types.StringValue(op.Version),
+			Status:     types.StringValue(op.Status),
+			StatusInfo: types.StringValue(op.StatusInfo),
+		}
+	}
+
+	return types.ListValueFrom(ctx, elemType, operators)
+}
+
+// pollInterval returns the configured poll_interval, or the default poll
+// interval when it is not set
+func pollInterval(value types.String) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return installationPollInterval
+	}
+	interval, err := time.ParseDuration(value.ValueString())
+	if err != nil || interval <= 0 {
+		return installationPollInterval
+	}
+	return interval
+}
+
+// timestampValue converts an API timestamp to a string, null when the API has not set it
+func timestampValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// pendingOperators returns the names of monitored operators that are not yet available
+func pendingOperators(cluster *models.Cluster) []string {
+	var pending []string
+	for _, op := range cluster.MonitoredOperators {
+		if op.Status != operatorStatusAvailable {
+			pending = append(pending, op.Name)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,812 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFinalizingOperatorsFromCluster(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &models.Cluster{
+		MonitoredOperators: []models.MonitoredOperator{
+			{Name: "lso", Namespace: "openshift-local-storage", Version: "4.15.0", Status: "available"},
+			{Name: "odf", Namespace: "openshift-storage", Status: "progressing", StatusInfo: "installing storage system"},
+		},
+	}
+
+	list, diags := finalizingOperatorsFromCluster(ctx, cluster)
+	if diags.HasError() {
+		t.Fatalf("finalizingOperatorsFromCluster() diagnostics: %+v", diags)
+	}
+
+	var operators []FinalizingOperatorModel
+	list.ElementsAs(ctx, &operators, false)
+	if len(operators) != 2 {
+		t.Fatalf("Expected 2 operators, got %d", len(operators))
+	}
+	if operators[1].Name.ValueString() != "odf" || operators[1].Status.ValueString() != "progressing" || operators[1].StatusInfo.ValueString() != "installing storage system" {
+		t.Errorf("Unexpected operator: %+v", operators[1])
+	}
+
+	if pending := pendingOperators(cluster); len(pending) != 1 || pending[0] != "odf" {
+		t.Errorf("Expected only odf to be pending, got %v", pending)
+	}
+
+	empty, _ := finalizingOperatorsFromCluster(ctx, &models.Cluster{})
+	if !empty.IsNull() {
+		t.Error("Expected null list when there are no monitored operators")
+	}
+}
+
+func TestClusterInstallationResource_Create_Finalizing(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	progressing := []models.MonitoredOperator{
+		{Name: "console", Status: "available"},
+		{Name: "odf", Namespace: "openshift-storage", Status: "progressing", StatusInfo: "waiting for storage cluster"},
+	}
+	available := []models.MonitoredOperator{
+		{Name: "console", Status: "available"},
+		{Name: "odf", Namespace: "openshift-storage", Status: "available"},
+	}
+
+	tests := []struct {
+		name             string
+		finalizingPolls  int32
+		timeout          string
+		expectError      string
+		expectedStatus   string
+		expectedOdfState string
+	}{
+		{
+			name:             "operators complete during finalizing",
+			finalizingPolls:  3,
+			timeout:          "10s",
+			expectedStatus:   "installed",
+			expectedOdfState: "available",
+		},
+		{
+			name:             "timeout while operators are pending",
+			finalizingPolls:  -1,
+			timeout:          "50ms",
+			expectError:      "operators still pending: odf",
+			expectedStatus:   "finalizing",
+			expectedOdfState: "progressing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				cluster := models.Cluster{ID: "test-cluster-id", Status: "finalizing", MonitoredOperators: progressing}
+				if n := atomic.AddInt32(&polls, 1); tt.finalizingPolls >= 0 && n > tt.finalizingPolls {
+					cluster.Status = "installed"
+					cluster.MonitoredOperators = available
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(cluster)
+			}))
+			defer server.Close()
+
+			r := &ClusterInstallationResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			plan := newClusterInstallationPlan(t, r, tt.timeout, true)
+			req := resource.CreateRequest{Plan: plan}
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+
+			r.Create(context.Background(), req, resp)
+
+			if tt.expectError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+			if tt.expectError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %+v", tt.expectError, resp.Diagnostics)
+				}
+			}
+
+			var state ClusterInstallationResourceModel
+			resp.State.Get(context.Background(), &state)
+
+			if state.Status.ValueString() != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s", tt.expectedStatus, state.Status)
+			}
+
+			var operators []FinalizingOperatorModel
+			state.FinalizingOperators.ElementsAs(context.Background(), &operators, false)
+			if len(operators) != 2 {
+				t.Fatalf("Expected 2 finalizing operators, got %d", len(operators))
+			}
+			if operators[1].Status.ValueString() != tt.expectedOdfState {
+				t.Errorf("Expected odf status %s, got %s", tt.expectedOdfState, operators[1].Status)
+			}
+		})
+	}
+}
+
+func TestClusterInstallationResource_Create_WithoutWaiting(t *testing.T) {
+	startedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var installed, polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cluster := models.Cluster{ID: "test-cluster-id", Status: "ready"}
+		if r.Method == http.MethodPost && r.URL.Path == "/v2/clusters/test-cluster-id/actions/install" {
+			atomic.StoreInt32(&installed, 1)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		atomic.AddInt32(&polls, 1)
+		if atomic.LoadInt32(&installed) == 1 {
+			cluster.Status = "preparing-for-installation"
+			cluster.InstallStartedAt = startedAt
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(cluster)
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	plan := newClusterInstallationPlan(t, r, "10s", false)
+	req := resource.CreateRequest{Plan: plan}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+	}
+	if atomic.LoadInt32(&installed) != 1 {
+		t.Fatal("Expected installation to be triggered")
+	}
+	if polls != 2 {
+		t.Errorf("Expected 2 cluster reads without waiting, got %d", polls)
+	}
+
+	var state ClusterInstallationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+
+	if state.Status.ValueString() != "preparing-for-installation" {
+		t.Errorf("Expected status preparing-for-installation, got %s", state.Status)
+	}
+	if !state.InstallCompletedAt.IsNull() {
+		t.Errorf("Expected install_completed_at to be null, got %s", state.InstallCompletedAt)
+	}
+}
+
+// newClusterInstallationPlan builds an installation plan that does not wait for hosts
+func TestClusterInstallationResource_Create_NetworkingAfterDiscovery(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	ctx := context.Background()
+
+	// Hosts register one at a time; the cluster only becomes ready once the
+	// VIPs are set
+	var events []string
+	var hostCount int
+	var networking models.ClusterUpdateParams
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cluster := models.Cluster{ID: "test-cluster-id", Status: "insufficient", HostCount: hostCount}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id":
+			if hostCount < 2 {
+				hostCount++
+			}
+			if len(networking.APIVips) > 0 {
+				cluster.Status = "ready"
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/clusters/test-cluster-id":
+			events = append(events, fmt.Sprintf("update networking with %d hosts", hostCount))
+			if err := json.NewDecoder(r.Body).Decode(&networking); err != nil {
+				t.Errorf("Failed to decode update params: %v", err)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters/test-cluster-id/actions/install":
+			events = append(events, "install")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(cluster)
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	stringList := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			elements[i] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"cluster_id":          tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"wait_for_hosts":      tftypes.NewValue(tftypes.Bool, true),
+		"expected_host_count": tftypes.NewValue(tftypes.Number, 2),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, false),
+		"api_vips":            stringList("192.168.1.100"),
+		"ingress_vips":        stringList("192.168.1.101"),
+		"machine_networks":    stringList("192.168.1.0/24"),
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"status":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"timeouts": tftypes.NewValue(objType.AttributeTypes["timeouts"], map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, "10s"),
+		}),
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	expectedEvents := []string{"update networking with 2 hosts", "install"}
+	if strings.Join(events, ", ") != strings.Join(expectedEvents, ", ") {
+		t.Errorf("Expected events %v, got %v", expectedEvents, events)
+	}
+	if len(networking.APIVips) != 1 || networking.APIVips[0].IP != "192.168.1.100" {
+		t.Errorf("Expected api_vips 192.168.1.100, got %+v", networking.APIVips)
+	}
+	if len(networking.IngressVips) != 1 || networking.IngressVips[0].IP != "192.168.1.101" {
+		t.Errorf("Expected ingress_vips 192.168.1.101, got %+v", networking.IngressVips)
+	}
+	if len(networking.MachineNetworks) != 1 || networking.MachineNetworks[0].CIDR != "192.168.1.0/24" {
+		t.Errorf("Expected machine_networks 192.168.1.0/24, got %+v", networking.MachineNetworks)
+	}
+}
+
+func TestClusterInstallationResource_Create_FailingValidations(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	validationsInfo, err := json.Marshal(models.ValidationGroups{
+		"network": {
+			{ID: "no-cidrs-overlapping", Status: "failure", Message: "The machine network overlaps with the service network"},
+			{ID: "api-vips-defined", Status: "success", Message: "API virtual IPs are defined"},
+		},
+		"configuration": {
+			{ID: "pull-secret-set", Status: "failure", Message: "The pull secret is not set"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal validations: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		failOnValidation bool
+		expectError      string
+	}{
+		{
+			name:             "fails early",
+			failOnValidation: true,
+			expectError:      "blocking validations are failing:\n  - no-cidrs-overlapping: The machine network overlaps with the service network",
+		},
+		{
+			name:        "waits until the timeout",
+			expectError: "did not become ready for installation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Cluster{
+					ID:              "test-cluster-id",
+					Status:          "insufficient",
+					HostCount:       3,
+					ValidationsInfo: string(validationsInfo),
+				})
+			}))
+			defer server.Close()
+
+			r := &ClusterInstallationResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			plan := newClusterInstallationPlan(t, r, "500ms", false)
+			for name, value := range map[string]bool{"wait_for_hosts": true, "fail_on_validation_errors": tt.failOnValidation} {
+				if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+					t.Fatalf("Failed to build plan: %+v", diags)
+				}
+			}
+
+			start := time.Now()
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+				t.Fatalf("Expected error containing %q, got %+v", tt.expectError, resp.Diagnostics)
+			}
+			if strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "pull-secret-set") {
+				t.Errorf("Expected only blocking validations to be listed, got %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if tt.failOnValidation && time.Since(start) >= 500*time.Millisecond {
+				t.Errorf("Expected the wait to end before the timeout, took %s", time.Since(start))
+			}
+		})
+	}
+}
+
+func newClusterInstallationPlan(t *testing.T, r *ClusterInstallationResource, createTimeout string, waitForCompletion bool) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	timeoutsType := objType.AttributeTypes["timeouts"].(tftypes.Object)
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"cluster_id":          tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"wait_for_hosts":      tftypes.NewValue(tftypes.Bool, false),
+		"expected_host_count": tftypes.NewValue(tftypes.Number, 3),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, waitForCompletion),
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"status":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, createTimeout),
+		}),
+	})
+
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestTriggerInstallation(t *testing.T) {
+	originalWait := installRetryWait
+	installRetryWait = time.Millisecond
+	defer func() { installRetryWait = originalWait }()
+
+	tests := []struct {
+		name             string
+		installStatuses  []int
+		clusterStatus    string
+		expectError      bool
+		expectedAttempts int
+	}{
+		{
+			name:             "transient failure then success",
+			installStatuses:  []int{http.StatusServiceUnavailable, http.StatusAccepted},
+			clusterStatus:    "ready",
+			expectedAttempts: 2,
+		},
+		{
+			name:             "cluster started installing after a failure",
+			installStatuses:  []int{http.StatusConflict},
+			clusterStatus:    "preparing-for-installation",
+			expectedAttempts: 1,
+		},
+		{
+			name:             "validation failure is not retried",
+			installStatuses:  []int{http.StatusBadRequest},
+			clusterStatus:    "insufficient",
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "gives up after the last attempt",
+			installStatuses:  []int{http.StatusConflict, http.StatusConflict, http.StatusConflict, http.StatusAccepted},
+			clusterStatus:    "ready",
+			expectError:      true,
+			expectedAttempts: installTriggerAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters/test-cluster-id/actions/install":
+					status := tt.installStatuses[attempts]
+					attempts++
+					w.WriteHeader(status)
+					_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: tt.clusterStatus})
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id":
+					_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: tt.clusterStatus})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c := client.NewClient(client.ClientConfig{
+				BaseURL:      server.URL,
+				OfflineToken: "test-token",
+			})
+
+			err := triggerInstallation(context.Background(), c, "test-cluster-id")
+			if tt.expectError && err == nil {
+				t.Error("Expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Errorf("Expected %d install attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestClusterInstallationResource_ImportState(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2024, 1, 1, 11, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		status      string
+		expectError string
+	}{
+		{name: "installed", status: "installed"},
+		{name: "still installing", status: "installing"},
+		{name: "not started", status: "ready", expectError: "Cluster Installation Not Started"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				cluster := models.Cluster{
+					ID:               "test-cluster-id",
+					Status:           tt.status,
+					StatusInfo:       "Cluster is " + tt.status,
+					InstallStartedAt: startedAt,
+				}
+				if tt.status == "installed" {
+					cluster.InstallCompletedAt = completedAt
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(cluster)
+			}))
+			defer server.Close()
+
+			r := &ClusterInstallationResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			empty := newResourceState(t, r, nil)
+			importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: empty.Schema, Raw: tftypes.NewValue(empty.Raw.Type(), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: "test-cluster-id"}, importResp)
+
+			if tt.expectError != "" {
+				if !importResp.Diagnostics.HasError() || importResp.Diagnostics.Errors()[0].Summary() != tt.expectError {
+					t.Fatalf("Expected %s error, got %+v", tt.expectError, importResp.Diagnostics)
+				}
+				return
+			}
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("ImportState() returned diagnostics: %+v", importResp.Diagnostics)
+			}
+
+			readResp := &resource.ReadResponse{State: importResp.State}
+			r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", readResp.Diagnostics)
+			}
+
+			var data ClusterInstallationResourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+
+			if data.ID.ValueString() != "test-cluster-id" || data.ClusterID.ValueString() != "test-cluster-id" {
+				t.Errorf("Expected id and cluster_id test-cluster-id, got %s and %s", data.ID, data.ClusterID)
+			}
+			if data.Status.ValueString() != tt.status {
+				t.Errorf("Expected status %s, got %s", tt.status, data.Status)
+			}
+			if data.InstallStartedAt.ValueString() != "2024-01-01T10:00:00Z" {
+				t.Errorf("Expected install_started_at from the API, got %s", data.InstallStartedAt)
+			}
+			if tt.status == "installed" && data.InstallCompletedAt.ValueString() != "2024-01-01T11:15:00Z" {
+				t.Errorf("Expected install_completed_at from the API, got %s", data.InstallCompletedAt)
+			}
+			if tt.status != "installed" && !data.InstallCompletedAt.IsNull() {
+				t.Errorf("Expected install_completed_at to be null, got %s", data.InstallCompletedAt)
+			}
+			if !data.WaitForHosts.ValueBool() || !data.WaitForCompletion.ValueBool() || data.ExpectedHostCount.ValueInt64() != 3 {
+				t.Errorf("Expected defaults to be set on import, got wait_for_hosts=%s wait_for_completion=%s expected_host_count=%s", data.WaitForHosts, data.WaitForCompletion, data.ExpectedHostCount)
+			}
+			if !data.FailOnValidation.ValueBool() || data.CancelOnDestroy.ValueBool() || data.VerifyDNS.ValueBool() || data.PollInterval.ValueString() != "30s" {
+				t.Errorf("Expected defaults to be set on import, got fail_on_validation_errors=%s cancel_on_destroy=%s verify_dns=%s poll_interval=%s", data.FailOnValidation, data.CancelOnDestroy, data.VerifyDNS, data.PollInterval)
+			}
+
+			// Changing an install-time setting after import must not install again
+			plan := readResp.State
+			diags := plan.SetAttribute(ctx, path.Root("expected_host_count"), int64(5))
+			diags.Append(plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+			if diags.HasError() {
+				t.Fatalf("Failed to build plan: %+v", diags)
+			}
+
+			updateResp := &resource.UpdateResponse{State: readResp.State}
+			r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: readResp.State}, updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", updateResp.Diagnostics)
+			}
+
+			var updated ClusterInstallationResourceModel
+			updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &updated)...)
+			if updated.ExpectedHostCount.ValueInt64() != 5 || updated.Status.ValueString() != tt.status {
+				t.Errorf("Expected expected_host_count 5 and status %s, got %s and %s", tt.status, updated.ExpectedHostCount, updated.Status)
+			}
+		})
+	}
+}
+
+func TestClusterInstallationResource_Create_HostsProgress(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	stages := []models.Progress{
+		{CurrentStage: "Writing image to disk", InstallationPercentage: 40},
+		{CurrentStage: "Rebooting", InstallationPercentage: 70},
+		{CurrentStage: "Done", InstallationPercentage: 100},
+	}
+
+	var clusterPolls, hostPolls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/clusters/test-cluster-id":
+			cluster := models.Cluster{
+				ID:     "test-cluster-id",
+				Status: "installing",
+				Hosts:  []models.Host{{ID: "master-0", InfraEnvID: "test-infra-env-id", ClusterID: "test-cluster-id"}},
+			}
+			if atomic.AddInt32(&clusterPolls, 1) > int32(len(stages)) {
+				cluster.Status = "installed"
+			}
+			_ = json.NewEncoder(w).Encode(cluster)
+		case "/v2/infra-envs/test-infra-env-id/hosts":
+			n := int(atomic.AddInt32(&hostPolls, 1))
+			progress := stages[min(n, len(stages))-1]
+			_ = json.NewEncoder(w).Encode([]models.Host{
+				{ID: "master-1", ClusterID: "test-cluster-id", RequestedHostname: "master-1", Progress: &progress},
+				{ID: "master-0", ClusterID: "test-cluster-id", RequestedHostname: "master-0", Progress: &progress},
+				{ID: "other", ClusterID: "other-cluster-id", Progress: &progress},
+			})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	ctx := context.Background()
+	plan := newClusterInstallationPlan(t, r, "10s", true)
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if atomic.LoadInt32(&hostPolls) == 0 {
+		t.Fatal("Expected the hosts to be listed while waiting for the installation")
+	}
+
+	var data ClusterInstallationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	var progress []ClusterHostProgressModel
+	resp.Diagnostics.Append(data.HostsProgress.ElementsAs(ctx, &progress, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read hosts_progress: %+v", resp.Diagnostics)
+	}
+
+	if len(progress) != 2 {
+		t.Fatalf("Expected the 2 hosts of the cluster, got %+v", progress)
+	}
+	if progress[0].ID.ValueString() != "master-0" || progress[1].ID.ValueString() != "master-1" {
+		t.Errorf("Expected hosts ordered by hostname, got %s and %s", progress[0].ID, progress[1].ID)
+	}
+	for _, host := range progress {
+		if host.CurrentStage.ValueString() != "Done" || host.InstallationPercentage.ValueInt64() != 100 {
+			t.Errorf("Expected host %s to be done, got stage %s at %s%%", host.ID, host.CurrentStage, host.InstallationPercentage)
+		}
+	}
+}
+
+func TestClusterInstallationResource_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		cancelOnDestroy bool
+		status          string
+		expected        []string
+	}{
+		{name: "left untouched by default", status: "installing"},
+		{name: "running installation", cancelOnDestroy: true, status: "installing", expected: []string{"cancel", "reset"}},
+		{name: "failed installation", cancelOnDestroy: true, status: "error", expected: []string{"reset"}},
+		{name: "installed cluster", cancelOnDestroy: true, status: "installed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actions []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id":
+					_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: tt.status})
+				case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v2/clusters/test-cluster-id/actions/"):
+					actions = append(actions, strings.TrimPrefix(r.URL.Path, "/v2/clusters/test-cluster-id/actions/"))
+					w.WriteHeader(http.StatusAccepted)
+					_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			r := &ClusterInstallationResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"cluster_id":        tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"cancel_on_destroy": tftypes.NewValue(tftypes.Bool, tt.cancelOnDestroy),
+			})
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if strings.Join(actions, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected actions %v, got %v", tt.expected, actions)
+			}
+		})
+	}
+}
+
+func TestClusterInstallationResource_Create_PollInterval(t *testing.T) {
+	// The default interval would outlast the test, so only poll_interval can complete it
+	originalInterval := installationPollInterval
+	installationPollInterval = time.Hour
+	defer func() { installationPollInterval = originalInterval }()
+
+	statuses := []string{"installing", "installing", "finalizing", "installed"}
+	var polls int32
+	var observed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&polls, 1))
+		status := statuses[min(n, len(statuses))-1]
+		observed = append(observed, status)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Status: status})
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"cluster_id":          tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+		"poll_interval":       tftypes.NewValue(tftypes.String, "100ms"),
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if !strings.Contains(strings.Join(observed, ","), "installing,finalizing,installed") {
+		t.Errorf("Expected the poller to observe finalizing before installed, got %v", observed)
+	}
+
+	var data ClusterInstallationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.Status.ValueString() != "installed" {
+		t.Errorf("Expected status installed, got %s", data.Status)
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.String
+		expected time.Duration
+	}{
+		{name: "configured", value: types.StringValue("10s"), expected: 10 * time.Second},
+		{name: "not set", value: types.StringNull(), expected: installationPollInterval},
+		{name: "unknown", value: types.StringUnknown(), expected: installationPollInterval},
+		{name: "invalid", value: types.StringValue("often"), expected: installationPollInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pollInterval(tt.value); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterInstallationStatusDataSource{}
+
+func NewClusterInstallationStatusDataSource() datasource.DataSource {
+	return &ClusterInstallationStatusDataSource{}
+}
+
+// ClusterInstallationStatusDataSource defines the data source implementation.
+type ClusterInstallationStatusDataSource struct {
+	client *client.Client
+}
+
+// ClusterInstallationStatusDataSourceModel describes the data source data model.
+type ClusterInstallationStatusDataSourceModel struct {
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	ID                  types.String   `tfsdk:"id"`
+	ClusterID           types.String   `tfsdk:"cluster_id"`
+	WaitForCompletion   types.Bool     `tfsdk:"wait_for_completion"`
+	Status              types.String   `tfsdk:"status"`
+	StatusInfo          types.String   `tfsdk:"status_info"`
+	ProgressPercentage  types.Int64    `tfsdk:"progress_percentage"`
+	FinalizingStage     types.String   `tfsdk:"finalizing_stage"`
+	Installed           types.Bool     `tfsdk:"installed"`
+	InstallStartedAt    types.String   `tfsdk:"install_started_at"`
+	InstallCompletedAt  types.String   `tfsdk:"install_completed_at"`
+	FinalizingOperators types.List     `tfsdk:"finalizing_operators"`
+}
+
+func (d *ClusterInstallationStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_installation_status"
+}
+
+func (d *ClusterInstallationStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the installation status and progress of a cluster. Pair with `openshift_assisted_installer_cluster_installation` and `wait_for_completion = false` to trigger installation in one workspace and wait for it in another.",
+
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier (same as cluster_id)",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster to report on",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the installation has completed, bounded by the `read` timeout (default 90m). The read fails if the installation errors, is cancelled or does not complete in time. Defaults to false.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current cluster status",
+				Computed:            true,
+			},
+			"status_info": schema.StringAttribute{
+				MarkdownDescription: "Detailed status information",
+				Computed:            true,
+			},
+			"progress_percentage": schema.Int64Attribute{
+				MarkdownDescription: "Overall installation progress in percent",
+				Computed:            true,
+			},
+			"finalizing_stage": schema.StringAttribute{
+				MarkdownDescription: "Current stage while the cluster is finalizing",
+				Computed:            true,
+			},
+			"installed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster installation has completed",
+				Computed:            true,
+			},
+			"install_started_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when installation started",
+				Computed:            true,
+			},
+			"install_completed_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when installation completed",
+				Computed:            true,
+			},
+			"finalizing_operators": schema.ListNestedAttribute{
+				MarkdownDescription: "Operators monitored while the cluster is finalizing, with their installation state. Operators whose status is not `available` are still pending.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Operator name",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace the operator is installed in",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Operator version",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Operator status (progressing, available, failed)",
+							Computed:            true,
+						},
+						"status_info": schema.StringAttribute{
+							MarkdownDescription: "Detailed operator status information",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterInstallationStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterInstallationStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterInstallationStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 90*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	clusterID := data.ClusterID.ValueString()
+
+	cluster, err := d.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster, got error: %s", err),
+		)
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() && cluster.Status != "installed" {
+		tflog.Info(ctx, "Waiting for installation to complete", map[string]interface{}{
+			"cluster_id": clusterID,
+			"status":     cluster.Status,
+			"timeout":    readTimeout.String(),
+		})
+
+		if err := waitForInstallationComplete(ctx, d.client, clusterID, readTimeout, installationPollInterval, nil); err != nil {
+			resp.Diagnostics.AddError(
+				"Installation did not complete",
+				fmt.Sprintf("Cluster %s installation did not complete: %s", clusterID, err),
+			)
+			return
+		}
+
+		cluster, err = d.client.GetCluster(ctx, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read cluster after installation, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(clusterID)
+	data.Status = types.StringValue(cluster.Status)
+	data.StatusInfo = types.StringValue(cluster.StatusInfo)
+	data.Installed = types.BoolValue(cluster.Status == "installed")
+	data.InstallStartedAt = timestampValue(cluster.InstallStartedAt)
+	data.InstallCompletedAt = timestampValue(cluster.InstallCompletedAt)
+
+	data.ProgressPercentage = types.Int64Value(0)
+	data.FinalizingStage = types.StringNull()
+	if cluster.Progress != nil {
+		data.ProgressPercentage = types.Int64Value(cluster.Progress.TotalPercentage)
+		if cluster.Progress.FinalizingStage != "" {
+			data.FinalizingStage = types.StringValue(cluster.Progress.FinalizingStage)
+		}
+	}
+
+	data.FinalizingOperators, diags = finalizingOperatorsFromCluster(ctx, cluster)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
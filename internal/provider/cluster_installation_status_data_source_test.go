@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterInstallationStatusDataSource_Metadata(t *testing.T) {
+	ds := NewClusterInstallationStatusDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	if metadataResp.TypeName != "openshift_assisted_installer_cluster_installation_status" {
+		t.Errorf("Expected type name 'openshift_assisted_installer_cluster_installation_status', got '%s'", metadataResp.TypeName)
+	}
+}
+
+func TestClusterInstallationStatusDataSource_Read(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	startedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(45 * time.Minute)
+
+	installing := models.Cluster{
+		ID:               "test-cluster-id",
+		Status:           "installing",
+		StatusInfo:       "Installation in progress",
+		InstallStartedAt: startedAt,
+		Progress:         &models.ClusterProgress{TotalPercentage: 42},
+	}
+	installed := models.Cluster{
+		ID:                 "test-cluster-id",
+		Status:             "installed",
+		StatusInfo:         "Cluster is installed",
+		InstallStartedAt:   startedAt,
+		InstallCompletedAt: completedAt,
+		Progress:           &models.ClusterProgress{TotalPercentage: 100, FinalizingStage: "Done"},
+		MonitoredOperators: []models.MonitoredOperator{{Name: "console", Status: "available"}},
+	}
+	failed := installing
+	failed.Status = "error"
+	failed.StatusInfo = "Host failed to boot"
+
+	tests := []struct {
+		name               string
+		responses          []models.Cluster
+		waitForCompletion  bool
+		timeout            string
+		expectError        string
+		expectedStatus     string
+		expectedProgress   int64
+		expectedInstalled  bool
+		expectedCompletion string
+	}{
+		{
+			name:              "in progress without waiting",
+			responses:         []models.Cluster{installing, installed},
+			expectedStatus:    "installing",
+			expectedProgress:  42,
+			expectedInstalled: false,
+		},
+		{
+			name:               "completed",
+			responses:          []models.Cluster{installed},
+			expectedStatus:     "installed",
+			expectedProgress:   100,
+			expectedInstalled:  true,
+			expectedCompletion: "2025-06-01T12:45:00Z",
+		},
+		{
+			name:               "wait for completion",
+			responses:          []models.Cluster{installing, installing, installed},
+			waitForCompletion:  true,
+			timeout:            "10s",
+			expectedStatus:     "installed",
+			expectedProgress:   100,
+			expectedInstalled:  true,
+			expectedCompletion: "2025-06-01T12:45:00Z",
+		},
+		{
+			name:              "wait for failed installation",
+			responses:         []models.Cluster{installing, failed},
+			waitForCompletion: true,
+			timeout:           "10s",
+			expectError:       "Host failed to boot",
+		},
+		{
+			name:              "wait times out",
+			responses:         []models.Cluster{installing},
+			waitForCompletion: true,
+			timeout:           "50ms",
+			expectError:       "did not complete",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Expected path /v2/clusters/test-cluster-id, got %s", r.URL.Path)
+				}
+				// Serve responses in order, repeating the last one
+				n := int(atomic.AddInt32(&polls, 1)) - 1
+				if n >= len(tt.responses) {
+					n = len(tt.responses) - 1
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tt.responses[n])
+			}))
+			defer server.Close()
+
+			ds := &ClusterInstallationStatusDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			values := map[string]tftypes.Value{
+				"cluster_id":          tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"wait_for_completion": tftypes.NewValue(tftypes.Bool, tt.waitForCompletion),
+			}
+			if tt.timeout != "" {
+				values["timeouts"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"read": tftypes.String}}, map[string]tftypes.Value{
+					"read": tftypes.NewValue(tftypes.String, tt.timeout),
+				})
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, values)
+			ds.Read(context.Background(), req, resp)
+
+			if tt.expectError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %+v", tt.expectError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state ClusterInstallationStatusDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			if state.Status.ValueString() != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s", tt.expectedStatus, state.Status)
+			}
+			if state.ProgressPercentage.ValueInt64() != tt.expectedProgress {
+				t.Errorf("Expected progress %d, got %d", tt.expectedProgress, state.ProgressPercentage.ValueInt64())
+			}
+			if state.Installed.ValueBool() != tt.expectedInstalled {
+				t.Errorf("Expected installed %v, got %v", tt.expectedInstalled, state.Installed)
+			}
+			if state.InstallStartedAt.ValueString() != "2025-06-01T12:00:00Z" {
+				t.Errorf("Expected install_started_at 2025-06-01T12:00:00Z, got %s", state.InstallStartedAt)
+			}
+			if tt.expectedCompletion == "" {
+				if !state.InstallCompletedAt.IsNull() {
+					t.Errorf("Expected install_completed_at to be null, got %s", state.InstallCompletedAt)
+				}
+			} else if state.InstallCompletedAt.ValueString() != tt.expectedCompletion {
+				t.Errorf("Expected install_completed_at %s, got %s", tt.expectedCompletion, state.InstallCompletedAt)
+			}
+		})
+	}
+}
@@ -3,11 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -24,11 +33,15 @@ type ClusterLogsDataSource struct {
 
 // ClusterLogsDataSourceModel describes the data source data model.
 type ClusterLogsDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ClusterID types.String `tfsdk:"cluster_id"`
-	LogsType  types.String `tfsdk:"logs_type"`
-	HostID    types.String `tfsdk:"host_id"`
-	Content   types.String `tfsdk:"content"`
+	ID              types.String   `tfsdk:"id"`
+	ClusterID       types.String   `tfsdk:"cluster_id"`
+	LogsType        types.String   `tfsdk:"logs_type"`
+	HostID          types.String   `tfsdk:"host_id"`
+	OutputPath      types.String   `tfsdk:"output_path"`
+	MaxInStateBytes types.Int64    `tfsdk:"max_in_state_bytes"`
+	SizeBytes       types.Int64    `tfsdk:"size_bytes"`
+	Content         types.String   `tfsdk:"content"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *ClusterLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,17 +63,36 @@ func (d *ClusterLogsDataSource) Schema(ctx context.Context, req datasource.Schem
 				Required:            true,
 			},
 			"logs_type": schema.StringAttribute{
-				MarkdownDescription: "Type of logs to download (e.g., controller, host, all)",
+				MarkdownDescription: "Type of logs to download (host, controller, all)",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("host", "controller", "all"),
+				},
 			},
 			"host_id": schema.StringAttribute{
 				MarkdownDescription: "Specific host ID to download logs for",
 				Optional:            true,
 			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file to write the downloaded logs to. Parent directories are created as needed. Logs are usually a gzipped tarball, so set this rather than relying on `content`.",
+				Optional:            true,
+			},
+			"max_in_state_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in bytes of logs stored in `content`. Larger logs are rejected with an error instead of bloating the state; set `output_path` to download them. Does not apply when `output_path` is set. Unlimited when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the downloaded logs in bytes",
+				Computed:            true,
+			},
 			"content": schema.StringAttribute{
-				MarkdownDescription: "Raw log content as a string",
+				MarkdownDescription: "Raw log content as a string. Only set when `output_path` is not set, and only for plain text logs.",
 				Computed:            true,
 			},
+			"timeouts": timeouts.Attributes(ctx),
 		},
 	}
 }
@@ -105,6 +137,17 @@ func (d *ClusterLogsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		params["host_id"] = data.HostID.ValueString()
 	}
 
+	// Bound the download by the read timeout rather than the client's
+	// default request timeout, large downloads can take several minutes
+	readTimeout, diags := data.Timeouts.Read(ctx, client.DefaultDownloadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Download logs from API
 	logContent, err := d.client.DownloadClusterLogs(ctx, data.ClusterID.ValueString(), params)
 	if err != nil {
@@ -117,8 +160,60 @@ func (d *ClusterLogsDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	// Map response body to schema and populate Computed attribute values
 	data.ID = types.StringValue(fmt.Sprintf("logs-%s", data.ClusterID.ValueString()))
-	data.Content = types.StringValue(string(logContent))
+	data.SizeBytes = types.Int64Value(int64(len(logContent)))
+	data.Content = types.StringNull()
+
+	if !data.OutputPath.IsNull() {
+		// Written as is, the logs are binary and must not be decoded
+		outputPath := data.OutputPath.ValueString()
+		if err := writeOutputFile(outputPath, logContent); err != nil {
+			resp.Diagnostics.AddError(
+				"Error writing cluster logs",
+				fmt.Sprintf("Unable to write cluster logs to %s: %s", outputPath, err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Wrote cluster logs", map[string]any{
+			"data_source": "oai_cluster_logs",
+			"cluster_id":  data.ClusterID.ValueString(),
+			"output_path": outputPath,
+			"size_bytes":  len(logContent),
+		})
+	} else if exceedsStateLimit(data.MaxInStateBytes, len(logContent)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_in_state_bytes"),
+			"Cluster Logs Too Large for State",
+			fmt.Sprintf("The logs of cluster %s are %d bytes, more than the %d bytes allowed by max_in_state_bytes. Set output_path to write them to a file instead of storing them in state.",
+				data.ClusterID.ValueString(), len(logContent), data.MaxInStateBytes.ValueInt64()),
+		)
+		return
+	} else if utf8.Valid(logContent) {
+		data.Content = types.StringValue(string(logContent))
+	} else {
+		resp.Diagnostics.AddError(
+			"Binary Cluster Logs",
+			fmt.Sprintf("The logs of cluster %s are binary (%d bytes) and cannot be stored in the content attribute. Set output_path to write them to a file.", data.ClusterID.ValueString(), len(logContent)),
+		)
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// exceedsStateLimit reports whether downloaded content of size bytes is larger
+// than a max_in_state_bytes limit. A null limit does not restrict the size.
+func exceedsStateLimit(limit types.Int64, size int) bool {
+	return !limit.IsNull() && !limit.IsUnknown() && int64(size) > limit.ValueInt64()
+}
+
+// writeOutputFile writes downloaded content to path, creating its parent
+// directories. Logs and credentials can contain sensitive data, so the file
+// is only readable by the current user.
+func writeOutputFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o600)
+}
@@ -1,13 +1,18 @@
 package provider
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestClusterLogsDataSource_Schema(t *testing.T) {
@@ -24,14 +29,14 @@ func TestClusterLogsDataSource_Schema(t *testing.T) {
 
 	// Check that required attributes exist
 	attrs := resp.Schema.Attributes
-	requiredAttrs := []string{"id", "cluster_id", "content"}
+	requiredAttrs := []string{"id", "cluster_id", "content", "size_bytes"}
 	for _, attr := range requiredAttrs {
 		if _, ok := attrs[attr]; !ok {
 			t.Errorf("%s attribute is missing", attr)
 		}
 	}
 
-	optionalAttrs := []string{"logs_type", "host_id"}
+	optionalAttrs := []string{"logs_type", "host_id", "output_path", "max_in_state_bytes"}
 	for _, attr := range optionalAttrs {
 		if _, ok := attrs[attr]; !ok {
 			t.Errorf("%s attribute is missing", attr)
@@ -69,7 +74,6 @@ func TestClusterLogsDataSource_Configure(t *testing.T) {
 func TestClusterLogsDataSource_Read(t *testing.T) {
 	mockLogContent := "2023-01-01 12:00:00 INFO: Cluster installation started\n2023-01-01 12:05:00 INFO: Host validation completed\n"
 
-	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			t.Errorf("Expected GET request, got %s", r.Method)
@@ -85,19 +89,175 @@ func TestClusterLogsDataSource_Read(t *testing.T) {
 	}))
 	defer server.Close()
 
-	// Create client and data source
-	testClient := client.NewClient(client.ClientConfig{
-		BaseURL:      server.URL,
-		OfflineToken: "test-token",
+	ds := &ClusterLogsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
 	})
+	ds.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
 
-	dataSource := NewClusterLogsDataSource().(*ClusterLogsDataSource)
-	dataSource.Configure(context.Background(), datasource.ConfigureRequest{
-		ProviderData: testClient,
-	}, &datasource.ConfigureResponse{})
+	var state ClusterLogsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+
+	if state.Content.ValueString() != mockLogContent {
+		t.Errorf("Expected content %q, got %q", mockLogContent, state.Content.ValueString())
+	}
+	if state.SizeBytes.ValueInt64() != int64(len(mockLogContent)) {
+		t.Errorf("Expected size_bytes %d, got %d", len(mockLogContent), state.SizeBytes.ValueInt64())
+	}
+}
+
+func TestClusterLogsDataSource_ReadGzipToFile(t *testing.T) {
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	_, _ = gz.Write([]byte("installer log line\n"))
+	_ = gz.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("logs_type"); got != "host" {
+			t.Errorf("Expected logs_type host, got %q", got)
+		}
+		if got := r.URL.Query().Get("host_id"); got != "test-host-id" {
+			t.Errorf("Expected host_id test-host-id, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	ds := &ClusterLogsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "logs", "cluster.tar.gz")
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id":  tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"logs_type":   tftypes.NewValue(tftypes.String, "host"),
+		"host_id":     tftypes.NewValue(tftypes.String, "test-host-id"),
+		"output_path": tftypes.NewValue(tftypes.String, outputPath),
+	})
+	ds.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var state ClusterLogsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+
+	if state.OutputPath.ValueString() != outputPath {
+		t.Errorf("Expected output_path %s, got %s", outputPath, state.OutputPath)
+	}
+	if state.SizeBytes.ValueInt64() != int64(archive.Len()) {
+		t.Errorf("Expected size_bytes %d, got %d", archive.Len(), state.SizeBytes.ValueInt64())
+	}
+	if !state.Content.IsNull() {
+		t.Error("Expected content to be null when output_path is set")
+	}
+
+	written, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read written logs: %v", err)
+	}
+	if !bytes.Equal(written, archive.Bytes()) {
+		t.Error("Written logs do not match the downloaded archive")
+	}
+}
+
+func TestClusterLogsDataSource_ReadBinaryWithoutOutputPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte{0x1f, 0x8b, 0x08, 0x00, 0xff, 0xfe})
+	}))
+	defer server.Close()
+
+	ds := &ClusterLogsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Binary Cluster Logs" {
+		t.Errorf("Expected Binary Cluster Logs error, got %+v", resp.Diagnostics)
+	}
+}
+
+func TestClusterLogsDataSource_ReadMaxInStateBytes(t *testing.T) {
+	logContent := "cluster log line\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(logContent))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "under limit",
+			values: map[string]tftypes.Value{
+				"max_in_state_bytes": tftypes.NewValue(tftypes.Number, len(logContent)),
+			},
+		},
+		{
+			name: "over limit",
+			values: map[string]tftypes.Value{
+				"max_in_state_bytes": tftypes.NewValue(tftypes.Number, len(logContent)-1),
+			},
+			expectError: true,
+		},
+		{
+			name: "over limit with output_path",
+			values: map[string]tftypes.Value{
+				"max_in_state_bytes": tftypes.NewValue(tftypes.Number, 1),
+				"output_path":        tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "cluster.log")),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &ClusterLogsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			tt.values["cluster_id"] = tftypes.NewValue(tftypes.String, "test-cluster-id")
+			req, resp := newDataSourceReadRequest(t, ds, tt.values)
+			ds.Read(context.Background(), req, resp)
 
-	// Verify the data source was configured
-	if dataSource.client == nil {
-		t.Error("Expected client to be set after Configure")
+			if !tt.expectError {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Read() diagnostics: %+v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Cluster Logs Too Large for State" {
+				t.Errorf("Expected Cluster Logs Too Large for State error, got %+v", resp.Diagnostics)
+			}
+		})
 	}
 }
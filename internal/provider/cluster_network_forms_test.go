@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterResource_ValidateConfig_NetworkForms(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	clusterNetworksType := clusterConfigAttributeType(t, "cluster_networks").(tftypes.List)
+	clusterNetworks := tftypes.NewValue(clusterNetworksType, []tftypes.Value{
+		tftypes.NewValue(clusterNetworksType.ElementType, map[string]tftypes.Value{
+			"cidr":        tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+			"host_prefix": tftypes.NewValue(tftypes.Number, 23),
+		}),
+	})
+	serviceNetworksType := clusterConfigAttributeType(t, "service_networks").(tftypes.List)
+	serviceNetworks := tftypes.NewValue(serviceNetworksType, []tftypes.Value{
+		tftypes.NewValue(serviceNetworksType.ElementType, map[string]tftypes.Value{
+			"cidr": tftypes.NewValue(tftypes.String, "172.30.0.0/16"),
+		}),
+	})
+
+	tests := []struct {
+		name           string
+		values         map[string]tftypes.Value
+		expectedErrors []path.Path
+	}{
+		{
+			name: "single CIDRs",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 23),
+				"service_network_cidr":        tftypes.NewValue(tftypes.String, "172.30.0.0/16"),
+			},
+		},
+		{
+			name: "network lists",
+			values: map[string]tftypes.Value{
+				"cluster_networks": clusterNetworks,
+				"service_networks": serviceNetworks,
+			},
+		},
+		{
+			name: "cluster network CIDR and list",
+			values: map[string]tftypes.Value{
+				"cluster_network_cidr": tftypes.NewValue(tftypes.String, "10.128.0.0/14"),
+				"cluster_networks":     clusterNetworks,
+			},
+			expectedErrors: []path.Path{path.Root("cluster_network_cidr")},
+		},
+		{
+			name: "cluster network host prefix and list",
+			values: map[string]tftypes.Value{
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, 23),
+				"cluster_networks":            clusterNetworks,
+			},
+			expectedErrors: []path.Path{path.Root("cluster_network_host_prefix")},
+		},
+		{
+			name: "service network CIDR and list",
+			values: map[string]tftypes.Value{
+				"service_network_cidr": tftypes.NewValue(tftypes.String, "172.30.0.0/16"),
+				"service_networks":     serviceNetworks,
+			},
+			expectedErrors: []path.Path{path.Root("service_network_cidr")},
+		},
+		{
+			name: "unknown CIDR and list",
+			values: map[string]tftypes.Value{
+				"service_network_cidr": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"service_networks":     serviceNetworks,
+			},
+			expectedErrors: []path.Path{path.Root("service_network_cidr")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors, got diagnostics: %+v", len(tt.expectedErrors), resp.Diagnostics)
+			}
+			for i, expected := range tt.expectedErrors {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Errorf("Expected error on %s, got %+v", expected, errs[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	networkTypeOpenShiftSDN = "OpenShiftSDN"
+
+	// openShiftSDNRemovedMinor is the first 4.y release new clusters cannot
+	// be installed with OpenShiftSDN on
+	openShiftSDNRemovedMinor = 15
+)
+
+// minorVersionPattern matches the major and minor version at the start of an
+// OpenShift version such as 4.15, 4.15.20 or 4.16.0-ec.1
+var minorVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)(?:[.-]|$)`)
+
+// openshiftMinorVersion returns the major and minor version of an OpenShift
+// version, or false when it cannot be parsed
+func openshiftMinorVersion(version string) (int, int, bool) {
+	match := minorVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err := strconv.Atoi(match[2])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// validateNetworkType rejects OpenShiftSDN for OpenShift 4.15 and later, which
+// only install with OVNKubernetes. The version is taken from openshift_version,
+// or from the tag of ocp_release_image. Versions that cannot be parsed are
+// left for the Assisted Service to check.
+func (r *ClusterResource) validateNetworkType(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.NetworkType.IsNull() || data.NetworkType.IsUnknown() || data.NetworkType.ValueString() != networkTypeOpenShiftSDN {
+		return
+	}
+
+	var version string
+	switch {
+	case data.OpenshiftVersion.IsUnknown():
+		return
+	case !data.OpenshiftVersion.IsNull():
+		version = data.OpenshiftVersion.ValueString()
+	case !data.OCPReleaseImage.IsNull() && !data.OCPReleaseImage.IsUnknown():
+		version, _ = releaseImageVersion(data.OCPReleaseImage.ValueString())
+	}
+
+	major, minor, ok := openshiftMinorVersion(version)
+	if !ok || major < 4 || (major == 4 && minor < openShiftSDNRemovedMinor) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("network_type"),
+		"Unsupported Network Type",
+		fmt.Sprintf("OpenShiftSDN cannot be used to install OpenShift %s, it is only supported up to 4.%d. Use \"OVNKubernetes\" or remove \"network_type\".",
+			version, openShiftSDNRemovedMinor-1),
+	)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOpenshiftMinorVersion(t *testing.T) {
+	tests := []struct {
+		version   string
+		wantMajor int
+		wantMinor int
+		wantOK    bool
+	}{
+		{version: "4.14", wantMajor: 4, wantMinor: 14, wantOK: true},
+		{version: "4.15.20", wantMajor: 4, wantMinor: 15, wantOK: true},
+		{version: "4.16.0-ec.1", wantMajor: 4, wantMinor: 16, wantOK: true},
+		{version: "4.16-multi", wantMajor: 4, wantMinor: 16, wantOK: true},
+		{version: "4"},
+		{version: "latest"},
+		{version: "4.1x"},
+		{version: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			major, minor, ok := openshiftMinorVersion(tt.version)
+			if ok != tt.wantOK || major != tt.wantMajor || minor != tt.wantMinor {
+				t.Errorf("openshiftMinorVersion(%q) = %d, %d, %v, want %d, %d, %v",
+					tt.version, major, minor, ok, tt.wantMajor, tt.wantMinor, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_NetworkType(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "OpenShiftSDN on 4.14",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.14.30"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+		},
+		{
+			name: "OpenShiftSDN on 4.15",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.15"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+			expectError: true,
+		},
+		{
+			name: "OpenShiftSDN on 4.16",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16.3"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+			expectError: true,
+		},
+		{
+			name: "OpenShiftSDN with a 4.16 release image",
+			values: map[string]tftypes.Value{
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release:4.16.3-x86_64"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+			expectError: true,
+		},
+		{
+			name: "OVNKubernetes on 4.16",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16.3"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OVNKubernetes"),
+			},
+		},
+		{
+			name: "unparsable version",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "latest"),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+		},
+		{
+			name: "unknown version",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"network_type":      tftypes.NewValue(tftypes.String, "OpenShiftSDN"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
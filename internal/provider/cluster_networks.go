@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// clusterNetworksFromModel converts cluster_networks to the API model. It
+// returns nil when the list is not set or not known yet.
+func clusterNetworksFromModel(data ClusterResourceModel) []models.ClusterNetwork {
+	if data.ClusterNetworks.IsNull() || data.ClusterNetworks.IsUnknown() {
+		return nil
+	}
+
+	var networks []ClusterNetworkModel
+	if diags := data.ClusterNetworks.ElementsAs(context.Background(), &networks, false); diags.HasError() {
+		return nil
+	}
+
+	result := make([]models.ClusterNetwork, len(networks))
+	for i, network := range networks {
+		result[i] = models.ClusterNetwork{
+			CIDR:       network.CIDR.ValueString(),
+			HostPrefix: int(network.HostPrefix.ValueInt64()),
+		}
+	}
+	return result
+}
+
+// serviceNetworksFromModel converts service_networks to the API model. It
+// returns nil when the list is not set or not known yet.
+func serviceNetworksFromModel(data ClusterResourceModel) []models.ServiceNetwork {
+	if data.ServiceNetworks.IsNull() || data.ServiceNetworks.IsUnknown() {
+		return nil
+	}
+
+	var networks []ServiceNetworkModel
+	if diags := data.ServiceNetworks.ElementsAs(context.Background(), &networks, false); diags.HasError() {
+		return nil
+	}
+
+	result := make([]models.ServiceNetwork, len(networks))
+	for i, network := range networks {
+		result[i] = models.ServiceNetwork{CIDR: network.CIDR.ValueString()}
+	}
+	return result
+}
+
+// machineNetworksFromModel converts machine_networks to the API model. It
+// returns nil when the list is not set or not known yet.
+func machineNetworksFromModel(data ClusterResourceModel) []models.MachineNetwork {
+	if data.MachineNetworks.IsNull() || data.MachineNetworks.IsUnknown() {
+		return nil
+	}
+
+	var networks []MachineNetworkModel
+	if diags := data.MachineNetworks.ElementsAs(context.Background(), &networks, false); diags.HasError() {
+		return nil
+	}
+
+	result := make([]models.MachineNetwork, len(networks))
+	for i, network := range networks {
+		result[i] = models.MachineNetwork{CIDR: network.CIDR.ValueString()}
+	}
+	return result
+}
+
+// planNetworkCIDRs plans cluster_network_cidr, cluster_network_host_prefix and
+// service_network_cidr from the first entry of cluster_networks and
+// service_networks when those are used. The service reports the first network
+// in the single-network attributes, which would otherwise keep their defaults
+// in the plan and not match the created cluster.
+func (r *ClusterResource) planNetworkCIDRs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ClusterNetworks.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster_network_cidr"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster_network_host_prefix"), types.Int64Unknown())...)
+	} else if networks := clusterNetworksFromModel(data); len(networks) > 0 {
+		hostPrefix := types.Int64Unknown()
+		if networks[0].HostPrefix > 0 {
+			hostPrefix = types.Int64Value(int64(networks[0].HostPrefix))
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster_network_cidr"), types.StringValue(networks[0].CIDR))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster_network_host_prefix"), hostPrefix)...)
+	}
+
+	if data.ServiceNetworks.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("service_network_cidr"), types.StringUnknown())...)
+	} else if networks := serviceNetworksFromModel(data); len(networks) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("service_network_cidr"), types.StringValue(networks[0].CIDR))...)
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClusterResource_NetworkLists_modelToParams(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	clusterNetworks, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: map[string]attr.Type{
+		"cidr":        types.StringType,
+		"host_prefix": types.Int64Type,
+	}}, []ClusterNetworkModel{
+		{CIDR: StringValue("10.132.0.0/14"), HostPrefix: types.Int64Value(23)},
+		{CIDR: StringValue("fd01::/48"), HostPrefix: types.Int64Value(64)},
+	})
+	cidrType := types.ObjectType{AttrTypes: map[string]attr.Type{"cidr": types.StringType}}
+	serviceNetworks, _ := types.ListValueFrom(ctx, cidrType, []ServiceNetworkModel{
+		{CIDR: StringValue("172.31.0.0/16")},
+		{CIDR: StringValue("fd02::/112")},
+	})
+	machineNetworks, _ := types.ListValueFrom(ctx, cidrType, []MachineNetworkModel{
+		{CIDR: StringValue("192.168.1.0/24")},
+		{CIDR: StringValue("fd00::/64")},
+	})
+
+	model := ClusterResourceModel{
+		Name:                     StringValue("test-cluster"),
+		ClusterNetworkCIDR:       StringValue(defaultClusterNetworkCIDR),
+		ClusterNetworkHostPrefix: types.Int64Unknown(),
+		ServiceNetworkCIDR:       StringValue("172.30.0.0/16"),
+		ClusterNetworks:          clusterNetworks,
+		ServiceNetworks:          serviceNetworks,
+		MachineNetworks:          machineNetworks,
+	}
+
+	expectedClusterNetworks := []models.ClusterNetwork{
+		{CIDR: "10.132.0.0/14", HostPrefix: 23},
+		{CIDR: "fd01::/48", HostPrefix: 64},
+	}
+	expectedServiceNetworks := []models.ServiceNetwork{{CIDR: "172.31.0.0/16"}, {CIDR: "fd02::/112"}}
+	expectedMachineNetworks := []models.MachineNetwork{{CIDR: "192.168.1.0/24"}, {CIDR: "fd00::/64"}}
+
+	create := r.modelToCreateParams(model)
+	if !reflect.DeepEqual(create.ClusterNetworks, expectedClusterNetworks) {
+		t.Errorf("Expected cluster_networks %+v on create, got %+v", expectedClusterNetworks, create.ClusterNetworks)
+	}
+	if !reflect.DeepEqual(create.ServiceNetworks, expectedServiceNetworks) {
+		t.Errorf("Expected service_networks %+v on create, got %+v", expectedServiceNetworks, create.ServiceNetworks)
+	}
+	if !reflect.DeepEqual(create.MachineNetworks, expectedMachineNetworks) {
+		t.Errorf("Expected machine_networks %+v on create, got %+v", expectedMachineNetworks, create.MachineNetworks)
+	}
+	if create.ClusterNetworkCIDR != "" || create.ClusterNetworkHostPrefix != 0 || create.ServiceNetworkCIDR != "" {
+		t.Errorf("Expected the single-network attributes to be left out with network lists, got %q, %d and %q",
+			create.ClusterNetworkCIDR, create.ClusterNetworkHostPrefix, create.ServiceNetworkCIDR)
+	}
+
+	update := r.modelToUpdateParams(model)
+	if !reflect.DeepEqual(update.ClusterNetworks, expectedClusterNetworks) {
+		t.Errorf("Expected cluster_networks %+v on update, got %+v", expectedClusterNetworks, update.ClusterNetworks)
+	}
+	if !reflect.DeepEqual(update.ServiceNetworks, expectedServiceNetworks) {
+		t.Errorf("Expected service_networks %+v on update, got %+v", expectedServiceNetworks, update.ServiceNetworks)
+	}
+	if !reflect.DeepEqual(update.MachineNetworks, expectedMachineNetworks) {
+		t.Errorf("Expected machine_networks %+v on update, got %+v", expectedMachineNetworks, update.MachineNetworks)
+	}
+
+	// Without the lists the single-network attributes are sent
+	single := r.modelToCreateParams(ClusterResourceModel{
+		Name:                     StringValue("test-cluster"),
+		ClusterNetworkCIDR:       StringValue(defaultClusterNetworkCIDR),
+		ClusterNetworkHostPrefix: types.Int64Value(23),
+		ServiceNetworkCIDR:       StringValue("172.30.0.0/16"),
+		ClusterNetworks:          types.ListNull(clusterNetworks.ElementType(ctx)),
+		ServiceNetworks:          types.ListNull(cidrType),
+		MachineNetworks:          types.ListNull(cidrType),
+	})
+	if single.ClusterNetworkCIDR != defaultClusterNetworkCIDR || single.ClusterNetworkHostPrefix != 23 || single.ServiceNetworkCIDR != "172.30.0.0/16" {
+		t.Errorf("Expected the single-network attributes to be sent, got %q, %d and %q",
+			single.ClusterNetworkCIDR, single.ClusterNetworkHostPrefix, single.ServiceNetworkCIDR)
+	}
+	if single.ClusterNetworks != nil || single.ServiceNetworks != nil || single.MachineNetworks != nil {
+		t.Errorf("Expected no network lists, got %+v, %+v and %+v", single.ClusterNetworks, single.ServiceNetworks, single.MachineNetworks)
+	}
+}
+
+func TestClusterResource_ModifyPlan_NetworkCIDRs(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	clusterNetworksType := clusterConfigAttributeType(t, "cluster_networks").(tftypes.List)
+	serviceNetworksType := clusterConfigAttributeType(t, "service_networks").(tftypes.List)
+
+	tests := []struct {
+		name               string
+		config             map[string]tftypes.Value
+		expectedCIDR       types.String
+		expectedHostPrefix types.Int64
+		expectedService    types.String
+	}{
+		{
+			name: "network lists",
+			config: map[string]tftypes.Value{
+				"cluster_networks": tftypes.NewValue(clusterNetworksType, []tftypes.Value{
+					tftypes.NewValue(clusterNetworksType.ElementType, map[string]tftypes.Value{
+						"cidr":        tftypes.NewValue(tftypes.String, "10.132.0.0/14"),
+						"host_prefix": tftypes.NewValue(tftypes.Number, 23),
+					}),
+					tftypes.NewValue(clusterNetworksType.ElementType, map[string]tftypes.Value{
+						"cidr":        tftypes.NewValue(tftypes.String, "fd01::/48"),
+						"host_prefix": tftypes.NewValue(tftypes.Number, 64),
+					}),
+				}),
+				"service_networks": tftypes.NewValue(serviceNetworksType, []tftypes.Value{
+					tftypes.NewValue(serviceNetworksType.ElementType, map[string]tftypes.Value{
+						"cidr": tftypes.NewValue(tftypes.String, "172.31.0.0/16"),
+					}),
+				}),
+			},
+			expectedCIDR:       types.StringValue("10.132.0.0/14"),
+			expectedHostPrefix: types.Int64Value(23),
+			expectedService:    types.StringValue("172.31.0.0/16"),
+		},
+		{
+			name: "unknown network lists",
+			config: map[string]tftypes.Value{
+				"cluster_networks": tftypes.NewValue(clusterNetworksType, tftypes.UnknownValue),
+				"service_networks": tftypes.NewValue(serviceNetworksType, tftypes.UnknownValue),
+			},
+			expectedCIDR:       types.StringUnknown(),
+			expectedHostPrefix: types.Int64Unknown(),
+			expectedService:    types.StringUnknown(),
+		},
+		{
+			name:               "single CIDRs",
+			config:             map[string]tftypes.Value{},
+			expectedCIDR:       types.StringValue(defaultClusterNetworkCIDR),
+			expectedHostPrefix: types.Int64Unknown(),
+			expectedService:    types.StringValue("172.30.0.0/16"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newClusterConfig(t, tt.config)
+
+			// The single-network attributes are planned with their defaults
+			planValues := map[string]tftypes.Value{
+				"cluster_network_cidr":        tftypes.NewValue(tftypes.String, defaultClusterNetworkCIDR),
+				"cluster_network_host_prefix": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"service_network_cidr":        tftypes.NewValue(tftypes.String, "172.30.0.0/16"),
+				"user_managed_networking":     tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			}
+			for name, value := range tt.config {
+				planValues[name] = value
+			}
+			planned := newClusterConfig(t, planValues)
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var data ClusterResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+			if !data.ClusterNetworkCIDR.Equal(tt.expectedCIDR) {
+				t.Errorf("Expected planned cluster_network_cidr %s, got %s", tt.expectedCIDR, data.ClusterNetworkCIDR)
+			}
+			if !data.ClusterNetworkHostPrefix.Equal(tt.expectedHostPrefix) {
+				t.Errorf("Expected planned cluster_network_host_prefix %s, got %s", tt.expectedHostPrefix, data.ClusterNetworkHostPrefix)
+			}
+			if !data.ServiceNetworkCIDR.Equal(tt.expectedService) {
+				t.Errorf("Expected planned service_network_cidr %s, got %s", tt.expectedService, data.ServiceNetworkCIDR)
+			}
+		})
+	}
+}
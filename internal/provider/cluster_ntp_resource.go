@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterNTPResource{}
+var _ resource.ResourceWithImportState = &ClusterNTPResource{}
+
+func NewClusterNTPResource() resource.Resource {
+	return &ClusterNTPResource{}
+}
+
+// ClusterNTPResource defines the resource implementation.
+type ClusterNTPResource struct {
+	client *client.Client
+}
+
+// ClusterNTPResourceModel describes the resource data model.
+type ClusterNTPResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ClusterID           types.String `tfsdk:"cluster_id"`
+	AdditionalNTPSource types.String `tfsdk:"additional_ntp_source"`
+}
+
+func (r *ClusterNTPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ntp"
+}
+
+func (r *ClusterNTPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Cluster NTP resource manages the additional NTP sources of a cluster independently of the cluster resource, for environments where NTP is owned by a different team. Do not also set `additional_ntp_source` on the cluster resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier (same as cluster_id).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID to manage NTP sources for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"additional_ntp_source": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of NTP servers or pools the cluster hosts synchronise with, in addition to the defaults. Cleared when the resource is destroyed.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterNTPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterNTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterNTPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.setNTPSource(ctx, data.ClusterID.ValueString(), data.AdditionalNTPSource.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting cluster NTP sources", fmt.Sprintf("Could not set NTP sources for cluster %s: %s", data.ClusterID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(cluster.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterNTPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.client.GetCluster(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		// The owning cluster is gone, so are its NTP sources
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Cluster not found", fmt.Sprintf("Cluster %s no longer exists, its NTP sources will be removed from state", data.ClusterID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading cluster NTP sources", fmt.Sprintf("Could not read cluster %s: %s", data.ClusterID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(cluster.ID)
+	data.AdditionalNTPSource = types.StringValue(cluster.AdditionalNTPSource)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNTPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterNTPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.setNTPSource(ctx, data.ClusterID.ValueString(), data.AdditionalNTPSource.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating cluster NTP sources", fmt.Sprintf("Could not update NTP sources for cluster %s: %s", data.ClusterID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(cluster.ID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNTPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterNTPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.setNTPSource(ctx, data.ClusterID.ValueString(), ""); err != nil {
+		// Nothing to clear once the cluster is gone
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error clearing cluster NTP sources", fmt.Sprintf("Could not clear NTP sources for cluster %s: %s", data.ClusterID.ValueString(), err))
+	}
+}
+
+func (r *ClusterNTPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by cluster ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), req.ID)...)
+}
+
+// setNTPSource updates only the additional NTP source of a cluster, leaving
+// the rest of its configuration untouched. An empty source clears it.
+func (r *ClusterNTPResource) setNTPSource(ctx context.Context, clusterID, source string) (*models.Cluster, error) {
+	tflog.Info(ctx, "Updating cluster NTP sources", map[string]any{
+		"cluster_id":            clusterID,
+		"additional_ntp_source": source,
+	})
+
+	return r.client.UpdateCluster(ctx, clusterID, models.ClusterUpdateParams{
+		AdditionalNTPSource: &source,
+	})
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newClusterNTPServer serves a single cluster whose NTP source is changed by
+// PATCH requests, recording the raw update bodies
+func newClusterNTPServer(t *testing.T, updates *[]map[string]any) *httptest.Server {
+	t.Helper()
+
+	cluster := models.Cluster{ID: "test-cluster-id", Name: "test-cluster", AdditionalNTPSource: "existing.ntp.example.com"}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/clusters/test-cluster-id" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPatch {
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode update body: %v", err)
+			}
+			*updates = append(*updates, body)
+			cluster.AdditionalNTPSource, _ = body["additional_ntp_source"].(string)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(cluster)
+	}))
+}
+
+func TestClusterNTPResource_SetAndClear(t *testing.T) {
+	ctx := context.Background()
+
+	var updates []map[string]any
+	server := newClusterNTPServer(t, &updates)
+	defer server.Close()
+
+	r := &ClusterNTPResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	planFor := func(source string) tfsdk.Plan {
+		state := newResourceState(t, r, map[string]tftypes.Value{
+			"id":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"cluster_id":            tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			"additional_ntp_source": tftypes.NewValue(tftypes.String, source),
+		})
+		return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	}
+
+	// Set
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: planFor("").Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: planFor("ntp1.example.com,ntp2.example.com")}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", createResp.Diagnostics)
+	}
+
+	var data ClusterNTPResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &data)...)
+	if data.ID.ValueString() != "test-cluster-id" {
+		t.Errorf("Expected id test-cluster-id, got %s", data.ID)
+	}
+	if data.AdditionalNTPSource.ValueString() != "ntp1.example.com,ntp2.example.com" {
+		t.Errorf("Unexpected additional_ntp_source %s", data.AdditionalNTPSource)
+	}
+
+	// Update
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: planFor("ntp3.example.com"), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned diagnostics: %+v", updateResp.Diagnostics)
+	}
+
+	// Read picks up the current value
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", readResp.Diagnostics)
+	}
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if data.AdditionalNTPSource.ValueString() != "ntp3.example.com" {
+		t.Errorf("Expected additional_ntp_source ntp3.example.com after update, got %s", data.AdditionalNTPSource)
+	}
+
+	// Clear
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() returned diagnostics: %+v", deleteResp.Diagnostics)
+	}
+
+	expected := []string{"ntp1.example.com,ntp2.example.com", "ntp3.example.com", ""}
+	if len(updates) != len(expected) {
+		t.Fatalf("Expected %d cluster updates, got %d: %v", len(expected), len(updates), updates)
+	}
+	for i, body := range updates {
+		source, ok := body["additional_ntp_source"]
+		if !ok || source != expected[i] {
+			t.Errorf("Update %d: expected additional_ntp_source %q, got %v", i, expected[i], body)
+		}
+		// Only the NTP source is sent so other cluster settings are left alone
+		if len(body) != 1 {
+			t.Errorf("Update %d: expected only additional_ntp_source in body, got %v", i, body)
+		}
+	}
+}
+
+func TestClusterNTPResource_DeleteMissingCluster(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"404","reason":"not found"}`))
+	}))
+	defer server.Close()
+
+	r := &ClusterNTPResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"id":                    tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+		"cluster_id":            tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+		"additional_ntp_source": tftypes.NewValue(tftypes.String, "ntp1.example.com"),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Delete() returned errors for a deleted cluster: %+v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+)
+
+const (
+	// operatorApprovalManifestFolder and operatorApprovalManifestFileName
+	// identify the manifest earlier versions generated for
+	// operator_install_approval
+	operatorApprovalManifestFolder   = "openshift"
+	operatorApprovalManifestFileName = "99-operator-install-approval.yaml"
+)
+
+// operatorApprovalDeprecation explains why operator_install_approval has no
+// effect. The approval mode is a field of the Subscription the service creates
+// for each operator, and the API reports its name and namespace but not its
+// package, channel or catalog source. A manifest can therefore neither
+// reproduce that Subscription nor add one next to it: OLM rejects a second
+// Subscription for a package, and a second OperatorGroup in a namespace.
+const operatorApprovalDeprecation = "operator_install_approval has no effect: the Assisted Service API does not report the package, channel and catalog source of the Subscriptions it creates, so their install plan approval cannot be changed before installation. Remove the attribute and set installPlanApproval on the installed cluster instead."
+
+// removeOperatorApprovalManifest deletes the install approval manifest
+// uploaded by earlier versions, which added Subscriptions and OperatorGroups
+// that conflict with those of the service
+func (r *ClusterResource) removeOperatorApprovalManifest(ctx context.Context, clusterID string) error {
+	err := r.client.DeleteManifest(ctx, clusterID, operatorApprovalManifestFolder, operatorApprovalManifestFileName)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return fmt.Errorf("failed to remove operator install approval manifest: %w", err)
+	}
+	return nil
+}
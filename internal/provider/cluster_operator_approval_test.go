@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestClusterResource_OperatorInstallApprovalDeprecated(t *testing.T) {
+	r := &ClusterResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attribute, ok := resp.Schema.Attributes["operator_install_approval"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected operator_install_approval string attribute")
+	}
+	if attribute.DeprecationMessage != operatorApprovalDeprecation {
+		t.Errorf("Expected deprecation message %q, got %q", operatorApprovalDeprecation, attribute.DeprecationMessage)
+	}
+}
+
+func TestClusterResource_removeOperatorApprovalManifest(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+	}{
+		{
+			name:   "manifest removed",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "missing manifest ignored",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Method != http.MethodDelete || r.URL.Path != "/v2/clusters/test-cluster-id/manifests" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if r.URL.Query().Get("folder") != operatorApprovalManifestFolder || r.URL.Query().Get("file_name") != operatorApprovalManifestFileName {
+					t.Errorf("Unexpected delete query %s", r.URL.RawQuery)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			if err := r.removeOperatorApprovalManifest(context.Background(), "test-cluster-id"); err != nil {
+				t.Fatalf("removeOperatorApprovalManifest() error = %v", err)
+			}
+			if requests != 1 {
+				t.Errorf("Expected 1 request, got %d", requests)
+			}
+		})
+	}
+}
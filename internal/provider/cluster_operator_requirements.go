@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// operatorMinimumHosts is the number of hosts an operator must be deployed on.
+// The preflight requirements only describe a single host, so the host counts
+// are kept here.
+var operatorMinimumHosts = map[string]int{
+	"odf": 3,
+}
+
+// operatorRequirementProblems checks the hosts bound to the cluster against
+// the hardware requirements of OpenShift and of the cluster's operators, as
+// reported by the service. Only the operators of the cluster and their
+// dependencies are counted, since the service may report others. It returns
+// one message per unmet requirement. Hosts that have not reported an
+// inventory or a role yet are not checked.
+func operatorRequirementProblems(cluster *models.Cluster, requirements *models.PreflightHardwareRequirements) []string {
+	var problems []string
+
+	roles := map[string]int{}
+	for i := range cluster.Hosts {
+		roles[effectiveHostRole(&cluster.Hosts[i]).ValueString()]++
+	}
+
+	operators := make([]string, 0, len(cluster.OLMOperators))
+	for _, op := range cluster.OLMOperators {
+		operators = append(operators, op.Name)
+	}
+	sort.Strings(operators)
+
+	for _, name := range operators {
+		minimum, ok := operatorMinimumHosts[name]
+		if !ok {
+			continue
+		}
+
+		// Operators run on the workers, or on the control plane of clusters
+		// without workers. Hosts without a role yet may still become workers.
+		role, count := "worker", roles["worker"]+roles[""]
+		if count == 0 {
+			role, count = "control plane", roles["master"]
+		}
+		if count < minimum {
+			problems = append(problems, fmt.Sprintf(
+				"operator %s requires at least %d %s hosts, the cluster has %d", name, minimum, role, count))
+		}
+	}
+
+	if requirements == nil {
+		return problems
+	}
+
+	used := usedOperatorRequirements(cluster, requirements)
+
+	for i := range cluster.Hosts {
+		host := &cluster.Hosts[i]
+
+		role := effectiveHostRole(host).ValueString()
+		if role == "" {
+			continue
+		}
+		inventory, err := host.ParseInventory()
+		if err != nil || inventory == nil {
+			continue
+		}
+
+		cpuCores, ramMib := 0, 0
+		sources := []string{}
+		if ocp := requirements.OCP.ForRole(role); ocp != nil {
+			cpuCores += ocp.CPUCores
+			ramMib += ocp.RAMMib
+			sources = append(sources, fmt.Sprintf("OpenShift %d cores/%d MiB", ocp.CPUCores, ocp.RAMMib))
+		}
+		for _, op := range used {
+			required := op.Requirements.ForRole(role)
+			if required == nil || (required.CPUCores == 0 && required.RAMMib == 0) {
+				continue
+			}
+			cpuCores += required.CPUCores
+			ramMib += required.RAMMib
+			sources = append(sources, fmt.Sprintf("%s %d cores/%d MiB", op.OperatorName, required.CPUCores, required.RAMMib))
+		}
+
+		name := hostDisplayName(host)
+		if inventory.CPU.Count < cpuCores {
+			problems = append(problems, fmt.Sprintf(
+				"host %s (%s) has %d CPU cores, %d are required (%s)",
+				name, role, inventory.CPU.Count, cpuCores, strings.Join(sources, ", ")))
+		}
+		if memoryMib := int(inventory.Memory.PhysicalBytes / (1024 * 1024)); memoryMib < ramMib {
+			problems = append(problems, fmt.Sprintf(
+				"host %s (%s) has %d MiB of memory, %d MiB are required (%s)",
+				name, role, memoryMib, ramMib, strings.Join(sources, ", ")))
+		}
+	}
+
+	return problems
+}
+
+// usedOperatorRequirements returns the requirements of the operators of the
+// cluster and of the operators they depend on
+func usedOperatorRequirements(cluster *models.Cluster, requirements *models.PreflightHardwareRequirements) []models.OperatorHardwareRequirements {
+	byName := map[string]models.OperatorHardwareRequirements{}
+	for _, op := range requirements.Operators {
+		byName[op.OperatorName] = op
+	}
+
+	used := map[string]bool{}
+	var pending []string
+	for _, op := range cluster.OLMOperators {
+		pending = append(pending, op.Name)
+	}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if used[name] {
+			continue
+		}
+		used[name] = true
+		pending = append(pending, byName[name].Dependencies...)
+	}
+
+	var result []models.OperatorHardwareRequirements
+	for _, op := range requirements.Operators {
+		if used[op.OperatorName] {
+			result = append(result, op)
+		}
+	}
+	return result
+}
+
+// checkOperatorRequirements reads the hosts bound to the cluster and the
+// preflight requirements of its operators, and reports an error listing each
+// unmet requirement
+func (r *ClusterInstallationResource) checkOperatorRequirements(ctx context.Context, clusterID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cluster, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		diags.AddError(
+			"Error retrieving cluster",
+			fmt.Sprintf("Could not get cluster %s: %s", clusterID, err),
+		)
+		return diags
+	}
+
+	requirements, err := r.client.GetPreflightRequirements(ctx, clusterID)
+	if err != nil {
+		diags.AddError(
+			"Error retrieving operator requirements",
+			fmt.Sprintf("Could not get the preflight requirements of cluster %s: %s", clusterID, err),
+		)
+		return diags
+	}
+
+	if problems := operatorRequirementProblems(cluster, requirements); len(problems) > 0 {
+		diags.AddError(
+			"Operator Requirements Not Met",
+			fmt.Sprintf("The hosts of cluster %s do not meet the requirements of its operators:\n\n- %s\n\nAdd hosts or resources, or remove the operators, before installing.",
+				clusterID, strings.Join(problems, "\n- ")),
+		)
+	}
+
+	return diags
+}
+
+// hostDisplayName returns the most descriptive name of a host for messages
+func hostDisplayName(host *models.Host) string {
+	for _, name := range []string{host.RequestedHostname, host.HostName} {
+		if name != "" {
+			return name
+		}
+	}
+	return host.ID
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newRequirementsHost returns a host with the given role, CPU cores and memory
+func newRequirementsHost(name, role string, cpuCores int, memoryMib int64) models.Host {
+	inventory := fmt.Sprintf(`{"cpu":{"count":%d},"memory":{"physical_bytes":%d}}`, cpuCores, memoryMib*1024*1024)
+	return models.Host{ID: name + "-id", RequestedHostname: name, Role: role, Inventory: inventory}
+}
+
+// odfRequirements mirrors the preflight requirements the service reports for
+// a cluster with ODF. They also list CNV, which the service reports for every
+// cluster whether it uses the operator or not.
+func odfRequirements() *models.PreflightHardwareRequirements {
+	role := func(cpuCores, ramMib int) *models.HostTypeHardwareRequirements {
+		return &models.HostTypeHardwareRequirements{
+			Quantitative: &models.ClusterHostRequirementsDetails{CPUCores: cpuCores, RAMMib: ramMib},
+		}
+	}
+
+	return &models.PreflightHardwareRequirements{
+		OCP: &models.HostTypeHardwareRequirementsWrapper{
+			Master: role(4, 16384),
+			Worker: role(2, 8192),
+		},
+		Operators: []models.OperatorHardwareRequirements{
+			{
+				OperatorName: "odf",
+				Dependencies: []string{"lso"},
+				Requirements: &models.HostTypeHardwareRequirementsWrapper{
+					Master: role(6, 19456),
+					Worker: role(8, 19456),
+				},
+			},
+			{
+				OperatorName: "lso",
+				Requirements: &models.HostTypeHardwareRequirementsWrapper{
+					Master: role(0, 0),
+					Worker: role(0, 0),
+				},
+			},
+			{
+				OperatorName: "cnv",
+				Requirements: &models.HostTypeHardwareRequirementsWrapper{
+					Master: role(4, 150),
+					Worker: role(2, 360),
+				},
+			},
+		},
+	}
+}
+
+func TestOperatorRequirementProblems(t *testing.T) {
+	masters := []models.Host{
+		newRequirementsHost("master-0", "master", 16, 65536),
+		newRequirementsHost("master-1", "master", 16, 65536),
+		newRequirementsHost("master-2", "master", 16, 65536),
+	}
+
+	tests := []struct {
+		name             string
+		hosts            []models.Host
+		operators        []string
+		expectedProblems []string
+	}{
+		{
+			name: "odf with three workers",
+			hosts: append(append([]models.Host{}, masters...),
+				newRequirementsHost("worker-0", "worker", 16, 32768),
+				newRequirementsHost("worker-1", "worker", 16, 32768),
+				newRequirementsHost("worker-2", "worker", 16, 32768),
+			),
+			operators: []string{"odf", "lso"},
+		},
+		{
+			name: "odf with too few workers",
+			hosts: append(append([]models.Host{}, masters...),
+				newRequirementsHost("worker-0", "worker", 16, 32768),
+				newRequirementsHost("worker-1", "worker", 16, 32768),
+			),
+			operators:        []string{"odf", "lso"},
+			expectedProblems: []string{"operator odf requires at least 3 worker hosts, the cluster has 2"},
+		},
+		{
+			name:      "odf on a compact cluster",
+			hosts:     masters,
+			operators: []string{"odf", "lso"},
+		},
+		{
+			name:             "odf on a single node",
+			hosts:            masters[:1],
+			operators:        []string{"odf", "lso"},
+			expectedProblems: []string{"operator odf requires at least 3 control plane hosts, the cluster has 1"},
+		},
+		{
+			name: "odf with an undersized worker",
+			hosts: append(append([]models.Host{}, masters...),
+				newRequirementsHost("worker-0", "worker", 16, 32768),
+				newRequirementsHost("worker-1", "worker", 8, 16384),
+				newRequirementsHost("worker-2", "worker", 16, 32768),
+			),
+			operators: []string{"odf", "lso"},
+			expectedProblems: []string{
+				"host worker-1 (worker) has 8 CPU cores, 10 are required (OpenShift 2 cores/8192 MiB, odf 8 cores/19456 MiB)",
+				"host worker-1 (worker) has 16384 MiB of memory, 27648 MiB are required (OpenShift 2 cores/8192 MiB, odf 8 cores/19456 MiB)",
+			},
+		},
+		{
+			name: "operators the cluster does not use",
+			hosts: append(append([]models.Host{}, masters...),
+				newRequirementsHost("worker-0", "worker", 2, 8192),
+			),
+		},
+		{
+			name: "hosts without inventory or role",
+			hosts: append(append([]models.Host{}, masters...),
+				models.Host{ID: "worker-0-id", Role: "worker"},
+				models.Host{ID: "worker-1-id", Role: "auto-assign"},
+				newRequirementsHost("worker-2", "", 1, 1024),
+			),
+			operators: []string{"odf", "lso"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &models.Cluster{Hosts: tt.hosts}
+			for _, name := range tt.operators {
+				cluster.OLMOperators = append(cluster.OLMOperators, models.OLMOperator{Name: name})
+			}
+
+			problems := operatorRequirementProblems(cluster, odfRequirements())
+			if strings.Join(problems, "\n") != strings.Join(tt.expectedProblems, "\n") {
+				t.Errorf("Expected problems %q, got %q", tt.expectedProblems, problems)
+			}
+		})
+	}
+}
+
+func TestUsedOperatorRequirements(t *testing.T) {
+	cluster := &models.Cluster{OLMOperators: []models.OLMOperator{{Name: "odf"}}}
+
+	var names []string
+	for _, op := range usedOperatorRequirements(cluster, odfRequirements()) {
+		names = append(names, op.OperatorName)
+	}
+	if strings.Join(names, ",") != "odf,lso" {
+		t.Errorf("Expected odf and its lso dependency, got %v", names)
+	}
+}
+
+func TestClusterInstallationResource_Create_OperatorRequirements(t *testing.T) {
+	var installed int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id":
+			_ = json.NewEncoder(w).Encode(models.Cluster{
+				ID:           "test-cluster-id",
+				Status:       "ready",
+				OLMOperators: []models.OLMOperator{{Name: "odf"}, {Name: "lso"}},
+				Hosts: []models.Host{
+					newRequirementsHost("master-0", "master", 16, 65536),
+					newRequirementsHost("master-1", "master", 16, 65536),
+					newRequirementsHost("master-2", "master", 16, 65536),
+					newRequirementsHost("worker-0", "worker", 16, 32768),
+					newRequirementsHost("worker-1", "worker", 16, 32768),
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id/preflight-requirements":
+			_ = json.NewEncoder(w).Encode(odfRequirements())
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters/test-cluster-id/actions/install":
+			atomic.StoreInt32(&installed, 1)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ClusterInstallationResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	plan := newClusterInstallationPlan(t, r, "10s", false)
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for an ODF cluster with two workers")
+	}
+	diagnostic := resp.Diagnostics.Errors()[0]
+	if diagnostic.Summary() != "Operator Requirements Not Met" {
+		t.Errorf("Expected summary \"Operator Requirements Not Met\", got %q", diagnostic.Summary())
+	}
+	if !strings.Contains(diagnostic.Detail(), "operator odf requires at least 3 worker hosts, the cluster has 2") {
+		t.Errorf("Expected the detail to name the missing workers, got %q", diagnostic.Detail())
+	}
+	if atomic.LoadInt32(&installed) != 0 {
+		t.Error("Expected installation not to be triggered")
+	}
+}
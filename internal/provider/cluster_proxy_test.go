@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterResource_ProxyBlock_modelToCreateParams(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:             StringValue("test-cluster"),
+		OpenshiftVersion: StringValue("4.15.20"),
+		PullSecret:       StringValue("pull-secret"),
+		HTTPProxy:        types.StringUnknown(),
+		HTTPSProxy:       types.StringUnknown(),
+		NoProxy:          types.StringUnknown(),
+		Proxy: createClusterProxyObject(ClusterProxyModel{
+			HTTPProxy:  StringValue("http://proxy.example.com:3128"),
+			HTTPSProxy: StringValue("https://proxy.example.com:3129"),
+			NoProxy:    StringValue(".cluster.local,10.0.0.0/8"),
+		}),
+	}
+
+	result := resource.modelToCreateParams(model)
+
+	if result.HTTPProxy != "http://proxy.example.com:3128" {
+		t.Errorf("Expected http_proxy from proxy block, got %q", result.HTTPProxy)
+	}
+	if result.HTTPSProxy != "https://proxy.example.com:3129" {
+		t.Errorf("Expected https_proxy from proxy block, got %q", result.HTTPSProxy)
+	}
+	if result.NoProxy != ".cluster.local,10.0.0.0/8" {
+		t.Errorf("Expected no_proxy from proxy block, got %q", result.NoProxy)
+	}
+}
+
+func TestClusterResource_ProxyBlock_modelToUpdateParams(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:       StringValue("test-cluster"),
+		HTTPProxy:  types.StringUnknown(),
+		HTTPSProxy: types.StringUnknown(),
+		NoProxy:    types.StringUnknown(),
+		Proxy: createClusterProxyObject(ClusterProxyModel{
+			HTTPProxy:  StringValue("http://proxy.example.com:3128"),
+			HTTPSProxy: types.StringNull(),
+			NoProxy:    types.StringNull(),
+		}),
+	}
+
+	result := resource.modelToUpdateParams(model)
+
+	if result.HTTPProxy == nil || *result.HTTPProxy != "http://proxy.example.com:3128" {
+		t.Errorf("Expected http_proxy from proxy block, got %v", result.HTTPProxy)
+	}
+	// Fields omitted from the block must be cleared rather than left untouched
+	if result.HTTPSProxy == nil || *result.HTTPSProxy != "" {
+		t.Errorf("Expected https_proxy to be cleared, got %v", result.HTTPSProxy)
+	}
+	if result.NoProxy == nil || *result.NoProxy != "" {
+		t.Errorf("Expected no_proxy to be cleared, got %v", result.NoProxy)
+	}
+}
+
+func TestClusterResource_ValidateConfig_ProxyConflicts(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	proxyType := clusterConfigAttributeType(t, "proxy")
+	proxyBlock := tftypes.NewValue(proxyType, map[string]tftypes.Value{
+		"http_proxy":  tftypes.NewValue(tftypes.String, "http://proxy.example.com:3128"),
+		"https_proxy": tftypes.NewValue(tftypes.String, nil),
+		"no_proxy":    tftypes.NewValue(tftypes.String, nil),
+	})
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "flat proxy attributes only",
+			values: map[string]tftypes.Value{
+				"http_proxy": tftypes.NewValue(tftypes.String, "http://proxy.example.com:3128"),
+				"no_proxy":   tftypes.NewValue(tftypes.String, ".example.com"),
+			},
+			expectError: false,
+		},
+		{
+			name: "proxy block only",
+			values: map[string]tftypes.Value{
+				"proxy": proxyBlock,
+			},
+			expectError: false,
+		},
+		{
+			name: "proxy block with flat attribute",
+			values: map[string]tftypes.Value{
+				"proxy":    proxyBlock,
+				"no_proxy": tftypes.NewValue(tftypes.String, ".example.com"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// Helper function to create a proxy object for testing
+func createClusterProxyObject(proxy ClusterProxyModel) types.Object {
+	objValue, _ := types.ObjectValueFrom(context.Background(), map[string]attr.Type{
+		"http_proxy":  types.StringType,
+		"https_proxy": types.StringType,
+		"no_proxy":    types.StringType,
+	}, proxy)
+	return objValue
+}
+
+// newClusterConfig builds a cluster resource config where every attribute not
+// present in values is null.
+func newClusterConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ClusterResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Cluster schema is not an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attrs),
+	}
+}
+
+// clusterConfigAttributeType returns the Terraform type of a top-level cluster attribute
+func clusterConfigAttributeType(t *testing.T, name string) tftypes.Type {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ClusterResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	typ, ok := objType.AttributeTypes[name]
+	if !ok {
+		t.Fatalf("Cluster schema has no attribute %q", name)
+	}
+
+	return typ
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// releaseImageVersionPattern matches the version at the start of a release
+// image tag such as 4.15.20-x86_64 or 4.16.0-rc.1-multi
+var releaseImageVersionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+(-(ec|fc|rc)\.\d+)?`)
+
+// releaseImageVersion returns the OpenShift version encoded in a release image
+// tag. It returns false for images pinned by digest or with a non-version tag.
+func releaseImageVersion(image string) (string, bool) {
+	if strings.Contains(image, "@") {
+		return "", false
+	}
+
+	// The tag follows the last colon after the last slash, so registry ports are ignored
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	if i < 0 {
+		return "", false
+	}
+
+	version := releaseImageVersionPattern.FindString(name[i+1:])
+	return version, version != ""
+}
+
+// openshiftVersionMatches reports whether openshift_version selects the given
+// release version, either exactly or as its major.minor stream
+func openshiftVersionMatches(openshiftVersion, releaseVersion string) bool {
+	return openshiftVersion == releaseVersion || strings.HasPrefix(releaseVersion, openshiftVersion+".")
+}
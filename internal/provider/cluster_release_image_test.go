@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReleaseImageVersion(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected string
+		ok       bool
+	}{
+		{"quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64", "4.15.20", true},
+		{"quay.io/openshift-release-dev/ocp-release:4.16.0-rc.1-multi", "4.16.0-rc.1", true},
+		{"registry.example.com:5000/ocp4/openshift4:4.14.3-x86_64", "4.14.3", true},
+		{"registry.example.com:5000/ocp4/openshift4", "", false},
+		{"quay.io/openshift-release-dev/ocp-release:latest", "", false},
+		{"quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			version, ok := releaseImageVersion(tt.image)
+			if version != tt.expected || ok != tt.ok {
+				t.Errorf("releaseImageVersion(%q) = %q, %v; expected %q, %v", tt.image, version, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_ReleaseSelection(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError string
+	}{
+		{
+			name:        "neither set",
+			values:      map[string]tftypes.Value{},
+			expectError: `One of "openshift_version" or "ocp_release_image" must be set.`,
+		},
+		{
+			name: "version only",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.15"),
+			},
+		},
+		{
+			name: "release image only",
+			values: map[string]tftypes.Value{
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64"),
+			},
+		},
+		{
+			name: "release image only pinned by digest",
+			values: map[string]tftypes.Value{
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef"),
+			},
+			expectError: `Set "openshift_version" as well.`,
+		},
+		{
+			name: "both set and consistent",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.15"),
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64"),
+			},
+		},
+		{
+			name: "both set with digest image",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.15.20"),
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release@sha256:0123456789abcdef"),
+			},
+		},
+		{
+			name: "both set and inconsistent",
+			values: map[string]tftypes.Value{
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.1"),
+				"ocp_release_image": tftypes.NewValue(tftypes.String, "quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64"),
+			},
+			expectError: `is OpenShift 4.15.20 but "openshift_version" is "4.1"`,
+		},
+		{
+			name: "unknown release image",
+			values: map[string]tftypes.Value{
+				"ocp_release_image": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if tt.expectError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("Expected no error, got diagnostics: %+v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got diagnostics: %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterResource_modelToCreateParams_VersionFromReleaseImage(t *testing.T) {
+	r := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:             StringValue("test-cluster"),
+		OpenshiftVersion: types.StringUnknown(),
+		OCPReleaseImage:  StringValue("quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64"),
+		PullSecret:       StringValue("pull-secret"),
+	}
+
+	result := r.modelToCreateParams(model)
+
+	if result.OpenshiftVersion != "4.15.20" {
+		t.Errorf("Expected openshift_version derived from release image, got %q", result.OpenshiftVersion)
+	}
+	if result.OCPReleaseImage != "quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64" {
+		t.Errorf("Expected ocp_release_image to be passed through, got %q", result.OCPReleaseImage)
+	}
+}
@@ -2,18 +2,29 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
@@ -22,6 +33,8 @@ import (
 
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterResource{}
 
 type OLMOperatorModel struct {
 	Name       types.String `tfsdk:"name"`
@@ -101,9 +114,16 @@ type LoadBalancerModel struct {
 }
 
 type DiskEncryptionModel struct {
-	EnableOn    types.String `tfsdk:"enable_on"`
-	Mode        types.String `tfsdk:"mode"`
-	TangServers types.String `tfsdk:"tang_servers"`
+	EnableOn       types.String `tfsdk:"enable_on"`
+	Mode           types.String `tfsdk:"mode"`
+	TangServers    types.String `tfsdk:"tang_servers"`
+	TangServerList types.List   `tfsdk:"tang_server_list"`
+}
+
+type ClusterProxyModel struct {
+	HTTPProxy  types.String `tfsdk:"http_proxy"`
+	HTTPSProxy types.String `tfsdk:"https_proxy"`
+	NoProxy    types.String `tfsdk:"no_proxy"`
 }
 
 type IgnitionEndpointModel struct {
@@ -111,6 +131,11 @@ type IgnitionEndpointModel struct {
 	CACertPEM types.String `tfsdk:"ca_cert_pem"`
 }
 
+var ignitionEndpointAttrTypes = map[string]attr.Type{
+	"url":         types.StringType,
+	"ca_cert_pem": types.StringType,
+}
+
 type ImageInfoModel struct {
 	SSHPublicKey        types.String `tfsdk:"ssh_public_key"`
 	SizeBytes           types.Int64  `tfsdk:"size_bytes"`
@@ -163,14 +188,21 @@ type ClusterResourceModel struct {
 	HTTPProxy                types.String   `tfsdk:"http_proxy"`
 	HTTPSProxy               types.String   `tfsdk:"https_proxy"`
 	NoProxy                  types.String   `tfsdk:"no_proxy"`
+	Proxy                    types.Object   `tfsdk:"proxy"`
 	UserManagedNetworking    types.Bool     `tfsdk:"user_managed_networking"`
 	AdditionalNTPSource      types.String   `tfsdk:"additional_ntp_source"`
 	Hyperthreading           types.String   `tfsdk:"hyperthreading"`
 	ControlPlaneCount        types.Int64    `tfsdk:"control_plane_count"`
+	WorkerCount              types.Int64    `tfsdk:"worker_count"`
 	HighAvailabilityMode     types.String   `tfsdk:"high_availability_mode"`
 	NetworkType              types.String   `tfsdk:"network_type"`
 	SchedulableMasters       types.Bool     `tfsdk:"schedulable_masters"`
 	OLMOperators             types.List     `tfsdk:"olm_operators"`
+	Bundle                   types.String   `tfsdk:"bundle"`
+	ExpandBundle             types.Bool     `tfsdk:"expand_bundle"`
+	BundleOperators          types.List     `tfsdk:"bundle_operators"`
+	OperatorInstallApproval  types.String   `tfsdk:"operator_install_approval"`
+	Storage                  types.Object   `tfsdk:"storage"`
 	Platform                 types.Object   `tfsdk:"platform"`
 	LoadBalancer             types.Object   `tfsdk:"load_balancer"`
 	DiskEncryption           types.Object   `tfsdk:"disk_encryption"`
@@ -178,6 +210,7 @@ type ClusterResourceModel struct {
 	ImageInfo                types.Object   `tfsdk:"image_info"`
 	MonitoredOperators       types.List     `tfsdk:"monitored_operators"`
 	Tags                     types.String   `tfsdk:"tags"`
+	InstallConfigOverrides   types.String   `tfsdk:"install_config_overrides"`
 	Status                   types.String   `tfsdk:"status"`
 	StatusInfo               types.String   `tfsdk:"status_info"`
 	InstallCompleted         types.Bool     `tfsdk:"install_completed"`
@@ -211,16 +244,21 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 			},
 			"openshift_version": schema.StringAttribute{
-				MarkdownDescription: "OpenShift version to install",
-				Required:            true,
+				MarkdownDescription: "OpenShift version to install. Required unless `ocp_release_image` is set, in which case it is derived from the image tag.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"ocp_release_image": schema.StringAttribute{
-				MarkdownDescription: "OpenShift release image URI - alternative to openshift_version",
+				MarkdownDescription: "OpenShift release image URI - alternative to openshift_version. Must be pinned by tag (`registry/repository:tag`) or digest (`registry/repository@sha256:digest`). When both are set the image tag must match openshift_version.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validReleaseImage(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -231,63 +269,87 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Sensitive:           true,
 			},
 			"base_dns_domain": schema.StringAttribute{
-				MarkdownDescription: "Base DNS domain for the cluster",
+				MarkdownDescription: "Base DNS domain for the cluster. `<name>.<base_dns_domain>` must be a valid DNS name. When omitted, the domain the service assigns, if any, is stored; the cluster cannot be installed without one.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"cluster_network_cidr": schema.StringAttribute{
 				MarkdownDescription: "CIDR range for pod network",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString("10.128.0.0/14"),
+				Default:             stringdefault.StaticString(defaultClusterNetworkCIDR),
+				Validators: []validator.String{
+					validCIDR(),
+				},
 			},
 			"cluster_network_host_prefix": schema.Int64Attribute{
-				MarkdownDescription: "Host subnet prefix length for pod network",
+				MarkdownDescription: "Host subnet prefix length for pod network. Must be longer than the prefix of `cluster_network_cidr`",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
 			},
 			"service_network_cidr": schema.StringAttribute{
 				MarkdownDescription: "CIDR range for service network",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("172.30.0.0/16"),
+				Validators: []validator.String{
+					validCIDR(),
+				},
 			},
 			"cluster_networks": schema.ListNestedAttribute{
-				MarkdownDescription: "Cluster networks configuration - alternative to cluster_network_cidr",
+				MarkdownDescription: "Cluster networks configuration - alternative to cluster_network_cidr and cluster_network_host_prefix, which cannot be set with it",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cidr": schema.StringAttribute{
 							MarkdownDescription: "Network CIDR",
 							Required:            true,
+							Validators: []validator.String{
+								validCIDR(),
+							},
 						},
 						"host_prefix": schema.Int64Attribute{
-							MarkdownDescription: "Host subnet prefix length",
+							MarkdownDescription: "Host subnet prefix length. Must be longer than the prefix of `cidr`",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 128),
+							},
 						},
 					},
 				},
 			},
 			"service_networks": schema.ListNestedAttribute{
-				MarkdownDescription: "Service networks configuration - alternative to service_network_cidr",
+				MarkdownDescription: "Service networks configuration - alternative to service_network_cidr, which cannot be set with it",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cidr": schema.StringAttribute{
 							MarkdownDescription: "Service network CIDR",
 							Required:            true,
+							Validators: []validator.String{
+								validCIDR(),
+							},
 						},
 					},
 				},
 			},
 			"machine_networks": schema.ListNestedAttribute{
-				MarkdownDescription: "Machine networks configuration",
+				MarkdownDescription: "Machine networks configuration. With cluster-managed networking, the API and ingress VIPs must lie in one of them",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cidr": schema.StringAttribute{
 							MarkdownDescription: "Machine network CIDR",
 							Required:            true,
+							Validators: []validator.String{
+								validCIDR(),
+							},
 						},
 					},
 				},
@@ -327,22 +389,58 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 			"http_proxy": schema.StringAttribute{
-				MarkdownDescription: "HTTP proxy URL",
+				MarkdownDescription: "HTTP proxy URL, must use `http://`. Conflicts with `proxy`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validProxyURL("http"),
+				},
 			},
 			"https_proxy": schema.StringAttribute{
-				MarkdownDescription: "HTTPS proxy URL",
+				MarkdownDescription: "HTTPS proxy URL, `http://` or `https://`. Conflicts with `proxy`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validProxyURL("http", "https"),
+				},
 			},
 			"no_proxy": schema.StringAttribute{
-				MarkdownDescription: "Comma-separated list of hosts to bypass proxy",
+				MarkdownDescription: "Comma-separated list of domains, IP addresses and CIDRs to bypass proxy, or `*`. Conflicts with `proxy`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validNoProxy(),
+				},
+			},
+			"proxy": schema.SingleNestedAttribute{
+				MarkdownDescription: "Proxy configuration for the cluster, matching the `proxy` block of the infra-env resource. Alternative to the flat `http_proxy`, `https_proxy` and `no_proxy` attributes.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"http_proxy": schema.StringAttribute{
+						MarkdownDescription: "HTTP proxy URL, must use `http://`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validProxyURL("http"),
+						},
+					},
+					"https_proxy": schema.StringAttribute{
+						MarkdownDescription: "HTTPS proxy URL, `http://` or `https://`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validProxyURL("http", "https"),
+						},
+					},
+					"no_proxy": schema.StringAttribute{
+						MarkdownDescription: "Comma-separated list of domains, IP addresses and CIDRs to exclude from proxy, or `*`.",
+						Optional:            true,
+						Validators: []validator.String{
+							validNoProxy(),
+						},
+					},
+				},
 			},
 			"user_managed_networking": schema.BoolAttribute{
-				MarkdownDescription: "Enable user-managed networking. Note: Cluster-managed networking is only available for clusters with 3+ control plane nodes. Single-node OpenShift clusters will automatically use user-managed networking regardless of this setting.",
+				MarkdownDescription: "Enable user-managed networking. Note: Cluster-managed networking is only available for clusters with 3+ control plane nodes. Defaults to true for single-node OpenShift clusters, for which setting it to false is rejected.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -357,24 +455,27 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 			"high_availability_mode": schema.StringAttribute{
-				MarkdownDescription: "High availability mode (Full/None)",
+				MarkdownDescription: "High availability mode (Full/None). Deprecated, use `control_plane_count` instead; setting it produces a warning",
 				Optional:            true,
 				Computed:            true,
 			},
 			"network_type": schema.StringAttribute{
-				MarkdownDescription: "Network type (OpenShiftSDN/OVNKubernetes)",
+				MarkdownDescription: "Network type (OpenShiftSDN/OVNKubernetes). OpenShiftSDN is only supported up to OpenShift 4.14",
 				Optional:            true,
 				Computed:            true,
 			},
 			"schedulable_masters": schema.BoolAttribute{
-				MarkdownDescription: "Schedule workloads on masters. Default: false for multi-node, true for SNO",
+				MarkdownDescription: "Schedule workloads on masters. Default: false for multi-node, true for SNO and for compact clusters (3 control plane nodes with `worker_count` 0)",
 				Optional:            true,
 				Computed:            true,
 			},
 			"cpu_architecture": schema.StringAttribute{
-				MarkdownDescription: "CPU architecture (x86_64/arm64/ppc64le/s390x/multi). If not specified, will be determined by the OpenShift version and cluster configuration.",
+				MarkdownDescription: "CPU architecture (x86_64/aarch64/arm64/ppc64le/s390x/multi). `aarch64` and `arm64` are equivalent. If not specified, will be determined by the OpenShift version and cluster configuration.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cpuArchitectures...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -384,18 +485,93 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 			},
+			"worker_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of worker nodes planned for the cluster. Not sent to the API; setting it to 0 with 3 control plane nodes marks a compact cluster, for which `schedulable_masters` defaults to true.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"olm_operators": schema.ListNestedAttribute{
-				MarkdownDescription: "OLM operators to install during cluster deployment",
+				MarkdownDescription: "OLM operators to install during cluster deployment. Set to an empty list, or remove it, to remove all operators from the cluster.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Operator name",
 							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 						"properties": schema.StringAttribute{
-							MarkdownDescription: "Operator properties (JSON string)",
+							MarkdownDescription: "Operator properties (JSON object string). `version` must be a non-empty string and `namespace` a valid namespace name when set.",
 							Optional:            true,
+							Validators: []validator.String{
+								validOLMOperatorProperties(),
+							},
+						},
+					},
+				},
+			},
+			"bundle": schema.StringAttribute{
+				MarkdownDescription: "Operator bundle (e.g. `virtualization`) whose operators are added to `olm_operators` when the cluster is created. Use the `openshift_assisted_installer_operator_bundles` data source to list bundles.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expand_bundle": schema.BoolAttribute{
+				MarkdownDescription: "Whether to expand `bundle` into its operators when the cluster is created. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"bundle_operators": schema.ListAttribute{
+				MarkdownDescription: "Operators added to the cluster by expanding `bundle`, excluding those already listed in `olm_operators`",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operator_install_approval": schema.StringAttribute{
+				MarkdownDescription: "Deprecated, has no effect. The Assisted Service API does not report the package, channel and catalog source of the operator Subscriptions it creates, so their install plan approval (`Automatic` or `Manual`) cannot be set before installation. Set `installPlanApproval` on the installed cluster instead.",
+				Optional:            true,
+				DeprecationMessage:  operatorApprovalDeprecation,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Automatic", "Manual"),
+				},
+			},
+			"storage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Default storage for the cluster. Adds the storage operator to the cluster, so it does not need to be listed in `olm_operators`, and for `lvm` uploads an `LVMCluster` manifest with the device selection and thin pool size.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Storage type: `lvm` for LVM Storage on single node or compact clusters, `odf` for OpenShift Data Foundation.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("lvm", "odf"),
+						},
+					},
+					"device_selector": schema.ListAttribute{
+						MarkdownDescription: "Paths of the disks LVM Storage may use, e.g. `/dev/disk/by-path/pci-0000:00:1f.2-ata-2`. Prefer stable `/dev/disk/by-path` or `/dev/disk/by-id` paths. If not specified, all unused disks are used. Only supported with type `lvm`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(diskIDPattern, "must be a device path such as /dev/disk/by-path/pci-0000:00:1f.2-ata-2")),
+						},
+					},
+					"size_percent": schema.Int64Attribute{
+						MarkdownDescription: "Percentage of the volume group used for the thin pool. Defaults to 90. Only supported with type `lvm`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(10, 90),
 						},
 					},
 				},
@@ -447,8 +623,25 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Optional:            true,
 					},
 					"tang_servers": schema.StringAttribute{
-						MarkdownDescription: "Tang servers configuration (JSON)",
+						MarkdownDescription: "Tang servers configuration as a JSON array of objects with `url` and `thumbprint`. Only used when `tang_server_list` is empty.",
+						Optional:            true,
+						DeprecationMessage:  "tang_servers is replaced by tang_server_list.",
+					},
+					"tang_server_list": schema.ListNestedAttribute{
+						MarkdownDescription: "Tang servers the disks are bound to when `mode` is `tang`.",
 						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"url": schema.StringAttribute{
+									MarkdownDescription: "Tang server URL, e.g. `http://tang.example.com:7500`.",
+									Required:            true,
+								},
+								"thumbprint": schema.StringAttribute{
+									MarkdownDescription: "Thumbprint of the Tang server signing key.",
+									Required:            true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -461,7 +654,7 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Optional:            true,
 					},
 					"ca_cert_pem": schema.StringAttribute{
-						MarkdownDescription: "CA certificate in PEM format",
+						MarkdownDescription: "CA certificate in PEM format. Base64 encoded automatically before being sent to the API.",
 						Optional:            true,
 					},
 				},
@@ -545,9 +738,19 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"tags": schema.StringAttribute{
-				MarkdownDescription: "Comma-separated list of tags associated with the cluster",
+				MarkdownDescription: "Comma-separated list of tags associated with the cluster. At most 10 tags of up to 255 characters each.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validClusterTags(),
+				},
+			},
+			"install_config_overrides": schema.StringAttribute{
+				MarkdownDescription: "JSON document of overrides merged into the generated install-config.yaml, e.g. `jsonencode({ fips = true })`. Set through a separate API call after the cluster is created or updated. Removing it removes the overrides.",
+				Optional:            true,
+				Validators: []validator.String{
+					validJSON(),
+				},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Current cluster status",
@@ -594,10 +797,122 @@ func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *ClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ClusterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The proxy block and the flat proxy attributes are mutually exclusive
+	if !data.Proxy.IsNull() {
+		for name, value := range map[string]types.String{
+			"http_proxy":  data.HTTPProxy,
+			"https_proxy": data.HTTPSProxy,
+			"no_proxy":    data.NoProxy,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Proxy Configuration",
+					fmt.Sprintf("Attribute %q cannot be set together with the \"proxy\" block. Move the setting into the \"proxy\" block or remove the block.", name),
+				)
+			}
+		}
+	}
+
+	r.validateReleaseSelection(data, resp)
+	r.validateNetworkType(data, resp)
+	r.validateNetworkForms(data, resp)
+	r.validateHostPrefixes(data, resp)
+	r.validateVIPsInMachineNetworks(data, resp)
+	r.validateCompactTopology(data, resp)
+	r.validateSingleNodeNetworking(data, resp)
+	r.validateUserManagedNetworkingVIPs(data, resp)
+	r.validateHighAvailabilityMode(data, resp)
+	r.validateBaseDNSDomain(data, resp)
+	r.validateStorage(data, resp)
+	r.validateDiskEncryption(data, resp)
+}
+
+// validateReleaseSelection requires exactly one of openshift_version and
+// ocp_release_image, or both when the image tag matches the version
+func (r *ClusterResource) validateReleaseSelection(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.OpenshiftVersion.IsUnknown() || data.OCPReleaseImage.IsUnknown() {
+		return
+	}
+
+	if data.OpenshiftVersion.IsNull() && data.OCPReleaseImage.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("openshift_version"),
+			"Missing OpenShift Release",
+			"One of \"openshift_version\" or \"ocp_release_image\" must be set.",
+		)
+		return
+	}
+
+	if data.OCPReleaseImage.IsNull() {
+		return
+	}
+
+	image := data.OCPReleaseImage.ValueString()
+	releaseVersion, ok := releaseImageVersion(image)
+
+	if data.OpenshiftVersion.IsNull() {
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("openshift_version"),
+				"Missing OpenShift Version",
+				fmt.Sprintf("The OpenShift version cannot be derived from \"ocp_release_image\" %q because it is pinned by digest or its tag does not start with a version. Set \"openshift_version\" as well.", image),
+			)
+		}
+		return
+	}
+
+	// A digest-pinned image cannot be checked, so trust the configured version
+	if openshiftVersion := data.OpenshiftVersion.ValueString(); ok && !openshiftVersionMatches(openshiftVersion, releaseVersion) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ocp_release_image"),
+			"Conflicting OpenShift Release",
+			fmt.Sprintf("\"ocp_release_image\" %q is OpenShift %s but \"openshift_version\" is %q. Remove one of them or make them match.", image, releaseVersion, openshiftVersion),
+		)
+	}
+}
+
+// validateNetworkForms rejects setting a cluster or service network both as a
+// single CIDR and as a list. The single CIDR suits single-stack clusters, the
+// list is needed for dual-stack ones.
+func (r *ClusterResource) validateNetworkForms(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if !data.ClusterNetworks.IsNull() {
+		for name, value := range map[string]attr.Value{
+			"cluster_network_cidr":        data.ClusterNetworkCIDR,
+			"cluster_network_host_prefix": data.ClusterNetworkHostPrefix,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Cluster Network Configuration",
+					fmt.Sprintf("Attribute %q cannot be set together with \"cluster_networks\". Use \"cluster_network_cidr\" and \"cluster_network_host_prefix\" for a single-stack cluster, or list every network, with its host_prefix, in \"cluster_networks\".", name),
+				)
+			}
+		}
+	}
+
+	if !data.ServiceNetworks.IsNull() && !data.ServiceNetworkCIDR.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("service_network_cidr"),
+			"Conflicting Service Network Configuration",
+			"Attribute \"service_network_cidr\" cannot be set together with \"service_networks\". Use \"service_network_cidr\" for a single-stack cluster, or list every network in \"service_networks\".",
+		)
+	}
+}
+
 func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ClusterResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resolveConfiguredTopology(ctx, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -614,13 +929,33 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	// Convert Terraform model to API model
 	createParams := r.modelToCreateParams(data)
 
+	data.BundleOperators = types.ListNull(types.StringType)
+	if !data.Bundle.IsNull() && data.ExpandBundle.ValueBool() {
+		added, err := r.expandOperatorBundle(ctx, data.Bundle.ValueString(), &createParams)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error expanding operator bundle",
+				fmt.Sprintf("Could not expand operator bundle %s: %s", data.Bundle.ValueString(), err),
+			)
+			return
+		}
+
+		data.BundleOperators, diags = types.ListValueFrom(ctx, types.StringType, added)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Creating cluster", map[string]interface{}{
 		"name":              createParams.Name,
 		"openshift_version": createParams.OpenshiftVersion,
 	})
 
 	// Create cluster
-	cluster, err := r.client.CreateCluster(ctx, createParams)
+	createCtx, deprecations := client.CollectDeprecations(ctx)
+	cluster, err := r.client.CreateCluster(createCtx, createParams)
+	addDeprecationWarnings(deprecations, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating cluster",
@@ -629,9 +964,35 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if !data.InstallConfigOverrides.IsNull() {
+		if err := r.applyInstallConfigOverrides(ctx, cluster, data); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting install-config overrides",
+				fmt.Sprintf("Could not set the install-config overrides of cluster %s: %s", cluster.ID, err),
+			)
+		}
+	}
+
 	// Update state with created cluster data
 	r.updateModelFromCluster(&data, cluster)
 
+	if data.BaseDNSDomain.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("base_dns_domain"),
+			"Missing Base DNS Domain",
+			fmt.Sprintf("Cluster %s was created without a base DNS domain and the service did not assign one. Set \"base_dns_domain\" before installing the cluster.", cluster.ID),
+		)
+	}
+
+	if !data.Storage.IsNull() {
+		if err := r.syncStorageManifest(ctx, cluster.ID, data); err != nil {
+			resp.Diagnostics.AddError(
+				"Error configuring cluster storage",
+				fmt.Sprintf("Could not configure storage for cluster %s: %s", cluster.ID, err),
+			)
+		}
+	}
+
 	tflog.Info(ctx, "Cluster created successfully", map[string]interface{}{
 		"id":     cluster.ID,
 		"status": cluster.Status,
@@ -653,6 +1014,14 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	clusterID := data.ID.ValueString()
 	cluster, err := r.client.GetCluster(ctx, clusterID)
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning(
+				"Cluster not found",
+				fmt.Sprintf("Cluster %s no longer exists and will be removed from state", clusterID),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading cluster",
 			fmt.Sprintf("Could not read cluster %s: %s", clusterID, err),
@@ -669,6 +1038,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	var data ClusterResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resolveConfiguredTopology(ctx, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -682,14 +1052,39 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
 	defer cancel()
 
+	var state ClusterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unconfigured user_managed_networking is planned as unknown, but the
+	// cluster keeps its networking mode
+	if data.UserManagedNetworking.IsUnknown() {
+		data.UserManagedNetworking = state.UserManagedNetworking
+	}
+
 	clusterID := data.ID.ValueString()
 	updateParams := r.modelToUpdateParams(data)
 
+	// Removing olm_operators from the configuration removes the operators too
+	if data.OLMOperators.IsNull() && len(state.OLMOperators.Elements()) > 0 {
+		updateParams.OLMOperators = olmOperatorsParam(data)
+	}
+
+	// Changing the storage block adds or removes its operator
+	storageChanged := !data.Storage.Equal(state.Storage)
+	if storageChanged {
+		updateParams.OLMOperators = olmOperatorsParam(data)
+	}
+
 	tflog.Info(ctx, "Updating cluster", map[string]interface{}{
 		"id": clusterID,
 	})
 
-	cluster, err := r.client.UpdateCluster(ctx, clusterID, updateParams)
+	updateCtx, deprecations := client.CollectDeprecations(ctx)
+	cluster, err := r.client.UpdateCluster(updateCtx, clusterID, updateParams)
+	addDeprecationWarnings(deprecations, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating cluster",
@@ -698,8 +1093,37 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !data.InstallConfigOverrides.Equal(state.InstallConfigOverrides) {
+		if err := r.applyInstallConfigOverrides(ctx, cluster, data); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting install-config overrides",
+				fmt.Sprintf("Could not set the install-config overrides of cluster %s: %s", clusterID, err),
+			)
+		}
+	}
+
 	r.updateModelFromCluster(&data, cluster)
 
+	// Clusters configured with operator_install_approval may still carry the
+	// conflicting manifest earlier versions uploaded
+	if !state.OperatorInstallApproval.IsNull() {
+		if err := r.removeOperatorApprovalManifest(ctx, clusterID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing operator install approval",
+				fmt.Sprintf("Could not remove the operator install approval manifest of cluster %s: %s", clusterID, err),
+			)
+		}
+	}
+
+	if storageChanged {
+		if err := r.syncStorageManifest(ctx, clusterID, data); err != nil {
+			resp.Diagnostics.AddError(
+				"Error configuring cluster storage",
+				fmt.Sprintf("Could not configure storage for cluster %s: %s", clusterID, err),
+			)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -738,18 +1162,31 @@ func (r *ClusterResource) modelToCreateParams(data ClusterResourceModel) models.
 		PullSecret:       data.PullSecret.ValueString(),
 	}
 
+	// Without an explicit version, install the version the release image is tagged with
+	if params.OpenshiftVersion == "" && !data.OCPReleaseImage.IsUnknown() {
+		params.OpenshiftVersion, _ = releaseImageVersion(data.OCPReleaseImage.ValueString())
+	}
+
 	if !data.BaseDNSDomain.IsNull() {
 		params.BaseDNSDomain = data.BaseDNSDomain.ValueString()
 	}
-	if !data.ClusterNetworkCIDR.IsNull() {
-		params.ClusterNetworkCIDR = data.ClusterNetworkCIDR.ValueString()
-	}
-	if !data.ClusterNetworkHostPrefix.IsNull() {
-		params.ClusterNetworkHostPrefix = int(data.ClusterNetworkHostPrefix.ValueInt64())
+	// The network lists replace the single-network attributes when set
+	if networks := clusterNetworksFromModel(data); len(networks) > 0 {
+		params.ClusterNetworks = networks
+	} else {
+		if !data.ClusterNetworkCIDR.IsNull() {
+			params.ClusterNetworkCIDR = data.ClusterNetworkCIDR.ValueString()
+		}
+		if !data.ClusterNetworkHostPrefix.IsNull() {
+			params.ClusterNetworkHostPrefix = int(data.ClusterNetworkHostPrefix.ValueInt64())
+		}
 	}
-	if !data.ServiceNetworkCIDR.IsNull() {
+	if networks := serviceNetworksFromModel(data); len(networks) > 0 {
+		params.ServiceNetworks = networks
+	} else if !data.ServiceNetworkCIDR.IsNull() {
 		params.ServiceNetworkCIDR = data.ServiceNetworkCIDR.ValueString()
 	}
+	params.MachineNetworks = machineNetworksFromModel(data)
 	if !data.SSHPublicKey.IsNull() {
 		params.SSHPublicKey = data.SSHPublicKey.ValueString()
 	}
@@ -765,8 +1202,19 @@ func (r *ClusterResource) modelToCreateParams(data ClusterResourceModel) models.
 	if !data.NoProxy.IsNull() {
 		params.NoProxy = data.NoProxy.ValueString()
 	}
-	if !data.UserManagedNetworking.IsNull() {
-		params.UserManagedNetworking = data.UserManagedNetworking.ValueBool()
+	if proxy := r.proxyFromModel(data); proxy != nil {
+		if !proxy.HTTPProxy.IsNull() {
+			params.HTTPProxy = proxy.HTTPProxy.ValueString()
+		}
+		if !proxy.HTTPSProxy.IsNull() {
+			params.HTTPSProxy = proxy.HTTPSProxy.ValueString()
+		}
+		if !proxy.NoProxy.IsNull() {
+			params.NoProxy = proxy.NoProxy.ValueString()
+		}
+	}
+	if userManaged := userManagedNetworkingParam(data); userManaged != nil {
+		params.UserManagedNetworking = *userManaged
 	}
 	if !data.AdditionalNTPSource.IsNull() {
 		params.AdditionalNTPSource = data.AdditionalNTPSource.ValueString()
@@ -780,10 +1228,7 @@ func (r *ClusterResource) modelToCreateParams(data ClusterResourceModel) models.
 	if !data.NetworkType.IsNull() {
 		params.NetworkType = data.NetworkType.ValueString()
 	}
-	if !data.SchedulableMasters.IsNull() {
-		schedulable := data.SchedulableMasters.ValueBool()
-		params.SchedulableMasters = &schedulable
-	}
+	params.SchedulableMasters = schedulableMastersParam(data)
 	if !data.CPUArchitecture.IsNull() {
 		params.CPUArchitecture = data.CPUArchitecture.ValueString()
 	}
@@ -803,30 +1248,11 @@ func (r *ClusterResource) modelToCreateParams(data ClusterResourceModel) models.
 			}
 		}
 	}
+	params.OLMOperators = mergeStorageOperators(params.OLMOperators, storageFromModel(data))
 
-	// Convert API VIPs
-	if !data.APIVips.IsNull() {
-		var vips []APIVipModel
-		data.APIVips.ElementsAs(context.Background(), &vips, false)
-		params.APIVips = make([]models.APIVip, len(vips))
-		for i, vip := range vips {
-			params.APIVips[i] = models.APIVip{
-				IP: vip.IP.ValueString(),
-			}
-		}
-	}
-
-	// Convert Ingress VIPs
-	if !data.IngressVips.IsNull() {
-		var vips []IngressVipModel
-		data.IngressVips.ElementsAs(context.Background(), &vips, false)
-		params.IngressVips = make([]models.IngressVip, len(vips))
-		for i, vip := range vips {
-			params.IngressVips[i] = models.IngressVip{
-				IP: vip.IP.ValueString(),
-			}
-		}
-	}
+	// Convert API and Ingress VIPs
+	params.APIVips = r.apiVipsFromModel(data)
+	params.IngressVips = r.ingressVipsFromModel(data)
 
 	// Convert new structured fields
 	if !data.OCPReleaseImage.IsNull() {
@@ -837,12 +1263,45 @@ func (r *ClusterResource) modelToCreateParams(data ClusterResourceModel) models.
 		params.Tags = data.Tags.ValueString()
 	}
 
-	// TODO: Add conversion for cluster_networks, service_networks, machine_networks
-	// TODO: Add conversion for platform, load_balancer, disk_encryption, ignition_endpoint
+	params.IgnitionEndpoint = r.ignitionEndpointFromModel(data)
+	params.DiskEncryption = r.diskEncryptionFromModel(data)
+
+	// TODO: Add conversion for platform, load_balancer
 
 	return params
 }
 
+// expandOperatorBundle adds the operators of a bundle to the create params,
+// skipping those already configured, and returns the names of those it added
+func (r *ClusterResource) expandOperatorBundle(ctx context.Context, bundleID string, params *models.ClusterCreateParams) ([]string, error) {
+	operators, err := r.client.ResolveOperatorBundle(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make(map[string]bool, len(params.OLMOperators))
+	for _, op := range params.OLMOperators {
+		configured[op.Name] = true
+	}
+
+	added := []string{}
+	for _, op := range operators {
+		if configured[op.Name] {
+			continue
+		}
+		configured[op.Name] = true
+		params.OLMOperators = append(params.OLMOperators, op)
+		added = append(added, op.Name)
+	}
+
+	tflog.Info(ctx, "Expanded operator bundle", map[string]interface{}{
+		"bundle":          bundleID,
+		"operators_added": added,
+	})
+
+	return added, nil
+}
+
 func (r *ClusterResource) modelToUpdateParams(data ClusterResourceModel) models.ClusterUpdateParams {
 	params := models.ClusterUpdateParams{}
 
@@ -870,7 +1329,17 @@ func (r *ClusterResource) modelToUpdateParams(data ClusterResourceModel) models.
 		noProxy := data.NoProxy.ValueString()
 		params.NoProxy = &noProxy
 	}
-	if !data.AdditionalNTPSource.IsNull() {
+	if proxy := r.proxyFromModel(data); proxy != nil {
+		// Send every proxy field so that values removed from the block are cleared
+		httpProxy := proxy.HTTPProxy.ValueString()
+		httpsProxy := proxy.HTTPSProxy.ValueString()
+		noProxy := proxy.NoProxy.ValueString()
+		params.HTTPProxy = &httpProxy
+		params.HTTPSProxy = &httpsProxy
+		params.NoProxy = &noProxy
+	}
+	// Left alone when not configured, e.g. when managed by the cluster_ntp resource
+	if !data.AdditionalNTPSource.IsNull() && !data.AdditionalNTPSource.IsUnknown() {
 		ntp := data.AdditionalNTPSource.ValueString()
 		params.AdditionalNTPSource = &ntp
 	}
@@ -878,14 +1347,182 @@ func (r *ClusterResource) modelToUpdateParams(data ClusterResourceModel) models.
 		secret := data.PullSecret.ValueString()
 		params.PullSecret = &secret
 	}
-	if !data.SchedulableMasters.IsNull() {
-		schedulable := data.SchedulableMasters.ValueBool()
-		params.SchedulableMasters = &schedulable
+	params.SchedulableMasters = schedulableMastersParam(data)
+	params.UserManagedNetworking = userManagedNetworkingParam(data)
+
+	if !data.Hyperthreading.IsNull() && !data.Hyperthreading.IsUnknown() {
+		hyperthreading := data.Hyperthreading.ValueString()
+		params.Hyperthreading = &hyperthreading
+	}
+	if !data.NetworkType.IsNull() && !data.NetworkType.IsUnknown() {
+		networkType := data.NetworkType.ValueString()
+		params.NetworkType = &networkType
+	}
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		tags := data.Tags.ValueString()
+		params.Tags = &tags
+	}
+
+	params.ClusterNetworks = clusterNetworksFromModel(data)
+	params.ServiceNetworks = serviceNetworksFromModel(data)
+	params.MachineNetworks = machineNetworksFromModel(data)
+
+	// VIPs are only managed by the service when networking is cluster-managed,
+	// which is unknown until user_managed_networking is
+	if userManaged := params.UserManagedNetworking; userManaged != nil && !*userManaged {
+		params.APIVips = r.apiVipsFromModel(data)
+		params.IngressVips = r.ingressVipsFromModel(data)
+	}
+
+	params.IgnitionEndpoint = r.ignitionEndpointFromModel(data)
+	params.DiskEncryption = r.diskEncryptionFromModel(data)
+
+	if !data.OLMOperators.IsNull() && !data.OLMOperators.IsUnknown() {
+		params.OLMOperators = olmOperatorsParam(data)
 	}
 
 	return params
 }
 
+// olmOperatorsParam converts olm_operators to the full operator list of the
+// cluster, keeping the operators added by the bundle. The list is never nil,
+// so an emptied olm_operators is sent as [] and removes the operators.
+func olmOperatorsParam(data ClusterResourceModel) *[]models.OLMOperator {
+	var configured []OLMOperatorModel
+	if !data.OLMOperators.IsNull() && !data.OLMOperators.IsUnknown() {
+		data.OLMOperators.ElementsAs(context.Background(), &configured, false)
+	}
+
+	operators := make([]models.OLMOperator, 0, len(configured))
+	names := make(map[string]bool, len(configured))
+	for _, op := range configured {
+		operators = append(operators, models.OLMOperator{
+			Name:       op.Name.ValueString(),
+			Properties: op.Properties.ValueString(),
+		})
+		names[op.Name.ValueString()] = true
+	}
+
+	var bundleOperators []string
+	if !data.BundleOperators.IsNull() && !data.BundleOperators.IsUnknown() {
+		data.BundleOperators.ElementsAs(context.Background(), &bundleOperators, false)
+	}
+	for _, name := range bundleOperators {
+		if !names[name] {
+			operators = append(operators, models.OLMOperator{Name: name})
+		}
+	}
+	operators = mergeStorageOperators(operators, storageFromModel(data))
+
+	return &operators
+}
+
+// apiVipsFromModel converts the api_vips list to the API model
+func (r *ClusterResource) apiVipsFromModel(data ClusterResourceModel) []models.APIVip {
+	if data.APIVips.IsNull() || data.APIVips.IsUnknown() {
+		return nil
+	}
+
+	var vips []APIVipModel
+	data.APIVips.ElementsAs(context.Background(), &vips, false)
+	result := make([]models.APIVip, len(vips))
+	for i, vip := range vips {
+		result[i] = models.APIVip{
+			IP: vip.IP.ValueString(),
+		}
+	}
+
+	return result
+}
+
+// ingressVipsFromModel converts the ingress_vips list to the API model
+func (r *ClusterResource) ingressVipsFromModel(data ClusterResourceModel) []models.IngressVip {
+	if data.IngressVips.IsNull() || data.IngressVips.IsUnknown() {
+		return nil
+	}
+
+	var vips []IngressVipModel
+	data.IngressVips.ElementsAs(context.Background(), &vips, false)
+	result := make([]models.IngressVip, len(vips))
+	for i, vip := range vips {
+		result[i] = models.IngressVip{
+			IP: vip.IP.ValueString(),
+		}
+	}
+
+	return result
+}
+
+// proxyFromModel returns the proxy block from the model, or nil when it is not set
+func (r *ClusterResource) proxyFromModel(data ClusterResourceModel) *ClusterProxyModel {
+	if data.Proxy.IsNull() || data.Proxy.IsUnknown() {
+		return nil
+	}
+
+	var proxy ClusterProxyModel
+	if diags := data.Proxy.As(context.Background(), &proxy, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil
+	}
+
+	return &proxy
+}
+
+// ignitionEndpointFromModel converts the ignition_endpoint block to the API model.
+// The API expects the CA certificate base64 encoded, so the PEM is encoded here.
+func (r *ClusterResource) ignitionEndpointFromModel(data ClusterResourceModel) *models.IgnitionEndpoint {
+	if data.IgnitionEndpoint.IsNull() || data.IgnitionEndpoint.IsUnknown() {
+		return nil
+	}
+
+	var endpoint IgnitionEndpointModel
+	if diags := data.IgnitionEndpoint.As(context.Background(), &endpoint, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil
+	}
+
+	result := &models.IgnitionEndpoint{}
+	if !endpoint.URL.IsNull() {
+		result.URL = endpoint.URL.ValueString()
+	}
+	if !endpoint.CACertPEM.IsNull() && endpoint.CACertPEM.ValueString() != "" {
+		pem := normalizePEMCertificate(endpoint.CACertPEM.ValueString())
+		result.CACertificate = base64.StdEncoding.EncodeToString([]byte(pem))
+	}
+
+	return result
+}
+
+// ignitionEndpointToModel converts the API ignition endpoint back into the
+// ignition_endpoint block, decoding the CA certificate to PEM. The configured
+// PEM is kept when it only differs from the API value by normalization.
+func (r *ClusterResource) ignitionEndpointToModel(data *ClusterResourceModel, endpoint *models.IgnitionEndpoint) types.Object {
+	var prior IgnitionEndpointModel
+	if !data.IgnitionEndpoint.IsNull() && !data.IgnitionEndpoint.IsUnknown() {
+		data.IgnitionEndpoint.As(context.Background(), &prior, basetypes.ObjectAsOptions{})
+	}
+
+	result := IgnitionEndpointModel{
+		URL:       types.StringNull(),
+		CACertPEM: types.StringNull(),
+	}
+	if endpoint.URL != "" {
+		result.URL = types.StringValue(endpoint.URL)
+	}
+	if endpoint.CACertificate != "" {
+		pem := endpoint.CACertificate
+		if decoded, err := base64.StdEncoding.DecodeString(endpoint.CACertificate); err == nil {
+			pem = string(decoded)
+		}
+		if !prior.CACertPEM.IsNull() && normalizePEMCertificate(prior.CACertPEM.ValueString()) == normalizePEMCertificate(pem) {
+			result.CACertPEM = prior.CACertPEM
+		} else {
+			result.CACertPEM = types.StringValue(pem)
+		}
+	}
+
+	objValue, _ := types.ObjectValueFrom(context.Background(), ignitionEndpointAttrTypes, result)
+	return objValue
+}
+
 func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, cluster *models.Cluster) {
 	data.ID = types.StringValue(cluster.ID)
 	data.Name = types.StringValue(cluster.Name)
@@ -900,6 +1537,8 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 
 	if cluster.BaseDNSDomain != "" {
 		data.BaseDNSDomain = types.StringValue(cluster.BaseDNSDomain)
+	} else if data.BaseDNSDomain.IsUnknown() {
+		data.BaseDNSDomain = types.StringNull()
 	}
 	if cluster.ClusterNetworkCIDR != "" {
 		data.ClusterNetworkCIDR = types.StringValue(cluster.ClusterNetworkCIDR)
@@ -945,7 +1584,7 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 
 	// Set CPU architecture from API response
 	if cluster.CPUArchitecture != "" {
-		data.CPUArchitecture = types.StringValue(cluster.CPUArchitecture)
+		data.CPUArchitecture = cpuArchitectureValue(data.CPUArchitecture, cluster.CPUArchitecture)
 	}
 
 	// Set computed fields that must always have values
@@ -979,15 +1618,24 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 	// Set schedulable masters
 	data.SchedulableMasters = types.BoolValue(cluster.SchedulableMasters)
 
-	// Convert OLM operators
-	if len(cluster.OLMOperators) > 0 {
-		operators := make([]OLMOperatorModel, len(cluster.OLMOperators))
-		for i, op := range cluster.OLMOperators {
-			operators[i] = OLMOperatorModel{
-				Name:       types.StringValue(op.Name),
-				Properties: types.StringValue(op.Properties),
-			}
+	// Convert OLM operators, leaving out those added by expanding the bundle
+	// so they do not show up as drift against the configured olm_operators
+	var bundleOperators []string
+	if !data.BundleOperators.IsNull() && !data.BundleOperators.IsUnknown() {
+		data.BundleOperators.ElementsAs(context.Background(), &bundleOperators, false)
+	}
+
+	var operators []OLMOperatorModel
+	for _, op := range cluster.OLMOperators {
+		if slices.Contains(bundleOperators, op.Name) || isStorageOperator(*data, op.Name) {
+			continue
 		}
+		operators = append(operators, OLMOperatorModel{
+			Name:       types.StringValue(op.Name),
+			Properties: types.StringValue(op.Properties),
+		})
+	}
+	if len(operators) > 0 {
 		listValue, _ := types.ListValueFrom(context.Background(), types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"name":       types.StringType,
@@ -997,8 +1645,11 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 		data.OLMOperators = listValue
 	}
 
+	// VIPs are only refreshed when configured here, as they may instead be
+	// applied after host discovery by the cluster_installation resource
+
 	// Convert API VIPs
-	if len(cluster.APIVips) > 0 {
+	if len(cluster.APIVips) > 0 && !data.APIVips.IsNull() {
 		vips := make([]APIVipModel, len(cluster.APIVips))
 		for i, vip := range cluster.APIVips {
 			vips[i] = APIVipModel{
@@ -1014,7 +1665,7 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 	}
 
 	// Convert Ingress VIPs
-	if len(cluster.IngressVips) > 0 {
+	if len(cluster.IngressVips) > 0 && !data.IngressVips.IsNull() {
 		vips := make([]IngressVipModel, len(cluster.IngressVips))
 		for i, vip := range cluster.IngressVips {
 			vips[i] = IngressVipModel{
@@ -1042,6 +1693,8 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 		data.Tags = types.StringNull()
 	}
 
+	data.InstallConfigOverrides = installConfigOverridesValue(data.InstallConfigOverrides, cluster.InstallConfigOverrides)
+
 	// Set ImageInfo if present
 	if cluster.ImageInfo != nil {
 		imageInfo := ImageInfoModel{}
@@ -1169,6 +1822,11 @@ func (r *ClusterResource) updateModelFromCluster(data *ClusterResourceModel, clu
 		})
 	}
 
+	// Set ignition endpoint only when the API reports one, so an unset block stays null
+	if cluster.IgnitionEndpoint != nil && (cluster.IgnitionEndpoint.URL != "" || cluster.IgnitionEndpoint.CACertificate != "") {
+		data.IgnitionEndpoint = r.ignitionEndpointToModel(data, cluster.IgnitionEndpoint)
+	}
+
 	// Set deleted_at if present
 	if cluster.DeletedAt != "" {
 		data.DeletedAt = types.StringValue(cluster.DeletedAt)
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	// storageManifestFolder and storageManifestFileName identify the
+	// LVMCluster manifest generated for a storage block of type lvm
+	storageManifestFolder   = "openshift"
+	storageManifestFileName = "99-storage-lvmcluster.yaml"
+
+	// defaultLVMSizePercent is the share of the volume group given to the thin
+	// pool when size_percent is not set, matching the LVM operator default
+	defaultLVMSizePercent = 90
+)
+
+// ClusterStorageModel describes the storage block of the cluster resource
+type ClusterStorageModel struct {
+	Type           types.String `tfsdk:"type"`
+	DeviceSelector types.List   `tfsdk:"device_selector"`
+	SizePercent    types.Int64  `tfsdk:"size_percent"`
+}
+
+// storageOperators maps a storage type to the operator requested for it,
+// followed by the operators the service adds as its dependencies. All of them
+// are left out of olm_operators when reading the cluster.
+var storageOperators = map[string][]string{
+	"lvm": {"lvm"},
+	"odf": {"odf", "lso"},
+}
+
+// clusterStorage is the storage block converted from its Terraform model
+type clusterStorage struct {
+	Type           string
+	DeviceSelector []string
+	SizePercent    int64
+}
+
+// storageFromModel returns the configured storage block, or nil when unset
+func storageFromModel(data ClusterResourceModel) *clusterStorage {
+	if data.Storage.IsNull() || data.Storage.IsUnknown() {
+		return nil
+	}
+
+	var model ClusterStorageModel
+	if diags := data.Storage.As(context.Background(), &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil
+	}
+
+	storage := &clusterStorage{
+		Type:        model.Type.ValueString(),
+		SizePercent: defaultLVMSizePercent,
+	}
+	if !model.DeviceSelector.IsNull() && !model.DeviceSelector.IsUnknown() {
+		model.DeviceSelector.ElementsAs(context.Background(), &storage.DeviceSelector, false)
+	}
+	if !model.SizePercent.IsNull() && !model.SizePercent.IsUnknown() {
+		storage.SizePercent = model.SizePercent.ValueInt64()
+	}
+
+	return storage
+}
+
+// olmOperators returns the operator the cluster needs for this storage type.
+// Neither operator takes properties, the LVM device selection is applied with
+// the LVMCluster manifest instead.
+func (s *clusterStorage) olmOperators() []models.OLMOperator {
+	if s == nil || len(storageOperators[s.Type]) == 0 {
+		return nil
+	}
+	return []models.OLMOperator{{Name: storageOperators[s.Type][0]}}
+}
+
+// manifest renders the manifest for this storage type, or "" when the
+// operator's defaults need no manifest
+func (s *clusterStorage) manifest() string {
+	if s == nil || s.Type != "lvm" {
+		return ""
+	}
+	return lvmClusterManifest(s.DeviceSelector, s.SizePercent)
+}
+
+// lvmClusterManifest renders an LVMCluster with a single default device class
+// backed by a thin pool of sizePercent of the volume group. Without device
+// paths the LVM operator uses all unused disks of the node.
+func lvmClusterManifest(devicePaths []string, sizePercent int64) string {
+	var b strings.Builder
+
+	b.WriteString(`apiVersion: lvm.topolvm.io/v1alpha1
+kind: LVMCluster
+metadata:
+  name: lvmcluster
+  namespace: openshift-storage
+spec:
+  storage:
+    deviceClasses:
+    - name: vg1
+      default: true
+      fstype: xfs
+`)
+	if len(devicePaths) > 0 {
+		b.WriteString("      deviceSelector:\n        paths:\n")
+		for _, devicePath := range devicePaths {
+			fmt.Fprintf(&b, "        - %s\n", devicePath)
+		}
+	}
+	fmt.Fprintf(&b, `      thinPoolConfig:
+        name: thin-pool-1
+        sizePercent: %d
+        overprovisionRatio: 10
+`, sizePercent)
+
+	return b.String()
+}
+
+// mergeStorageOperators appends the storage operators missing from operators
+func mergeStorageOperators(operators []models.OLMOperator, storage *clusterStorage) []models.OLMOperator {
+	for _, op := range storage.olmOperators() {
+		if !slices.ContainsFunc(operators, func(existing models.OLMOperator) bool { return existing.Name == op.Name }) {
+			operators = append(operators, op)
+		}
+	}
+	return operators
+}
+
+// isStorageOperator reports whether the operator was added for the storage block
+func isStorageOperator(data ClusterResourceModel, name string) bool {
+	storage := storageFromModel(data)
+	return storage != nil && slices.Contains(storageOperators[storage.Type], name)
+}
+
+// syncStorageManifest replaces the generated storage manifest on the cluster,
+// removing it when the storage block is unset or does not need one
+func (r *ClusterResource) syncStorageManifest(ctx context.Context, clusterID string, data ClusterResourceModel) error {
+	err := r.client.DeleteManifest(ctx, clusterID, storageManifestFolder, storageManifestFileName)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return fmt.Errorf("failed to remove storage manifest: %w", err)
+	}
+
+	content := storageFromModel(data).manifest()
+	if content == "" {
+		return nil
+	}
+
+	err = r.client.CreateManifest(ctx, clusterID, models.CreateManifestParams{
+		Folder:   storageManifestFolder,
+		FileName: storageManifestFileName,
+		Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create storage manifest: %w", err)
+	}
+
+	return nil
+}
+
+// validateStorage rejects storage settings the chosen storage type ignores.
+// ODF is deployed by the service on all eligible disks, so it cannot be
+// limited to selected devices or sized.
+func (r *ClusterResource) validateStorage(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.Storage.IsNull() || data.Storage.IsUnknown() {
+		return
+	}
+
+	var model ClusterStorageModel
+	resp.Diagnostics.Append(data.Storage.As(context.Background(), &model, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || model.Type.ValueString() != "odf" {
+		return
+	}
+
+	for name, value := range map[string]interface{ IsNull() bool }{
+		"device_selector": model.DeviceSelector,
+		"size_percent":    model.SizePercent,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("storage").AtName(name),
+				"Unsupported Storage Setting",
+				fmt.Sprintf("\"storage.%s\" is only supported with type \"lvm\". ODF uses all eligible disks that are not the installation disk.", name),
+			)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newStorageValue builds a storage block value, leaving device_selector and
+// size_percent null when empty
+func newStorageValue(t *testing.T, storageType string, devices []string, sizePercent int64) tftypes.Value {
+	t.Helper()
+
+	objType := clusterConfigAttributeType(t, "storage").(tftypes.Object)
+
+	deviceSelector := tftypes.NewValue(objType.AttributeTypes["device_selector"], nil)
+	if len(devices) > 0 {
+		values := make([]tftypes.Value, len(devices))
+		for i, device := range devices {
+			values[i] = tftypes.NewValue(tftypes.String, device)
+		}
+		deviceSelector = tftypes.NewValue(objType.AttributeTypes["device_selector"], values)
+	}
+
+	size := tftypes.NewValue(tftypes.Number, nil)
+	if sizePercent > 0 {
+		size = tftypes.NewValue(tftypes.Number, sizePercent)
+	}
+
+	return tftypes.NewValue(objType, map[string]tftypes.Value{
+		"type":            tftypes.NewValue(tftypes.String, storageType),
+		"device_selector": deviceSelector,
+		"size_percent":    size,
+	})
+}
+
+func TestLVMClusterManifest(t *testing.T) {
+	tests := []struct {
+		name                string
+		devicePaths         []string
+		sizePercent         int64
+		expectedContains    []string
+		expectedNotContains []string
+	}{
+		{
+			name:        "all unused disks",
+			sizePercent: defaultLVMSizePercent,
+			expectedContains: []string{
+				"kind: LVMCluster",
+				"namespace: openshift-storage",
+				"- name: vg1",
+				"sizePercent: 90",
+			},
+			expectedNotContains: []string{"deviceSelector"},
+		},
+		{
+			name:        "selected disks",
+			devicePaths: []string{"/dev/disk/by-path/pci-0000:00:1f.2-ata-2", "/dev/sdc"},
+			sizePercent: 50,
+			expectedContains: []string{
+				"      deviceSelector:\n        paths:\n        - /dev/disk/by-path/pci-0000:00:1f.2-ata-2\n        - /dev/sdc\n",
+				"sizePercent: 50",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := lvmClusterManifest(tt.devicePaths, tt.sizePercent)
+			for _, expected := range tt.expectedContains {
+				if !strings.Contains(manifest, expected) {
+					t.Errorf("Expected manifest to contain %q:\n%s", expected, manifest)
+				}
+			}
+			for _, unexpected := range tt.expectedNotContains {
+				if strings.Contains(manifest, unexpected) {
+					t.Errorf("Expected manifest not to contain %q:\n%s", unexpected, manifest)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterResource_Create_Storage(t *testing.T) {
+	tests := []struct {
+		name              string
+		storage           func(t *testing.T) tftypes.Value
+		expectedOperators []string
+		expectedManifest  []string
+	}{
+		{
+			name: "lvm",
+			storage: func(t *testing.T) tftypes.Value {
+				return newStorageValue(t, "lvm", []string{"/dev/disk/by-path/pci-0000:00:1f.2-ata-2"}, 70)
+			},
+			expectedOperators: []string{"cnv", "lvm"},
+			expectedManifest:  []string{"kind: LVMCluster", "- /dev/disk/by-path/pci-0000:00:1f.2-ata-2", "sizePercent: 70"},
+		},
+		{
+			name: "odf",
+			storage: func(t *testing.T) tftypes.Value {
+				return newStorageValue(t, "odf", nil, 0)
+			},
+			expectedOperators: []string{"cnv", "odf"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requested []models.OLMOperator
+			var manifest *models.CreateManifestParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters":
+					var params models.ClusterCreateParams
+					if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+						t.Errorf("Failed to decode create params: %v", err)
+					}
+					requested = params.OLMOperators
+					// The service adds the dependencies of the requested operators
+					operators := params.OLMOperators
+					if slices.ContainsFunc(operators, func(op models.OLMOperator) bool { return op.Name == "odf" }) {
+						operators = append(operators, models.OLMOperator{Name: "lso"})
+					}
+					w.WriteHeader(http.StatusCreated)
+					_ = json.NewEncoder(w).Encode(models.Cluster{
+						ID:               "test-cluster-id",
+						Name:             params.Name,
+						OpenshiftVersion: params.OpenshiftVersion,
+						Status:           "insufficient",
+						OLMOperators:     operators,
+					})
+				case r.Method == http.MethodDelete && r.URL.Path == "/v2/clusters/test-cluster-id/manifests":
+					w.WriteHeader(http.StatusNotFound)
+				case r.Method == http.MethodPost && r.URL.Path == "/v2/clusters/test-cluster-id/manifests":
+					manifest = &models.CreateManifestParams{}
+					if err := json.NewDecoder(r.Body).Decode(manifest); err != nil {
+						t.Errorf("Failed to decode manifest: %v", err)
+					}
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`{}`))
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			operatorsType := clusterConfigAttributeType(t, "olm_operators").(tftypes.List)
+			operatorType := operatorsType.ElementType.(tftypes.Object)
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"pull_secret":       tftypes.NewValue(tftypes.String, "pull-secret"),
+				"olm_operators": tftypes.NewValue(operatorsType, []tftypes.Value{
+					tftypes.NewValue(operatorType, map[string]tftypes.Value{
+						"name":       tftypes.NewValue(tftypes.String, "cnv"),
+						"properties": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+				"storage": tt.storage(t),
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var names []string
+			for _, op := range requested {
+				if op.Properties != "" {
+					t.Errorf("Expected operator %s without properties, got %s", op.Name, op.Properties)
+				}
+				names = append(names, op.Name)
+			}
+			if !slices.Equal(names, tt.expectedOperators) {
+				t.Errorf("Expected create request operators %v, got %v", tt.expectedOperators, names)
+			}
+
+			if len(tt.expectedManifest) == 0 {
+				if manifest != nil {
+					t.Errorf("Expected no storage manifest, got %+v", manifest)
+				}
+			} else {
+				if manifest == nil {
+					t.Fatal("Expected a storage manifest to be created")
+				}
+				if manifest.Folder != storageManifestFolder || manifest.FileName != storageManifestFileName {
+					t.Errorf("Unexpected manifest location %s/%s", manifest.Folder, manifest.FileName)
+				}
+				content, err := base64.StdEncoding.DecodeString(manifest.Content)
+				if err != nil {
+					t.Fatalf("Manifest content is not base64 encoded: %v", err)
+				}
+				for _, expected := range tt.expectedManifest {
+					if !strings.Contains(string(content), expected) {
+						t.Errorf("Expected manifest to contain %q:\n%s", expected, content)
+					}
+				}
+			}
+
+			// Only the configured operator is tracked in olm_operators
+			var data ClusterResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			var operators []OLMOperatorModel
+			data.OLMOperators.ElementsAs(ctx, &operators, false)
+			if len(operators) != 1 || operators[0].Name.ValueString() != "cnv" {
+				t.Errorf("Expected olm_operators to only contain cnv, got %+v", operators)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_Storage(t *testing.T) {
+	tests := []struct {
+		name        string
+		storage     func(t *testing.T) tftypes.Value
+		expectError string
+	}{
+		{
+			name:    "lvm with selector and size",
+			storage: func(t *testing.T) tftypes.Value { return newStorageValue(t, "lvm", []string{"/dev/sdb"}, 50) },
+		},
+		{
+			name:    "odf defaults",
+			storage: func(t *testing.T) tftypes.Value { return newStorageValue(t, "odf", nil, 0) },
+		},
+		{
+			name:        "odf with selector",
+			storage:     func(t *testing.T) tftypes.Value { return newStorageValue(t, "odf", []string{"/dev/sdb"}, 0) },
+			expectError: "Unsupported Storage Setting",
+		},
+		{
+			name:        "odf with size",
+			storage:     func(t *testing.T) tftypes.Value { return newStorageValue(t, "odf", nil, 50) },
+			expectError: "Unsupported Storage Setting",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newClusterConfig(t, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"storage":           tt.storage(t),
+			})
+
+			resp := &resource.ValidateConfigResponse{}
+			(&ClusterResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+			if tt.expectError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("Expected no error, got %+v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.expectError {
+				t.Errorf("Expected error %q, got %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
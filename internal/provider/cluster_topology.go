@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// compactControlPlaneCount is the number of control plane nodes in a compact
+// cluster, which runs workloads on its control plane instead of on workers
+const compactControlPlaneCount = 3
+
+// resolveConfiguredTopology replaces a planned control_plane_count or
+// high_availability_mode that is unknown with its configured value. Both are
+// computed, so the plan leaves them unknown when they are not configured,
+// while in the configuration they are null and the topology helpers fall back
+// the same way as in ValidateConfig.
+func resolveConfiguredTopology(ctx context.Context, config tfsdk.Config, data *ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.ControlPlaneCount.IsUnknown() {
+		diags.Append(config.GetAttribute(ctx, path.Root("control_plane_count"), &data.ControlPlaneCount)...)
+	}
+	if data.HighAvailabilityMode.IsUnknown() {
+		diags.Append(config.GetAttribute(ctx, path.Root("high_availability_mode"), &data.HighAvailabilityMode)...)
+	}
+
+	return diags
+}
+
+// isCompactTopology reports whether the configuration describes a compact
+// cluster: three control plane nodes and no workers. worker_count must be
+// set explicitly, as workers can otherwise be added after cluster creation.
+func isCompactTopology(data ClusterResourceModel) bool {
+	if data.WorkerCount.IsNull() || data.WorkerCount.IsUnknown() || data.WorkerCount.ValueInt64() != 0 {
+		return false
+	}
+
+	switch {
+	case data.ControlPlaneCount.IsUnknown():
+		return false
+	case !data.ControlPlaneCount.IsNull():
+		return data.ControlPlaneCount.ValueInt64() == compactControlPlaneCount
+	default:
+		// Without control_plane_count, only high_availability_mode "None" (SNO) deviates from three
+		return data.HighAvailabilityMode.IsUnknown() || data.HighAvailabilityMode.ValueString() != "None"
+	}
+}
+
+// schedulableMastersParam returns the schedulable_masters value to send to the
+// API. Compact clusters need schedulable masters, so it defaults to true for
+// them when not configured.
+func schedulableMastersParam(data ClusterResourceModel) *bool {
+	if !data.SchedulableMasters.IsNull() && !data.SchedulableMasters.IsUnknown() {
+		schedulable := data.SchedulableMasters.ValueBool()
+		return &schedulable
+	}
+
+	if isCompactTopology(data) {
+		schedulable := true
+		return &schedulable
+	}
+
+	return nil
+}
+
+// validateCompactTopology warns when a compact cluster is configured with
+// unschedulable masters, as it would have nowhere to run workloads
+func (r *ClusterResource) validateCompactTopology(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if !isCompactTopology(data) || data.SchedulableMasters.IsNull() || data.SchedulableMasters.IsUnknown() {
+		return
+	}
+
+	if !data.SchedulableMasters.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("schedulable_masters"),
+			"Unschedulable Masters on Compact Cluster",
+			fmt.Sprintf("The cluster has %d control plane nodes and \"worker_count\" is 0, so workloads can only run on the control plane. "+
+				"The Assisted Service will force \"schedulable_masters\" to true during installation. Remove \"schedulable_masters\" or set it to true.", compactControlPlaneCount),
+		)
+	}
+}
+
+// isSingleNodeTopology reports whether the configuration describes a single
+// node cluster, through control_plane_count or the deprecated
+// high_availability_mode
+func isSingleNodeTopology(data ClusterResourceModel) bool {
+	switch {
+	case data.ControlPlaneCount.IsUnknown():
+		return false
+	case !data.ControlPlaneCount.IsNull():
+		return data.ControlPlaneCount.ValueInt64() == 1
+	default:
+		return !data.HighAvailabilityMode.IsUnknown() && data.HighAvailabilityMode.ValueString() == "None"
+	}
+}
+
+// userManagedNetworkingParam returns the user_managed_networking value to send
+// to the API. Single node clusters only support user-managed networking, so it
+// is true for them when not configured.
+func userManagedNetworkingParam(data ClusterResourceModel) *bool {
+	if !data.UserManagedNetworking.IsNull() && !data.UserManagedNetworking.IsUnknown() {
+		userManaged := data.UserManagedNetworking.ValueBool()
+		return &userManaged
+	}
+
+	if isSingleNodeTopology(data) {
+		userManaged := true
+		return &userManaged
+	}
+
+	return nil
+}
+
+// validateSingleNodeNetworking rejects cluster-managed networking on single
+// node clusters, which the Assisted Service would refuse
+func (r *ClusterResource) validateSingleNodeNetworking(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if !isSingleNodeTopology(data) || data.UserManagedNetworking.IsNull() || data.UserManagedNetworking.IsUnknown() {
+		return
+	}
+
+	if !data.UserManagedNetworking.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_managed_networking"),
+			"Cluster-Managed Networking on Single Node Cluster",
+			"Single node clusters only support user-managed networking. Remove \"user_managed_networking\" or set it to true.",
+		)
+	}
+}
+
+// validateUserManagedNetworkingVIPs rejects API and ingress VIPs on clusters
+// with user-managed networking, including single node clusters that default
+// to it. The load balancer is then provided outside the cluster, so the
+// Assisted Service refuses VIPs.
+func (r *ClusterResource) validateUserManagedNetworkingVIPs(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.UserManagedNetworking.IsUnknown() {
+		return
+	}
+
+	userManaged := data.UserManagedNetworking.ValueBool()
+	reason := "\"user_managed_networking\" is true"
+	if data.UserManagedNetworking.IsNull() {
+		userManaged = isSingleNodeTopology(data)
+		reason = "single node clusters use user-managed networking"
+	}
+	if !userManaged {
+		return
+	}
+
+	for _, attr := range []struct {
+		name string
+		vips types.List
+	}{
+		{"api_vips", data.APIVips},
+		{"ingress_vips", data.IngressVips},
+	} {
+		if attr.vips.IsNull() || attr.vips.IsUnknown() || len(attr.vips.Elements()) == 0 {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attr.name),
+			"VIPs With User-Managed Networking",
+			fmt.Sprintf("%q cannot be set because %s, which relies on an external load balancer instead of VIPs. Remove %q, or set \"user_managed_networking\" to false on a multi-node cluster.", attr.name, reason, attr.name),
+		)
+	}
+}
+
+// validateHighAvailabilityMode warns when the deprecated high_availability_mode
+// is configured. It keeps working, but control_plane_count replaces it.
+func (r *ClusterResource) validateHighAvailabilityMode(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.HighAvailabilityMode.IsNull() {
+		return
+	}
+
+	replacement := "control_plane_count"
+	if !data.HighAvailabilityMode.IsUnknown() {
+		switch data.HighAvailabilityMode.ValueString() {
+		case "None":
+			replacement = "control_plane_count = 1"
+		case "Full":
+			replacement = fmt.Sprintf("control_plane_count = %d", compactControlPlaneCount)
+		}
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("high_availability_mode"),
+		"Deprecated Attribute",
+		fmt.Sprintf("\"high_availability_mode\" is deprecated by the Assisted Service in favor of \"control_plane_count\". Replace it with \"%s\".", replacement),
+	)
+}
+
+// ModifyPlan plans the values the service derives from the configuration, so
+// that they do not show up as changes on later plans
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the cluster is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planUserManagedNetworking(ctx, req, resp)
+	r.planNetworkCIDRs(ctx, req, resp)
+}
+
+// planUserManagedNetworking plans user_managed_networking as true for single
+// node clusters that do not configure it, as the service enables it on create
+func (r *ClusterResource) planUserManagedNetworking(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configured types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_managed_networking"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	var data ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resolveConfiguredTopology(ctx, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSingleNodeTopology(data) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_managed_networking"), types.BoolValue(true))...)
+	}
+}
@@ -0,0 +1,633 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestIsCompactTopology(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     ClusterResourceModel
+		expected bool
+	}{
+		{
+			name:     "three control planes without workers",
+			data:     ClusterResourceModel{ControlPlaneCount: types.Int64Value(3), WorkerCount: types.Int64Value(0)},
+			expected: true,
+		},
+		{
+			name:     "default control plane without workers",
+			data:     ClusterResourceModel{HighAvailabilityMode: types.StringValue("Full"), WorkerCount: types.Int64Value(0)},
+			expected: true,
+		},
+		{
+			name: "three control planes with workers",
+			data: ClusterResourceModel{ControlPlaneCount: types.Int64Value(3), WorkerCount: types.Int64Value(2)},
+		},
+		{
+			name: "worker count not set",
+			data: ClusterResourceModel{ControlPlaneCount: types.Int64Value(3), WorkerCount: types.Int64Null()},
+		},
+		{
+			name: "single node",
+			data: ClusterResourceModel{ControlPlaneCount: types.Int64Value(1), WorkerCount: types.Int64Value(0)},
+		},
+		{
+			name: "single node via high availability mode",
+			data: ClusterResourceModel{HighAvailabilityMode: types.StringValue("None"), WorkerCount: types.Int64Value(0)},
+		},
+		{
+			name: "five control planes",
+			data: ClusterResourceModel{ControlPlaneCount: types.Int64Value(5), WorkerCount: types.Int64Value(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCompactTopology(tt.data); got != tt.expected {
+				t.Errorf("isCompactTopology() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClusterResource_CompactSchedulableMasters(t *testing.T) {
+	r := &ClusterResource{}
+
+	compact := ClusterResourceModel{
+		ControlPlaneCount:  types.Int64Value(3),
+		WorkerCount:        types.Int64Value(0),
+		SchedulableMasters: types.BoolNull(),
+	}
+
+	createParams := r.modelToCreateParams(compact)
+	if createParams.SchedulableMasters == nil || !*createParams.SchedulableMasters {
+		t.Errorf("Expected schedulable_masters to default to true for a compact cluster on create, got %v", createParams.SchedulableMasters)
+	}
+
+	compact.SchedulableMasters = types.BoolUnknown()
+	updateParams := r.modelToUpdateParams(compact)
+	if updateParams.SchedulableMasters == nil || !*updateParams.SchedulableMasters {
+		t.Errorf("Expected schedulable_masters to default to true for a compact cluster on update, got %v", updateParams.SchedulableMasters)
+	}
+
+	standard := ClusterResourceModel{
+		ControlPlaneCount:  types.Int64Value(3),
+		WorkerCount:        types.Int64Value(2),
+		SchedulableMasters: types.BoolNull(),
+	}
+	if params := r.modelToCreateParams(standard); params.SchedulableMasters != nil {
+		t.Errorf("Expected schedulable_masters to be left to the API with workers, got %v", *params.SchedulableMasters)
+	}
+
+	// An explicit value is always sent as configured
+	compact.SchedulableMasters = types.BoolValue(false)
+	if params := r.modelToCreateParams(compact); params.SchedulableMasters == nil || *params.SchedulableMasters {
+		t.Errorf("Expected explicit schedulable_masters false to be kept, got %v", params.SchedulableMasters)
+	}
+}
+
+func TestClusterResource_ValidateConfig_CompactTopology(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name          string
+		values        map[string]tftypes.Value
+		expectWarning bool
+	}{
+		{
+			name: "compact without schedulable_masters",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+				"worker_count":        tftypes.NewValue(tftypes.Number, 0),
+			},
+		},
+		{
+			name: "compact with schedulable masters",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+				"worker_count":        tftypes.NewValue(tftypes.Number, 0),
+				"schedulable_masters": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		{
+			name: "compact with unschedulable masters",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+				"worker_count":        tftypes.NewValue(tftypes.Number, 0),
+				"schedulable_masters": tftypes.NewValue(tftypes.Bool, false),
+			},
+			expectWarning: true,
+		},
+		{
+			name: "workers with unschedulable masters",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+				"worker_count":        tftypes.NewValue(tftypes.Number, 3),
+				"schedulable_masters": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ValidateConfig() returned errors: %+v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.expectWarning {
+				t.Errorf("Expected warning: %v, got diagnostics: %+v", tt.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterResource_SingleNodeUserManagedNetworking(t *testing.T) {
+	r := &ClusterResource{}
+
+	sno := ClusterResourceModel{
+		ControlPlaneCount:     types.Int64Value(1),
+		UserManagedNetworking: types.BoolNull(),
+	}
+
+	if params := r.modelToCreateParams(sno); !params.UserManagedNetworking {
+		t.Error("Expected user_managed_networking to default to true for a single node cluster on create")
+	}
+
+	sno.UserManagedNetworking = types.BoolUnknown()
+	if params := r.modelToUpdateParams(sno); params.UserManagedNetworking == nil || !*params.UserManagedNetworking {
+		t.Errorf("Expected user_managed_networking to default to true for a single node cluster on update, got %v", params.UserManagedNetworking)
+	}
+
+	legacy := ClusterResourceModel{
+		HighAvailabilityMode:  types.StringValue("None"),
+		UserManagedNetworking: types.BoolNull(),
+	}
+	if params := r.modelToCreateParams(legacy); !params.UserManagedNetworking {
+		t.Error("Expected user_managed_networking to default to true with high_availability_mode None")
+	}
+
+	multiNode := ClusterResourceModel{
+		ControlPlaneCount:     types.Int64Value(3),
+		UserManagedNetworking: types.BoolUnknown(),
+	}
+	if params := r.modelToUpdateParams(multiNode); params.UserManagedNetworking != nil {
+		t.Errorf("Expected user_managed_networking to be left to the API for a multi-node cluster, got %v", *params.UserManagedNetworking)
+	}
+}
+
+func TestClusterResource_ValidateConfig_SingleNodeNetworking(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "single node without user_managed_networking",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		{
+			name: "single node with user-managed networking",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 1),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		{
+			name: "single node with cluster-managed networking",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 1),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, false),
+			},
+			expectError: true,
+		},
+		{
+			name: "single node via high_availability_mode with cluster-managed networking",
+			values: map[string]tftypes.Value{
+				"high_availability_mode":  tftypes.NewValue(tftypes.String, "None"),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, false),
+			},
+			expectError: true,
+		},
+		{
+			name: "multi-node with cluster-managed networking",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 3),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_UserManagedNetworkingVIPs(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	vips := func(name, ip string) tftypes.Value {
+		vipsType := clusterConfigAttributeType(t, name).(tftypes.List)
+		return tftypes.NewValue(vipsType, []tftypes.Value{
+			tftypes.NewValue(vipsType.ElementType, map[string]tftypes.Value{
+				"ip": tftypes.NewValue(tftypes.String, ip),
+			}),
+		})
+	}
+
+	tests := []struct {
+		name           string
+		values         map[string]tftypes.Value
+		expectedErrors []path.Path
+	}{
+		{
+			name: "cluster-managed networking with VIPs",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 3),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, false),
+				"api_vips":                vips("api_vips", "192.168.10.5"),
+				"ingress_vips":            vips("ingress_vips", "192.168.10.6"),
+			},
+		},
+		{
+			name: "multi-node default networking with VIPs",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+				"api_vips":            vips("api_vips", "192.168.10.5"),
+			},
+		},
+		{
+			name: "user-managed networking with API VIP",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 3),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, true),
+				"api_vips":                vips("api_vips", "192.168.10.5"),
+			},
+			expectedErrors: []path.Path{path.Root("api_vips")},
+		},
+		{
+			name: "user-managed networking with both VIPs",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 3),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, true),
+				"api_vips":                vips("api_vips", "192.168.10.5"),
+				"ingress_vips":            vips("ingress_vips", "192.168.10.6"),
+			},
+			expectedErrors: []path.Path{path.Root("api_vips"), path.Root("ingress_vips")},
+		},
+		{
+			name: "single node default networking with ingress VIP",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 1),
+				"ingress_vips":        vips("ingress_vips", "192.168.10.6"),
+			},
+			expectedErrors: []path.Path{path.Root("ingress_vips")},
+		},
+		{
+			// Only the networking mode is reported, not the VIPs as well
+			name: "single node with cluster-managed networking and VIPs",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 1),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, false),
+				"api_vips":                vips("api_vips", "192.168.10.5"),
+			},
+			expectedErrors: []path.Path{path.Root("user_managed_networking")},
+		},
+		{
+			name: "unknown user_managed_networking",
+			values: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 1),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"api_vips":                vips("api_vips", "192.168.10.5"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors, got diagnostics: %+v", len(tt.expectedErrors), resp.Diagnostics)
+			}
+			for i, expected := range tt.expectedErrors {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Errorf("Expected error on %s, got %+v", expected, errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClusterResource_ValidateConfig_HighAvailabilityModeDeprecated(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name          string
+		values        map[string]tftypes.Value
+		expectWarning bool
+	}{
+		{
+			name: "control_plane_count",
+			values: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+			},
+		},
+		{
+			name:   "neither set",
+			values: map[string]tftypes.Value{},
+		},
+		{
+			name: "high_availability_mode Full",
+			values: map[string]tftypes.Value{
+				"high_availability_mode": tftypes.NewValue(tftypes.String, "Full"),
+			},
+			expectWarning: true,
+		},
+		{
+			name: "high_availability_mode None",
+			values: map[string]tftypes.Value{
+				"high_availability_mode": tftypes.NewValue(tftypes.String, "None"),
+			},
+			expectWarning: true,
+		},
+		{
+			name: "unknown high_availability_mode",
+			values: map[string]tftypes.Value{
+				"high_availability_mode": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %+v", resp.Diagnostics)
+			}
+			warned := false
+			for _, warning := range resp.Diagnostics.Warnings() {
+				withPath, ok := warning.(interface{ Path() path.Path })
+				if ok && withPath.Path().Equal(path.Root("high_availability_mode")) {
+					warned = true
+				}
+			}
+			if warned != tt.expectWarning {
+				t.Errorf("Expected deprecation warning: %v, got diagnostics: %+v", tt.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterResource_ModifyPlan_SingleNodeUserManagedNetworking(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	tests := []struct {
+		name     string
+		config   map[string]tftypes.Value
+		plan     map[string]tftypes.Value
+		planned  tftypes.Value
+		expected types.Bool
+	}{
+		{
+			name: "single node without user_managed_networking",
+			config: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 1),
+			},
+			planned:  tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolValue(true),
+		},
+		{
+			name: "single node with unknown user_managed_networking",
+			config: map[string]tftypes.Value{
+				"control_plane_count":     tftypes.NewValue(tftypes.Number, 1),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			},
+			planned:  tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolUnknown(),
+		},
+		{
+			name: "multi-node without user_managed_networking",
+			config: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, 3),
+			},
+			planned:  tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolUnknown(),
+		},
+		{
+			name: "high_availability_mode None with unknown planned control_plane_count",
+			config: map[string]tftypes.Value{
+				"high_availability_mode": tftypes.NewValue(tftypes.String, "None"),
+			},
+			plan: map[string]tftypes.Value{
+				"control_plane_count": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			},
+			planned:  tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolValue(true),
+		},
+		{
+			name:   "no topology with unknown planned values",
+			config: map[string]tftypes.Value{},
+			plan: map[string]tftypes.Value{
+				"control_plane_count":    tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"high_availability_mode": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			planned:  tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			expected: types.BoolUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := newClusterConfig(t, tt.config)
+
+			planValues := map[string]tftypes.Value{"user_managed_networking": tt.planned}
+			for name, value := range tt.config {
+				planValues[name] = value
+			}
+			for name, value := range tt.plan {
+				planValues[name] = value
+			}
+			planned := newClusterConfig(t, planValues)
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var data ClusterResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+			if !data.UserManagedNetworking.Equal(tt.expected) {
+				t.Errorf("Expected planned user_managed_networking %s, got %s", tt.expected, data.UserManagedNetworking)
+			}
+		})
+	}
+}
+
+// Create resolves the topology from the configuration when the computed
+// control_plane_count and high_availability_mode are unknown in the plan
+func TestClusterResource_Create_UnknownPlannedTopology(t *testing.T) {
+	unknownTopology := map[string]tftypes.Value{
+		"control_plane_count":    tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"high_availability_mode": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+
+	tests := []struct {
+		name                  string
+		config                map[string]tftypes.Value
+		userManagedNetworking bool
+		schedulableMasters    types.Bool
+	}{
+		{
+			name: "single node through high_availability_mode",
+			config: map[string]tftypes.Value{
+				"high_availability_mode": tftypes.NewValue(tftypes.String, "None"),
+			},
+			userManagedNetworking: true,
+			schedulableMasters:    types.BoolNull(),
+		},
+		{
+			name: "compact without control_plane_count",
+			config: map[string]tftypes.Value{
+				"worker_count": tftypes.NewValue(tftypes.Number, 0),
+			},
+			schedulableMasters: types.BoolValue(true),
+		},
+		{
+			name:               "multi-node",
+			config:             map[string]tftypes.Value{},
+			schedulableMasters: types.BoolNull(),
+		},
+		{
+			name: "multi-node with workers",
+			config: map[string]tftypes.Value{
+				"worker_count": tftypes.NewValue(tftypes.Number, 2),
+			},
+			schedulableMasters: types.BoolNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var params models.ClusterCreateParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+					t.Errorf("Failed to decode create params: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(models.Cluster{
+					ID:                "test-cluster-id",
+					Name:              params.Name,
+					OpenshiftVersion:  params.OpenshiftVersion,
+					BaseDNSDomain:     "example.com",
+					ControlPlaneCount: 3,
+					Status:            "insufficient",
+				})
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			configValues := map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+				"pull_secret":       tftypes.NewValue(tftypes.String, "pull-secret"),
+			}
+			for name, value := range tt.config {
+				configValues[name] = value
+			}
+			config := newClusterConfig(t, configValues)
+
+			planValues := map[string]tftypes.Value{}
+			for name, value := range unknownTopology {
+				planValues[name] = value
+			}
+			for name, value := range configValues {
+				planValues[name] = value
+			}
+			planned := newClusterConfig(t, planValues)
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Config: config, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if params.UserManagedNetworking != tt.userManagedNetworking {
+				t.Errorf("Expected user_managed_networking %v, got %v", tt.userManagedNetworking, params.UserManagedNetworking)
+			}
+			schedulableMasters := types.BoolPointerValue(params.SchedulableMasters)
+			if !schedulableMasters.Equal(tt.schedulableMasters) {
+				t.Errorf("Expected schedulable_masters %s, got %s", tt.schedulableMasters, schedulableMasters)
+			}
+		})
+	}
+}
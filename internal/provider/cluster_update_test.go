@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+func TestClusterResource_modelToUpdateParams_PatchBody(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:                  StringValue("test-cluster"),
+		NetworkType:           StringValue("OVNKubernetes"),
+		Hyperthreading:        StringValue("Disabled"),
+		Tags:                  StringValue("env:prod,team:platform"),
+		UserManagedNetworking: types.BoolValue(false),
+		APIVips:               createVipList([]string{"192.168.1.100"}),
+		IngressVips:           createVipList([]string{"192.168.1.101"}),
+	}
+
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("Failed to decode PATCH body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cluster-id"}`))
+	}))
+	defer server.Close()
+
+	testClient := client.NewClient(client.ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	if _, err := testClient.UpdateCluster(context.Background(), "cluster-id", resource.modelToUpdateParams(model)); err != nil {
+		t.Fatalf("UpdateCluster failed: %v", err)
+	}
+
+	if body["network_type"] != "OVNKubernetes" {
+		t.Errorf("Expected network_type in PATCH body, got %v", body["network_type"])
+	}
+	if body["hyperthreading"] != "Disabled" {
+		t.Errorf("Expected hyperthreading in PATCH body, got %v", body["hyperthreading"])
+	}
+	if body["tags"] != "env:prod,team:platform" {
+		t.Errorf("Expected tags in PATCH body, got %v", body["tags"])
+	}
+
+	apiVips, ok := body["api_vips"].([]interface{})
+	if !ok || len(apiVips) != 1 || apiVips[0].(map[string]interface{})["ip"] != "192.168.1.100" {
+		t.Errorf("Expected api_vips in PATCH body, got %v", body["api_vips"])
+	}
+	ingressVips, ok := body["ingress_vips"].([]interface{})
+	if !ok || len(ingressVips) != 1 || ingressVips[0].(map[string]interface{})["ip"] != "192.168.1.101" {
+		t.Errorf("Expected ingress_vips in PATCH body, got %v", body["ingress_vips"])
+	}
+}
+
+func TestClusterResource_modelToUpdateParams_UserManagedNetworkingSkipsVips(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:                  StringValue("test-cluster"),
+		UserManagedNetworking: types.BoolValue(true),
+		APIVips:               createVipList([]string{"192.168.1.100"}),
+		IngressVips:           createVipList([]string{"192.168.1.101"}),
+	}
+
+	result := resource.modelToUpdateParams(model)
+
+	if result.APIVips != nil {
+		t.Errorf("Expected api_vips to be omitted with user-managed networking, got %+v", result.APIVips)
+	}
+	if result.IngressVips != nil {
+		t.Errorf("Expected ingress_vips to be omitted with user-managed networking, got %+v", result.IngressVips)
+	}
+}
+
+func TestClusterResource_modelToUpdateParams_UnknownComputedFields(t *testing.T) {
+	resource := &ClusterResource{}
+
+	model := ClusterResourceModel{
+		Name:           StringValue("test-cluster"),
+		NetworkType:    types.StringUnknown(),
+		Hyperthreading: types.StringUnknown(),
+		Tags:           types.StringUnknown(),
+	}
+
+	result := resource.modelToUpdateParams(model)
+
+	if result.NetworkType != nil {
+		t.Errorf("Expected unknown network_type to be omitted, got %q", *result.NetworkType)
+	}
+	if result.Hyperthreading != nil {
+		t.Errorf("Expected unknown hyperthreading to be omitted, got %q", *result.Hyperthreading)
+	}
+	if result.Tags != nil {
+		t.Errorf("Expected unknown tags to be omitted, got %q", *result.Tags)
+	}
+}
+
+func TestClusterResource_modelToUpdateParams_ClearsOLMOperators(t *testing.T) {
+	resource := &ClusterResource{}
+
+	emptyOperators, _ := types.ListValueFrom(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"properties": types.StringType,
+		},
+	}, []OLMOperatorModel{})
+
+	model := ClusterResourceModel{
+		Name:            StringValue("test-cluster"),
+		OLMOperators:    emptyOperators,
+		BundleOperators: types.ListNull(types.StringType),
+	}
+
+	var body map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("Failed to decode PATCH body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cluster-id"}`))
+	}))
+	defer server.Close()
+
+	testClient := client.NewClient(client.ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	if _, err := testClient.UpdateCluster(context.Background(), "cluster-id", resource.modelToUpdateParams(model)); err != nil {
+		t.Fatalf("UpdateCluster failed: %v", err)
+	}
+
+	if string(body["olm_operators"]) != "[]" {
+		t.Errorf("Expected olm_operators to be sent as [], got %s", body["olm_operators"])
+	}
+}
+
+// Update sends the full desired operator list, so operators added or removed
+// after creation are reconciled by the service
+func TestClusterResource_modelToUpdateParams_UpdatesOLMOperators(t *testing.T) {
+	resource := &ClusterResource{}
+
+	operators, _ := types.ListValueFrom(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"properties": types.StringType,
+		},
+	}, []OLMOperatorModel{
+		{Name: StringValue("lso"), Properties: types.StringNull()},
+		{Name: StringValue("odf"), Properties: StringValue(`{"version":"4.16"}`)},
+	})
+
+	model := ClusterResourceModel{
+		Name:            StringValue("test-cluster"),
+		OLMOperators:    operators,
+		BundleOperators: types.ListNull(types.StringType),
+	}
+
+	var body struct {
+		OLMOperators []models.OLMOperator `json:"olm_operators"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("Failed to decode PATCH body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cluster-id"}`))
+	}))
+	defer server.Close()
+
+	testClient := client.NewClient(client.ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	if _, err := testClient.UpdateCluster(context.Background(), "cluster-id", resource.modelToUpdateParams(model)); err != nil {
+		t.Fatalf("UpdateCluster failed: %v", err)
+	}
+
+	expected := []models.OLMOperator{
+		{Name: "lso"},
+		{Name: "odf", Properties: `{"version":"4.16"}`},
+	}
+	if !reflect.DeepEqual(body.OLMOperators, expected) {
+		t.Errorf("Expected olm_operators %+v in PATCH body, got %+v", expected, body.OLMOperators)
+	}
+}
+
+func TestOLMOperatorsParam_KeepsBundleOperators(t *testing.T) {
+	operators, _ := types.ListValueFrom(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"properties": types.StringType,
+		},
+	}, []OLMOperatorModel{{Name: StringValue("lvm"), Properties: types.StringNull()}})
+	bundleOperators, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"cnv", "lvm"})
+
+	result := olmOperatorsParam(ClusterResourceModel{
+		OLMOperators:    operators,
+		BundleOperators: bundleOperators,
+	})
+
+	if len(*result) != 2 || (*result)[0].Name != "lvm" || (*result)[1].Name != "cnv" {
+		t.Errorf("Expected operators [lvm cnv], got %+v", *result)
+	}
+}
+
+// Update only clears operators for a null olm_operators when the state had some
+func TestClusterResource_modelToUpdateParams_NullOLMOperators(t *testing.T) {
+	resource := &ClusterResource{}
+
+	result := resource.modelToUpdateParams(ClusterResourceModel{
+		Name:         StringValue("test-cluster"),
+		OLMOperators: types.ListNull(types.ObjectType{}),
+	})
+
+	if result.OLMOperators != nil {
+		t.Errorf("Expected olm_operators to be omitted, got %+v", *result.OLMOperators)
+	}
+}
+
+// Helper function to create a VIP list for testing
+func createVipList(ips []string) types.List {
+	vips := make([]APIVipModel, len(ips))
+	for i, ip := range ips {
+		vips[i] = APIVipModel{IP: types.StringValue(ip)}
+	}
+	listValue, _ := types.ListValueFrom(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"ip": types.StringType,
+		},
+	}, vips)
+	return listValue
+}
+
+func TestClusterResource_Update_UnknownUserManagedNetworking(t *testing.T) {
+	tests := []struct {
+		name         string
+		userManaged  bool
+		expectedVips bool
+	}{
+		{name: "user-managed cluster", userManaged: true, expectedVips: false},
+		{name: "cluster-managed cluster", userManaged: false, expectedVips: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("Failed to decode PATCH body: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", Name: "test-cluster", UserManagedNetworking: tt.userManaged})
+			}))
+			defer server.Close()
+
+			r := &ClusterResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                      tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"name":                    tftypes.NewValue(tftypes.String, "test-cluster"),
+				"openshift_version":       tftypes.NewValue(tftypes.String, "4.16"),
+				"pull_secret":             tftypes.NewValue(tftypes.String, "pull-secret"),
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, tt.userManaged),
+			})
+
+			// user_managed_networking isn't configured, so it is planned as
+			// unknown alongside the VIPs
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			diags := plan.SetAttribute(ctx, path.Root("user_managed_networking"), types.BoolUnknown())
+			diags.Append(plan.SetAttribute(ctx, path.Root("api_vips"), createVipList([]string{"192.168.1.100"}))...)
+			if diags.HasError() {
+				t.Fatalf("Failed to build plan: %+v", diags)
+			}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if _, ok := body["api_vips"]; ok != tt.expectedVips {
+				t.Errorf("Expected api_vips sent = %v, got %v", tt.expectedVips, body["api_vips"])
+			}
+		})
+	}
+}
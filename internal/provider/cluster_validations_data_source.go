@@ -3,10 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
-	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -169,130 +167,27 @@ func (d *ClusterValidationsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	// Convert validation types filter to strings for comparison
-	var validationTypesFilter []string
-	if len(data.ValidationTypes) > 0 {
-		for _, vt := range data.ValidationTypes {
-			if !vt.IsNull() && !vt.IsUnknown() {
-				validationTypesFilter = append(validationTypesFilter, vt.ValueString())
-			}
-		}
-	}
-
-	// Convert status filter to strings for comparison
-	var statusFilter []string
-	if len(data.StatusFilter) > 0 {
-		for _, status := range data.StatusFilter {
-			if !status.IsNull() && !status.IsUnknown() {
-				statusFilter = append(statusFilter, status.ValueString())
-			}
-		}
-	}
-
-	// Convert validation names filter to strings for comparison
-	var validationNamesFilter []string
-	if len(data.ValidationNames) > 0 {
-		for _, name := range data.ValidationNames {
-			if !name.IsNull() && !name.IsUnknown() {
-				validationNamesFilter = append(validationNamesFilter, name.ValueString())
-			}
-		}
-	}
-
-	// Convert categories filter to strings for comparison
-	var categoriesFilter []string
-	if len(data.Categories) > 0 {
-		for _, category := range data.Categories {
-			if !category.IsNull() && !category.IsUnknown() {
-				categoriesFilter = append(categoriesFilter, category.ValueString())
-			}
-		}
-	}
+	filters := newValidationFilters(data.ValidationTypes, data.StatusFilter, data.ValidationNames, data.Categories)
 
 	// Process validations and apply filters
 	var filteredValidations []ClusterValidationModel
-	for groupName, validationsGroup := range clusterValidations.ValidationsInfo {
-		for _, validation := range validationsGroup {
-			// Determine validation type (blocking/non-blocking)
-			validationType := "non-blocking"
-			validationID := validation.ValidationID
-			if validationID == "" {
-				validationID = validation.ID
-			}
-			if models.IsBlockingValidation(validationID) {
-				validationType = "blocking"
-			}
-
-			// Apply validation type filter
-			if len(validationTypesFilter) > 0 {
-				found := false
-				for _, filterType := range validationTypesFilter {
-					if strings.EqualFold(validationType, filterType) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					continue
-				}
-			}
-
-			// Apply status filter
-			if len(statusFilter) > 0 {
-				found := false
-				for _, filterStatus := range statusFilter {
-					if strings.EqualFold(validation.Status, filterStatus) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					continue
-				}
+	for _, groupName := range sortedValidationGroups(clusterValidations.ValidationsInfo) {
+		for _, validation := range clusterValidations.ValidationsInfo[groupName] {
+			result := classifyValidation(validation)
+			if !filters.matches(validation, result) {
+				continue
 			}
 
-			// Apply validation names filter
-			if len(validationNamesFilter) > 0 {
-				found := false
-				for _, filterName := range validationNamesFilter {
-					if validationID == filterName || validation.ID == filterName {
-						found = true
-						break
-					}
-				}
-				if !found {
-					continue
-				}
-			}
-
-			// Apply categories filter
-			if len(categoriesFilter) > 0 {
-				category := string(models.GetValidationCategory(validationID))
-				found := false
-				for _, filterCategory := range categoriesFilter {
-					if strings.EqualFold(category, filterCategory) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					continue
-				}
-			}
-
-			// Build validation model
-			validationModel := ClusterValidationModel{
+			filteredValidations = append(filteredValidations, ClusterValidationModel{
 				ID:              types.StringValue(validation.ID),
 				Status:          types.StringValue(validation.Status),
 				Message:         types.StringValue(validation.Message),
-				ValidationID:    types.StringValue(validationID),
+				ValidationID:    types.StringValue(result.ValidationID),
 				ValidationName:  types.StringValue(validation.ValidationName),
 				ValidationGroup: types.StringValue(groupName),
-				ValidationType:  types.StringValue(validationType),
-				Category:        types.StringValue(string(models.GetValidationCategory(validationID))),
-			}
-
-			filteredValidations = append(filteredValidations, validationModel)
+				ValidationType:  types.StringValue(result.ValidationType),
+				Category:        types.StringValue(result.Category),
+			})
 		}
 	}
 
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 )
@@ -359,6 +361,103 @@ func getCategoryForValidation(validationID string) string {
 	return "cluster" // Default category
 }
 
+func TestClusterValidationsDataSource_Read_Filters(t *testing.T) {
+	const response = `{"validations_info": {
+		"network": [
+			{"id": "no-cidrs-overlapping", "status": "failure", "message": "Network CIDRs are overlapping"},
+			{"id": "api-vips-defined", "status": "success", "message": "API virtual IPs are defined"}
+		],
+		"hosts-data": [
+			{"id": "all-hosts-are-ready-to-install", "status": "failure", "message": "Not all hosts are ready"}
+		],
+		"configuration": [
+			{"id": "pull-secret-set", "status": "success", "message": "The pull secret is set"}
+		]
+	}}`
+
+	stringList := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	tests := []struct {
+		name     string
+		filters  map[string]tftypes.Value
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"pull-secret-set", "all-hosts-are-ready-to-install", "no-cidrs-overlapping", "api-vips-defined"},
+		},
+		{
+			name:     "status filter ignores case",
+			filters:  map[string]tftypes.Value{"status_filter": stringList("FAILURE")},
+			expected: []string{"all-hosts-are-ready-to-install", "no-cidrs-overlapping"},
+		},
+		{
+			name: "blocking network validations",
+			filters: map[string]tftypes.Value{
+				"validation_types": stringList("blocking"),
+				"categories":       stringList("network"),
+			},
+			expected: []string{"no-cidrs-overlapping"},
+		},
+		{
+			name:     "validation names",
+			filters:  map[string]tftypes.Value{"validation_names": stringList("api-vips-defined", "pull-secret-set")},
+			expected: []string{"pull-secret-set", "api-vips-defined"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/clusters/test-cluster-id" {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			ds := &ClusterValidationsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			values := map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, "test-cluster-id"),
+			}
+			for name, value := range tt.filters {
+				values[name] = value
+			}
+			req, resp := newDataSourceReadRequest(t, ds, values)
+			ds.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state ClusterValidationsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+
+			var got []string
+			for _, validation := range state.Validations {
+				got = append(got, validation.ValidationID.ValueString())
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected validations %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestClusterValidationsDataSource_Schema(t *testing.T) {
 	dataSource := NewClusterValidationsDataSource()
 
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// machineNetworksFromConfig parses the machine_networks CIDRs. It returns false
+// when a CIDR is not known yet or is invalid, as VIPs cannot be checked then.
+func machineNetworksFromConfig(machineNetworks types.List) ([]*net.IPNet, bool) {
+	if machineNetworks.IsNull() || machineNetworks.IsUnknown() {
+		return nil, false
+	}
+
+	var networks []MachineNetworkModel
+	if diags := machineNetworks.ElementsAs(context.Background(), &networks, false); diags.HasError() {
+		return nil, false
+	}
+
+	result := make([]*net.IPNet, 0, len(networks))
+	for _, network := range networks {
+		if network.CIDR.IsNull() || network.CIDR.IsUnknown() {
+			return nil, false
+		}
+		_, ipNet, err := net.ParseCIDR(network.CIDR.ValueString())
+		if err != nil {
+			return nil, false
+		}
+		result = append(result, ipNet)
+	}
+	return result, len(result) > 0
+}
+
+// vipIPs returns the ip attributes of an api_vips or ingress_vips list, whose
+// elements share the same shape
+func vipIPs(vips types.List) []types.String {
+	if vips.IsNull() || vips.IsUnknown() {
+		return nil
+	}
+
+	var entries []APIVipModel
+	if diags := vips.ElementsAs(context.Background(), &entries, false); diags.HasError() {
+		return nil
+	}
+
+	ips := make([]types.String, len(entries))
+	for i, entry := range entries {
+		ips[i] = entry.IP
+	}
+	return ips
+}
+
+// validateVIPsInMachineNetworks requires every API and ingress VIP to lie in
+// one of the machine networks when both are configured. With cluster-managed
+// networking the VIPs are served from the machine network, so the Assisted
+// Service would otherwise only reject them once the hosts are discovered.
+func (r *ClusterResource) validateVIPsInMachineNetworks(data ClusterResourceModel, resp *resource.ValidateConfigResponse) {
+	if data.UserManagedNetworking.IsUnknown() || data.UserManagedNetworking.ValueBool() {
+		return
+	}
+
+	networks, ok := machineNetworksFromConfig(data.MachineNetworks)
+	if !ok {
+		return
+	}
+
+	cidrs := make([]string, len(networks))
+	for i, network := range networks {
+		cidrs[i] = network.String()
+	}
+
+	for _, attr := range []struct {
+		name string
+		vips types.List
+	}{
+		{"api_vips", data.APIVips},
+		{"ingress_vips", data.IngressVips},
+	} {
+		name := attr.name
+		for i, vip := range vipIPs(attr.vips) {
+			if vip.IsNull() || vip.IsUnknown() {
+				continue
+			}
+
+			attrPath := path.Root(name).AtListIndex(i).AtName("ip")
+			ip := net.ParseIP(vip.ValueString())
+			if ip == nil {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"Invalid VIP Address",
+					fmt.Sprintf("%q is not a valid IP address.", vip.ValueString()),
+				)
+				continue
+			}
+
+			if !ipInNetworks(ip, networks) {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"VIP Outside Machine Networks",
+					fmt.Sprintf("The VIP %s in %q is not in any of the machine networks (%s). With cluster-managed networking the VIPs must belong to a machine network. Change the VIP or add its network to \"machine_networks\".",
+						vip.ValueString(), name, strings.Join(cidrs, ", ")),
+				)
+			}
+		}
+	}
+}
+
+func ipInNetworks(ip net.IP, networks []*net.IPNet) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
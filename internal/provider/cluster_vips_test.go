@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestClusterResource_ValidateConfig_VIPsInMachineNetworks(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+
+	machineNetworksType := clusterConfigAttributeType(t, "machine_networks").(tftypes.List)
+	machineNetworks := func(cidrs ...tftypes.Value) tftypes.Value {
+		elems := make([]tftypes.Value, len(cidrs))
+		for i, cidr := range cidrs {
+			elems[i] = tftypes.NewValue(machineNetworksType.ElementType, map[string]tftypes.Value{
+				"cidr": cidr,
+			})
+		}
+		return tftypes.NewValue(machineNetworksType, elems)
+	}
+	vips := func(name string, ips ...tftypes.Value) tftypes.Value {
+		vipsType := clusterConfigAttributeType(t, name).(tftypes.List)
+		elems := make([]tftypes.Value, len(ips))
+		for i, ip := range ips {
+			elems[i] = tftypes.NewValue(vipsType.ElementType, map[string]tftypes.Value{
+				"ip": ip,
+			})
+		}
+		return tftypes.NewValue(vipsType, elems)
+	}
+	str := func(value string) tftypes.Value {
+		return tftypes.NewValue(tftypes.String, value)
+	}
+
+	tests := []struct {
+		name           string
+		values         map[string]tftypes.Value
+		expectedErrors []path.Path
+	}{
+		{
+			name: "VIPs in machine network",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24")),
+				"api_vips":         vips("api_vips", str("192.168.10.5")),
+				"ingress_vips":     vips("ingress_vips", str("192.168.10.6")),
+			},
+		},
+		{
+			name: "dual-stack VIPs in machine networks",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24"), str("fd2e:6f44:5dd8::/64")),
+				"api_vips":         vips("api_vips", str("192.168.10.5"), str("fd2e:6f44:5dd8::5")),
+				"ingress_vips":     vips("ingress_vips", str("192.168.10.6"), str("fd2e:6f44:5dd8::6")),
+			},
+		},
+		{
+			name: "API VIP outside machine network",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24")),
+				"api_vips":         vips("api_vips", str("192.168.11.5")),
+				"ingress_vips":     vips("ingress_vips", str("192.168.10.6")),
+			},
+			expectedErrors: []path.Path{path.Root("api_vips").AtListIndex(0).AtName("ip")},
+		},
+		{
+			name: "ingress VIPs outside machine network",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24")),
+				"api_vips":         vips("api_vips", str("192.168.10.5")),
+				"ingress_vips":     vips("ingress_vips", str("192.168.10.6"), str("10.0.0.6")),
+			},
+			expectedErrors: []path.Path{path.Root("ingress_vips").AtListIndex(1).AtName("ip")},
+		},
+		{
+			name: "invalid VIP",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24")),
+				"api_vips":         vips("api_vips", str("not-an-ip")),
+			},
+			expectedErrors: []path.Path{path.Root("api_vips").AtListIndex(0).AtName("ip")},
+		},
+		{
+			name: "VIPs without machine networks",
+			values: map[string]tftypes.Value{
+				"api_vips": vips("api_vips", str("192.168.11.5")),
+			},
+		},
+		{
+			name: "unknown machine network",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+				"api_vips":         vips("api_vips", str("192.168.11.5")),
+			},
+		},
+		{
+			name: "unknown VIP",
+			values: map[string]tftypes.Value{
+				"machine_networks": machineNetworks(str("192.168.10.0/24")),
+				"api_vips":         vips("api_vips", tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			},
+		},
+		{
+			// VIPs are rejected outright, not checked against the machine networks
+			name: "user-managed networking",
+			values: map[string]tftypes.Value{
+				"user_managed_networking": tftypes.NewValue(tftypes.Bool, true),
+				"machine_networks":        machineNetworks(str("192.168.10.0/24")),
+				"api_vips":                vips("api_vips", str("192.168.11.5")),
+			},
+			expectedErrors: []path.Path{path.Root("api_vips")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["openshift_version"] = tftypes.NewValue(tftypes.String, "4.15.20")
+			req := resource.ValidateConfigRequest{
+				Config: newClusterConfig(t, tt.values),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors, got diagnostics: %+v", len(tt.expectedErrors), resp.Diagnostics)
+			}
+			for i, expected := range tt.expectedErrors {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(expected) {
+					t.Errorf("Expected error on %s, got %+v", expected, errs[i])
+				}
+			}
+		})
+	}
+}
@@ -23,7 +23,8 @@ type HostDataSource struct {
 	client *client.Client
 }
 
-// All inventory-related fields are JSON strings per Swagger spec, not parsed objects
+// Inventory-related fields are JSON strings per Swagger spec; only the
+// hardware inventory is also exposed parsed, as parsed_inventory
 
 // HostDataSourceModel describes the data source data model.
 // All fields match exactly with Swagger host definition
@@ -52,10 +53,12 @@ type HostDataSourceModel struct {
 	TangConnectivity   types.String `tfsdk:"tang_connectivity"`
 
 	// Hardware inventory (JSON string per Swagger)
-	Inventory     types.String `tfsdk:"inventory"`
-	FreeAddresses types.String `tfsdk:"free_addresses"`
-	NTPSources    types.String `tfsdk:"ntp_sources"`
-	DisksInfo     types.String `tfsdk:"disks_info"`
+	Inventory       types.String `tfsdk:"inventory"`
+	ParsedInventory types.Object `tfsdk:"parsed_inventory"`
+	IPAddresses     types.List   `tfsdk:"ip_addresses"`
+	FreeAddresses   types.String `tfsdk:"free_addresses"`
+	NTPSources      types.String `tfsdk:"ntp_sources"`
+	DisksInfo       types.String `tfsdk:"disks_info"`
 
 	// Host role and configuration
 	Role          types.String `tfsdk:"role"`
@@ -206,11 +209,13 @@ func (d *HostDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Computed:            true,
 			},
 
-			// Hardware inventory (JSON string per Swagger - NOT parsed objects)
+			// Hardware inventory (JSON string per Swagger, and parsed)
 			"inventory": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing hardware inventory information collected from the host",
 				Computed:            true,
 			},
+			"parsed_inventory": hostInventorySchema(),
+			"ip_addresses":     hostIPAddressesSchema(),
 			"free_addresses": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing list of free IP addresses available on this host",
 				Computed:            true,
@@ -408,7 +413,10 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.Kind = types.StringValue(host.Kind)
 	data.Href = types.StringValue(host.Href)
 	data.Role = types.StringValue(host.Role)
+	data.SuggestedRole = types.StringValue(host.SuggestedRole)
 	data.MachineConfigPoolName = types.StringValue(host.MachineConfigPoolName)
+	data.InstallationDiskID = types.StringValue(host.InstallationDiskID)
+	data.InstallationDiskPath = types.StringValue(host.InstallationDiskPath)
 
 	// Handle timestamps
 	if !host.CreatedAt.IsZero() {
@@ -449,7 +457,7 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			map[string]attr.Value{
 				"current_stage":           currentStage,
 				"progress_info":           progressInfo,
-				"installation_percentage": types.Int64Value(0), // Not available in basic Progress model
+				"installation_percentage": types.Int64Value(host.Progress.InstallationPercentage),
 				"stage_started_at":        stageStartedAt,
 				"stage_updated_at":        stageUpdatedAt,
 			},
@@ -460,8 +468,21 @@ func (d *HostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Note: FreeAddresses are not available in the basic Host model
 
-	// Note: Inventory is not available in the basic Host model
-	// This would need to be fetched from other API endpoints if needed
+	// Keep the raw inventory for advanced use next to the parsed one
+	data.Inventory = stringValueOrNull(host.Inventory)
+	data.DomainNameResolutions = stringValueOrNull(host.DomainNameResolutions)
+	inventory, err := host.ParseInventory()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Invalid Host Inventory",
+			fmt.Sprintf("Unable to parse the inventory of host %s, \"parsed_inventory\" is left empty: %s", host.ID, err),
+		)
+	}
+	parsed, diags := hostInventoryValue(ctx, inventory)
+	resp.Diagnostics.Append(diags...)
+	data.ParsedInventory = parsed
+	data.IPAddresses, diags = hostIPAddressesValue(ctx, inventory)
+	resp.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,3 +77,203 @@ func TestHostDataSource_Configure(t *testing.T) {
 	assert.False(t, configResp.Diagnostics.HasError())
 	assert.Equal(t, testClient, ds.client)
 }
+
+func TestHostDataSource_Read_Inventory(t *testing.T) {
+	inventory := `{"hostname":"worker-0","cpu":{"architecture":"x86_64","count":8},"memory":{"physical_bytes":34359738368,"usable_bytes":33554432000},` +
+		`"disks":[{"id":"/dev/disk/by-path/pci-0000:00:05.0","by_path":"/dev/disk/by-path/pci-0000:00:05.0","drive_type":"HDD","name":"vda","path":"/dev/vda","size_bytes":128849018880,"bootable":true}],` +
+		`"interfaces":[{"name":"enp1s0","mac_address":"52:54:00:12:34:56","ipv4_addresses":["10.0.0.21/24"],"ipv6_addresses":[],"mtu":1500}]}`
+
+	tests := []struct {
+		name          string
+		inventory     string
+		expectParsed  bool
+		expectWarning bool
+	}{
+		{
+			name:         "reported inventory",
+			inventory:    inventory,
+			expectParsed: true,
+		},
+		{
+			name: "no inventory yet",
+		},
+		{
+			name:          "invalid inventory",
+			inventory:     "{not json",
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:         "test-host-id",
+					InfraEnvID: "test-infra-env-id",
+					Status:     "known",
+					Inventory:  tt.inventory,
+				})
+			}))
+			defer server.Close()
+
+			ds := &HostDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+			})
+			ds.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.expectWarning {
+				t.Errorf("Expected warning: %v, got diagnostics: %+v", tt.expectWarning, resp.Diagnostics)
+			}
+
+			var data HostDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Inventory.ValueString() != tt.inventory {
+				t.Errorf("Expected the raw inventory to be kept, got %s", data.Inventory)
+			}
+
+			if !tt.expectParsed {
+				if !data.ParsedInventory.IsNull() {
+					t.Errorf("Expected parsed_inventory to be null, got %s", data.ParsedInventory)
+				}
+				return
+			}
+
+			var parsed HostInventoryModel
+			resp.Diagnostics.Append(data.ParsedInventory.As(ctx, &parsed, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read parsed_inventory: %+v", resp.Diagnostics)
+			}
+
+			assert.Equal(t, "worker-0", parsed.Hostname.ValueString())
+			assert.Equal(t, int64(8), parsed.CPUCores.ValueInt64())
+			assert.Equal(t, int64(34359738368), parsed.MemoryBytes.ValueInt64())
+			if assert.Len(t, parsed.Disks, 1) {
+				assert.Equal(t, "/dev/vda", parsed.Disks[0].Path.ValueString())
+				assert.Equal(t, int64(128849018880), parsed.Disks[0].SizeBytes.ValueInt64())
+				assert.True(t, parsed.Disks[0].Bootable.ValueBool())
+			}
+			if assert.Len(t, parsed.Interfaces, 1) {
+				assert.Equal(t, "52:54:00:12:34:56", parsed.Interfaces[0].MACAddress.ValueString())
+				assert.Equal(t, []string{"10.0.0.21/24"}, parsed.Interfaces[0].IPv4Addresses)
+				assert.Empty(t, parsed.Interfaces[0].IPv6Addresses)
+			}
+		})
+	}
+}
+
+func TestHostDataSource_Read_IPAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	inventory := `{"hostname":"master-0","interfaces":[` +
+		`{"name":"enp1s0","mac_address":"52:54:00:12:34:56","ipv4_addresses":["10.0.0.21/24"],"ipv6_addresses":["fd00::21/64"]},` +
+		`{"name":"enp2s0","mac_address":"52:54:00:12:34:57","ipv4_addresses":["192.168.100.21/24","192.168.100.121/24"]},` +
+		`{"name":"enp3s0","mac_address":"52:54:00:12:34:58"}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Host{
+			ID:         "test-host-id",
+			InfraEnvID: "test-infra-env-id",
+			Status:     "known",
+			Inventory:  inventory,
+		})
+	}))
+	defer server.Close()
+
+	ds := &HostDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "test-host-id"),
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+	})
+	ds.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var data HostDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	var addresses []HostIPAddressModel
+	resp.Diagnostics.Append(data.IPAddresses.ElementsAs(ctx, &addresses, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read ip_addresses: %+v", resp.Diagnostics)
+	}
+
+	expected := []struct{ iface, family, address, cidr string }{
+		{"enp1s0", "ipv4", "10.0.0.21", "10.0.0.21/24"},
+		{"enp1s0", "ipv6", "fd00::21", "fd00::21/64"},
+		{"enp2s0", "ipv4", "192.168.100.21", "192.168.100.21/24"},
+		{"enp2s0", "ipv4", "192.168.100.121", "192.168.100.121/24"},
+	}
+	if assert.Len(t, addresses, len(expected)) {
+		for i, want := range expected {
+			assert.Equal(t, want.iface, addresses[i].Interface.ValueString())
+			assert.Equal(t, want.family, addresses[i].Family.ValueString())
+			assert.Equal(t, want.address, addresses[i].Address.ValueString())
+			assert.Equal(t, want.cidr, addresses[i].CIDR.ValueString())
+		}
+	}
+}
+
+func TestHostDataSource_Read_Progress(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Host{
+			ID:         "test-host-id",
+			InfraEnvID: "test-infra-env-id",
+			Status:     "installing-in-progress",
+			Progress: &models.Progress{
+				CurrentStage:           "Writing image to disk",
+				ProgressInfo:           "58%",
+				InstallationPercentage: 42,
+			},
+		})
+	}))
+	defer server.Close()
+
+	ds := &HostDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "test-host-id"),
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+	})
+	ds.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var data HostDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+
+	progress := data.Progress.Attributes()
+	assert.Equal(t, basetypes.NewStringValue("Writing image to disk"), progress["current_stage"])
+	assert.Equal(t, basetypes.NewInt64Value(42), progress["installation_percentage"])
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"net"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hostDiskAttrTypes are the attribute types of a disk in the parsed inventory
+var hostDiskAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"name":       types.StringType,
+	"path":       types.StringType,
+	"by_path":    types.StringType,
+	"drive_type": types.StringType,
+	"size_bytes": types.Int64Type,
+	"bootable":   types.BoolType,
+}
+
+// hostInterfaceAttrTypes are the attribute types of a network interface in
+// the parsed inventory
+var hostInterfaceAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"mac_address":    types.StringType,
+	"ipv4_addresses": types.ListType{ElemType: types.StringType},
+	"ipv6_addresses": types.ListType{ElemType: types.StringType},
+}
+
+// hostInventoryAttrTypes are the attribute types of the parsed inventory
+var hostInventoryAttrTypes = map[string]attr.Type{
+	"hostname":     types.StringType,
+	"cpu_cores":    types.Int64Type,
+	"memory_bytes": types.Int64Type,
+	"disks":        types.ListType{ElemType: types.ObjectType{AttrTypes: hostDiskAttrTypes}},
+	"interfaces":   types.ListType{ElemType: types.ObjectType{AttrTypes: hostInterfaceAttrTypes}},
+}
+
+// hostIPAddressAttrTypes are the attribute types of an address in ip_addresses
+var hostIPAddressAttrTypes = map[string]attr.Type{
+	"interface": types.StringType,
+	"family":    types.StringType,
+	"address":   types.StringType,
+	"cidr":      types.StringType,
+}
+
+type HostInventoryModel struct {
+	Hostname    types.String         `tfsdk:"hostname"`
+	CPUCores    types.Int64          `tfsdk:"cpu_cores"`
+	MemoryBytes types.Int64          `tfsdk:"memory_bytes"`
+	Disks       []HostDiskModel      `tfsdk:"disks"`
+	Interfaces  []HostInterfaceModel `tfsdk:"interfaces"`
+}
+
+type HostDiskModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Path      types.String `tfsdk:"path"`
+	ByPath    types.String `tfsdk:"by_path"`
+	DriveType types.String `tfsdk:"drive_type"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+	Bootable  types.Bool   `tfsdk:"bootable"`
+}
+
+type HostIPAddressModel struct {
+	Interface types.String `tfsdk:"interface"`
+	Family    types.String `tfsdk:"family"`
+	Address   types.String `tfsdk:"address"`
+	CIDR      types.String `tfsdk:"cidr"`
+}
+
+type HostInterfaceModel struct {
+	Name          types.String `tfsdk:"name"`
+	MACAddress    types.String `tfsdk:"mac_address"`
+	IPv4Addresses []string     `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []string     `tfsdk:"ipv6_addresses"`
+}
+
+// hostInventorySchema describes the parsed inventory of the host data source
+func hostInventorySchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Hardware inventory of the host parsed from `inventory`. Null until the host has reported its inventory.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname reported by the host",
+				Computed:            true,
+			},
+			"cpu_cores": schema.Int64Attribute{
+				MarkdownDescription: "Number of CPU cores",
+				Computed:            true,
+			},
+			"memory_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Physical memory in bytes",
+				Computed:            true,
+			},
+			"disks": schema.ListNestedAttribute{
+				MarkdownDescription: "Disks of the host",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Disk identifier, as used by `installation_disk_id` and `disks_selected_config`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Device name, e.g. `sda`",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Device path, e.g. `/dev/sda`",
+							Computed:            true,
+						},
+						"by_path": schema.StringAttribute{
+							MarkdownDescription: "Shortest physical path to the device",
+							Computed:            true,
+						},
+						"drive_type": schema.StringAttribute{
+							MarkdownDescription: "Drive type (HDD, SSD, ODD, FDD, Unknown)",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Disk size in bytes",
+							Computed:            true,
+						},
+						"bootable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the disk is bootable",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"interfaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Network interfaces of the host",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Interface name",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "MAC address",
+							Computed:            true,
+						},
+						"ipv4_addresses": schema.ListAttribute{
+							MarkdownDescription: "IPv4 addresses in CIDR notation",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ipv6_addresses": schema.ListAttribute{
+							MarkdownDescription: "IPv6 addresses in CIDR notation",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// hostInventoryValue converts a parsed host inventory to its Terraform value,
+// which is null when the host has not reported an inventory
+func hostInventoryValue(ctx context.Context, inventory *models.Inventory) (types.Object, diag.Diagnostics) {
+	if inventory == nil {
+		return types.ObjectNull(hostInventoryAttrTypes), nil
+	}
+
+	model := HostInventoryModel{
+		Hostname:    stringValueOrNull(inventory.Hostname),
+		CPUCores:    types.Int64Value(int64(inventory.CPU.Count)),
+		MemoryBytes: types.Int64Value(inventory.Memory.PhysicalBytes),
+		Disks:       make([]HostDiskModel, 0, len(inventory.Disks)),
+		Interfaces:  make([]HostInterfaceModel, 0, len(inventory.Interfaces)),
+	}
+
+	for _, disk := range inventory.Disks {
+		model.Disks = append(model.Disks, HostDiskModel{
+			ID:        types.StringValue(disk.ID),
+			Name:      types.StringValue(disk.Name),
+			Path:      types.StringValue(disk.Path),
+			ByPath:    stringValueOrNull(disk.ByPath),
+			DriveType: stringValueOrNull(disk.DriveType),
+			SizeBytes: types.Int64Value(disk.SizeBytes),
+			Bootable:  types.BoolValue(disk.Bootable),
+		})
+	}
+
+	for _, iface := range inventory.Interfaces {
+		model.Interfaces = append(model.Interfaces, HostInterfaceModel{
+			Name:          types.StringValue(iface.Name),
+			MACAddress:    types.StringValue(iface.MacAddress),
+			IPv4Addresses: append([]string{}, iface.IPv4Addresses...),
+			IPv6Addresses: append([]string{}, iface.IPv6Addresses...),
+		})
+	}
+
+	return types.ObjectValueFrom(ctx, hostInventoryAttrTypes, model)
+}
+
+// hostIPAddressesSchema describes the flattened addresses of the host data source
+func hostIPAddressesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "IP addresses assigned to the network interfaces of the host, from `inventory`, in interface order with IPv4 before IPv6 addresses. Null until the host has reported its inventory.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"interface": schema.StringAttribute{
+					MarkdownDescription: "Name of the interface the address is assigned to",
+					Computed:            true,
+				},
+				"family": schema.StringAttribute{
+					MarkdownDescription: "Address family (ipv4, ipv6)",
+					Computed:            true,
+				},
+				"address": schema.StringAttribute{
+					MarkdownDescription: "IP address without prefix length, e.g. for DNS records",
+					Computed:            true,
+				},
+				"cidr": schema.StringAttribute{
+					MarkdownDescription: "IP address with prefix length, as reported by the host",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// hostIPAddressesValue flattens the addresses of the inventory's interfaces,
+// which is null when the host has not reported an inventory
+func hostIPAddressesValue(ctx context.Context, inventory *models.Inventory) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: hostIPAddressAttrTypes}
+	if inventory == nil {
+		return types.ListNull(elemType), nil
+	}
+
+	addresses := []HostIPAddressModel{}
+	for _, iface := range inventory.Interfaces {
+		for _, family := range []struct {
+			name  string
+			cidrs []string
+		}{
+			{name: "ipv4", cidrs: iface.IPv4Addresses},
+			{name: "ipv6", cidrs: iface.IPv6Addresses},
+		} {
+			for _, cidr := range family.cidrs {
+				addresses = append(addresses, HostIPAddressModel{
+					Interface: types.StringValue(iface.Name),
+					Family:    types.StringValue(family.name),
+					Address:   types.StringValue(ipAddressOf(cidr)),
+					CIDR:      types.StringValue(cidr),
+				})
+			}
+		}
+	}
+
+	return types.ListValueFrom(ctx, elemType, addresses)
+}
+
+// ipAddressOf strips the prefix length from an address in CIDR notation,
+// returning other values unchanged
+func ipAddressOf(cidr string) string {
+	if ip, _, err := net.ParseCIDR(cidr); err == nil {
+		return ip.String()
+	}
+	return cidr
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// nodeLabelsParam converts the node_labels map to the key/value list the host
+// update expects, sorted by key so requests are stable. It never returns nil,
+// an empty map is sent as an empty list to clear the labels.
+func nodeLabelsParam(labels map[string]string) *[]models.NodeLabel {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	params := make([]models.NodeLabel, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, models.NodeLabel{Key: key, Value: labels[key]})
+	}
+	return &params
+}
+
+// parseNodeLabels decodes the JSON object of node labels the API returns for a host
+func parseNodeLabels(raw string) (map[string]string, error) {
+	labels := map[string]string{}
+	if raw == "" {
+		return labels, nil
+	}
+
+	if err := json.Unmarshal([]byte(raw), &labels); err != nil {
+		return nil, fmt.Errorf("failed to parse node_labels %q: %w", raw, err)
+	}
+	return labels, nil
+}
+
+func (r *HostResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeHostStateV0},
+	}
+}
+
+// upgradeHostStateV0 converts node_labels from the version 0 list of key/value
+// objects to a map. The rest of the state is carried over unchanged.
+func upgradeHostStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Host State", fmt.Sprintf("Could not parse prior host state: %s", err))
+		return
+	}
+
+	var labels []models.NodeLabel
+	if raw, ok := state["node_labels"]; ok {
+		if err := json.Unmarshal(raw, &labels); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Host State", fmt.Sprintf("Could not parse prior node_labels: %s", err))
+			return
+		}
+	}
+
+	if labels == nil {
+		state["node_labels"] = json.RawMessage("null")
+	} else {
+		labelMap := make(map[string]string, len(labels))
+		for _, label := range labels {
+			labelMap[label.Key] = label.Value
+		}
+		encoded, _ := json.Marshal(labelMap)
+		state["node_labels"] = encoded
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Host State", fmt.Sprintf("Could not encode upgraded host state: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNodeLabelsParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected string
+	}{
+		{name: "empty", labels: map[string]string{}, expected: `[]`},
+		{name: "nil", labels: nil, expected: `[]`},
+		{name: "empty value", labels: map[string]string{"node-role.kubernetes.io/infra": ""}, expected: `[{"key":"node-role.kubernetes.io/infra","value":""}]`},
+		{
+			name:     "sorted by key",
+			labels:   map[string]string{"zone": "a", "node-role.kubernetes.io/infra": "", "environment": "production"},
+			expected: `[{"key":"environment","value":"production"},{"key":"node-role.kubernetes.io/infra","value":""},{"key":"zone","value":"a"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := json.Marshal(nodeLabelsParam(tt.labels))
+			if err != nil {
+				t.Fatalf("Failed to marshal node labels: %v", err)
+			}
+			if string(encoded) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, encoded)
+			}
+		})
+	}
+}
+
+func TestParseNodeLabels(t *testing.T) {
+	labels, err := parseNodeLabels(`{"node-role.kubernetes.io/infra":"","zone":"a"}`)
+	if err != nil {
+		t.Fatalf("parseNodeLabels() error = %v", err)
+	}
+	if len(labels) != 2 || labels["zone"] != "a" || labels["node-role.kubernetes.io/infra"] != "" {
+		t.Errorf("Unexpected labels %v", labels)
+	}
+
+	if labels, err := parseNodeLabels(""); err != nil || len(labels) != 0 {
+		t.Errorf("Expected no labels for an empty string, got %v, %v", labels, err)
+	}
+
+	if _, err := parseNodeLabels(`[{"key":"zone"}]`); err == nil {
+		t.Error("Expected an error for malformed node labels")
+	}
+}
+
+func TestHostResource_NodeLabelsAndMachineConfigPool(t *testing.T) {
+	labelsValue := func(labels map[string]string) tftypes.Value {
+		if labels == nil {
+			return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+		}
+		values := make(map[string]tftypes.Value, len(labels))
+		for key, value := range labels {
+			values[key] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+	}
+
+	tests := []struct {
+		name          string
+		currentLabels string
+		currentPool   string
+		plannedLabels map[string]string
+		plannedPool   tftypes.Value
+		expectedPool  string
+		expectedBody  string
+	}{
+		{
+			name:          "set labels and pool",
+			plannedLabels: map[string]string{"zone": "a", "node-role.kubernetes.io/infra": ""},
+			plannedPool:   tftypes.NewValue(tftypes.String, "infra"),
+			expectedPool:  "infra",
+			expectedBody:  `{"machine_config_pool_name":"infra","node_labels":[{"key":"node-role.kubernetes.io/infra","value":""},{"key":"zone","value":"a"}]}`,
+		},
+		{
+			name:          "unchanged",
+			currentLabels: `{"node-role.kubernetes.io/infra":"","zone":"a"}`,
+			currentPool:   "infra",
+			plannedLabels: map[string]string{"zone": "a", "node-role.kubernetes.io/infra": ""},
+			plannedPool:   tftypes.NewValue(tftypes.String, "infra"),
+			expectedPool:  "infra",
+		},
+		{
+			name:          "removed from configuration",
+			currentLabels: `{"zone":"a"}`,
+			currentPool:   "infra",
+			plannedPool:   tftypes.NewValue(tftypes.String, nil),
+			expectedBody:  `{"machine_config_pool_name":"","node_labels":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			currentLabels, currentPool := tt.currentLabels, tt.currentPool
+			var body string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts/test-host-id" {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				if r.Method == http.MethodPatch {
+					raw, _ := io.ReadAll(r.Body)
+					body = strings.TrimSpace(string(raw))
+
+					var params models.HostUpdateParams
+					_ = json.Unmarshal(raw, &params)
+					labels := map[string]string{}
+					for _, label := range *params.NodeLabels {
+						labels[label.Key] = label.Value
+					}
+					encoded, _ := json.Marshal(labels)
+					currentLabels, currentPool = string(encoded), *params.MachineConfigPoolName
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:                    "test-host-id",
+					InfraEnvID:            "test-infra-env-id",
+					Status:                "known",
+					Role:                  "worker",
+					NodeLabels:            currentLabels,
+					MachineConfigPoolName: currentPool,
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":             tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":                     tftypes.NewValue(tftypes.String, "worker"),
+				"node_labels":              labelsValue(tt.plannedLabels),
+				"machine_config_pool_name": tt.plannedPool,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if body != tt.expectedBody {
+				t.Errorf("Expected PATCH body %s, got %s", tt.expectedBody, body)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			labels := map[string]string{}
+			if !data.NodeLabels.IsNull() {
+				resp.Diagnostics.Append(data.NodeLabels.ElementsAs(ctx, &labels, false)...)
+			}
+			if len(labels) != len(tt.plannedLabels) {
+				t.Errorf("Expected node_labels %v, got %s", tt.plannedLabels, data.NodeLabels)
+			}
+			for key, value := range tt.plannedLabels {
+				if labels[key] != value {
+					t.Errorf("Expected node label %s=%q, got %q", key, value, labels[key])
+				}
+			}
+			if data.MachineConfigPoolName.ValueString() != tt.expectedPool || (tt.expectedPool == "") != data.MachineConfigPoolName.IsNull() {
+				t.Errorf("Expected machine_config_pool_name %q, got %s", tt.expectedPool, data.MachineConfigPoolName)
+			}
+		})
+	}
+}
+
+func TestHostResource_UpgradeStateV0(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		expected string
+	}{
+		{name: "labels", prior: `[{"key":"zone","value":"a"},{"key":"node-role.kubernetes.io/infra","value":""}]`, expected: `{"node-role.kubernetes.io/infra":"","zone":"a"}`},
+		{name: "no labels", prior: `null`, expected: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"test-host-id","infra_env_id":"test-infra-env-id","node_labels":` + tt.prior + `}`)},
+			}
+			resp := &resource.UpgradeStateResponse{}
+
+			upgrader := (&HostResource{}).UpgradeState(context.Background())[0]
+			upgrader.StateUpgrader(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("UpgradeState() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var upgraded map[string]json.RawMessage
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+				t.Fatalf("Failed to parse upgraded state: %v", err)
+			}
+			if string(upgraded["node_labels"]) != tt.expected {
+				t.Errorf("Expected node_labels %s, got %s", tt.expected, upgraded["node_labels"])
+			}
+			if string(upgraded["infra_env_id"]) != `"test-infra-env-id"` {
+				t.Errorf("Expected infra_env_id to be carried over, got %s", upgraded["infra_env_id"])
+			}
+		})
+	}
+}
@@ -2,12 +2,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +33,30 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HostResource{}
 var _ resource.ResourceWithImportState = &HostResource{}
+var _ resource.ResourceWithUpgradeState = &HostResource{}
+
+// hostDiscoveryPollInterval is how often the infra-env hosts are listed while
+// waiting for a host with the configured MAC address to be discovered
+var hostDiscoveryPollInterval = 15 * time.Second
+
+// diskIDPattern matches the disk identifiers reported in the host inventory,
+// such as /dev/disk/by-id/wwn-0x5000c500a0b1c2d3 or /dev/sdb
+var diskIDPattern = regexp.MustCompile(`^/dev/[^\s,]+$`)
+
+// hostConfigurableStatuses are the host statuses before installation starts,
+// in which the service still accepts changes to the installer arguments
+var hostConfigurableStatuses = map[string]bool{
+	"discovering":          true,
+	"known":                true,
+	"disconnected":         true,
+	"insufficient":         true,
+	"pending-for-input":    true,
+	"discovering-unbound":  true,
+	"known-unbound":        true,
+	"disconnected-unbound": true,
+	"insufficient-unbound": true,
+	"binding":              true,
+}
 
 func NewHostResource() resource.Resource {
 	return &HostResource{}
@@ -37,22 +72,40 @@ type HostResourceModel struct {
 	ID                          types.String `tfsdk:"id"`
 	InfraEnvID                  types.String `tfsdk:"infra_env_id"`
 	ClusterID                   types.String `tfsdk:"cluster_id"`
+	MACAddress                  types.String `tfsdk:"mac_address"`
 	RequestedHostname           types.String `tfsdk:"requested_hostname"`
 	HostName                    types.String `tfsdk:"host_name"`
 	Role                        types.String `tfsdk:"role"`
+	EffectiveRole               types.String `tfsdk:"effective_role"`
+	InstallationDiskID          types.String `tfsdk:"installation_disk_id"`
 	DisksSelectedConfig         types.List   `tfsdk:"disks_selected_config"`
 	DisksSkipFormatting         types.List   `tfsdk:"disks_skip_formatting"`
+	SkipFormattingDisks         types.Set    `tfsdk:"skip_formatting_disks"`
 	MachineConfigPoolName       types.String `tfsdk:"machine_config_pool_name"`
 	IgnitionEndpointToken       types.String `tfsdk:"ignition_endpoint_token"`
 	IgnitionEndpointHTTPHeaders types.List   `tfsdk:"ignition_endpoint_http_headers"`
-	NodeLabels                  types.List   `tfsdk:"node_labels"`
+	NodeLabels                  types.Map    `tfsdk:"node_labels"`
+	InstallerArgs               types.List   `tfsdk:"installer_args"`
+	IgnitionConfigOverrides     types.String `tfsdk:"ignition_config_overrides"`
+	WaitForInstalled            types.Bool   `tfsdk:"wait_for_installed"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
-	Status     types.String       `tfsdk:"status"`
-	StatusInfo types.String       `tfsdk:"status_info"`
-	Progress   *HostProgressModel `tfsdk:"progress"`
-	CreatedAt  types.String       `tfsdk:"created_at"`
-	UpdatedAt  types.String       `tfsdk:"updated_at"`
+	DisksToBeFormatted types.List   `tfsdk:"disks_to_be_formatted"`
+	Status             types.String `tfsdk:"status"`
+	StatusInfo         types.String `tfsdk:"status_info"`
+	Progress           types.Object `tfsdk:"progress"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+// hostProgressAttrTypes are the attribute types of the progress object
+var hostProgressAttrTypes = map[string]attr.Type{
+	"current_stage":    types.StringType,
+	"progress_info":    types.StringType,
+	"stage_started_at": types.StringType,
+	"stage_updated_at": types.StringType,
 }
 
 type HostProgressModel struct {
@@ -76,11 +129,6 @@ type IgnitionEndpointHTTPHeaderModel struct {
 	Value types.String `tfsdk:"value"`
 }
 
-type NodeLabelModel struct {
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
-}
-
 func (r *HostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_host"
 }
@@ -88,8 +136,14 @@ func (r *HostResource) Metadata(ctx context.Context, req resource.MetadataReques
 func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Host resource for managing OpenShift cluster hosts discovered through an infrastructure environment.",
+		// Version 1 changed node_labels from a list of key/value objects to a map
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Host identifier.",
 				Computed:            true,
@@ -108,25 +162,53 @@ func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Cluster ID to bind this host to. If not specified, host remains unbound.",
 				Optional:            true,
 			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "MAC address of a network interface of the host. On create, the provider waits until a host with this MAC address is discovered in the infrastructure environment, bounded by the create timeout, and manages that host. Not needed for imported hosts.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+						"must be a MAC address such as 52:54:00:aa:bb:cc",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					// Imported hosts have no MAC address in state, adding one must not replace them
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing the MAC address of a discovered host requires adopting another host.", "Changing the MAC address of a discovered host requires adopting another host."),
+				},
+			},
 			"requested_hostname": schema.StringAttribute{
 				MarkdownDescription: "Requested hostname for this host. If not specified, a default will be assigned.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "Role assignment for this host in the cluster.",
+				MarkdownDescription: "Role assignment for this host in the cluster. An explicit role always wins over the role the service suggests, `auto-assign` leaves the choice to the service and `accept-suggested` assigns the suggested role once the service reports one.",
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString("auto-assign"),
+				Default:             stringdefault.StaticString(hostRoleAutoAssign),
 				Validators: []validator.String{
-					stringvalidator.OneOf("master", "worker", "bootstrap", "auto-assign"),
+					stringvalidator.OneOf("master", "worker", "bootstrap", hostRoleAutoAssign, hostRoleAcceptSuggested),
 				},
 			},
+			"effective_role": schema.StringAttribute{
+				MarkdownDescription: "Role the host will actually take in the cluster. With `auto-assign` this is the role the service assigned or suggested; null until one is known.",
+				Computed:            true,
+			},
 			"host_name": schema.StringAttribute{
 				MarkdownDescription: "Host name (different from requested hostname).",
 				Optional:            true,
 				Computed:            true,
 			},
+			"installation_disk_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the disk to install the operating system on, as reported in the `id` of a disk in the host inventory (e.g. `/dev/disk/by-id/wwn-0x5000c500a0b1c2d3`). If not specified, the service selects a disk and its ID is reported here.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"disks_selected_config": schema.ListNestedAttribute{
 				MarkdownDescription: "Disk selection configuration for this host.",
 				Optional:            true,
@@ -144,7 +226,8 @@ func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"disks_skip_formatting": schema.ListNestedAttribute{
-				MarkdownDescription: "Disks to skip formatting during installation.",
+				MarkdownDescription: "Disks to skip formatting during installation. Deprecated and ignored, use `skip_formatting_disks` instead.",
+				DeprecationMessage:  "disks_skip_formatting is ignored, use skip_formatting_disks instead.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -155,8 +238,24 @@ func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					},
 				},
 			},
+			"skip_formatting_disks": schema.SetAttribute{
+				MarkdownDescription: "IDs of disks the installer must not format, as reported in the `id` of a disk in the host inventory (e.g. `/dev/disk/by-id/wwn-0x5000c500a0b1c2d3`). Use it to keep pre-provisioned data disks.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(diskIDPattern, "must be a disk ID such as /dev/disk/by-id/wwn-0x5000c500a0b1c2d3")),
+				},
+			},
+			"disks_to_be_formatted": schema.ListAttribute{
+				MarkdownDescription: "Disks the installer will format, as reported by the service.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"machine_config_pool_name": schema.StringAttribute{
-				MarkdownDescription: "Machine config pool name for this host.",
+				MarkdownDescription: "Name of the machine config pool the node joins, e.g. `infra`. The pool itself must be created with a manifest.",
 				Optional:            true,
 			},
 			"ignition_endpoint_token": schema.StringAttribute{
@@ -180,23 +279,29 @@ func (r *HostResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					},
 				},
 			},
-			"node_labels": schema.ListNestedAttribute{
-				MarkdownDescription: "Labels to be added to the corresponding Kubernetes node.",
+			"node_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels to be added to the corresponding Kubernetes node, e.g. `{ \"node-role.kubernetes.io/infra\" = \"\" }`.",
 				Optional:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"key": schema.StringAttribute{
-							MarkdownDescription: "Label key.",
-							Required:            true,
-						},
-						"value": schema.StringAttribute{
-							MarkdownDescription: "Label value.",
-							Required:            true,
-						},
-					},
+				ElementType:         types.StringType,
+			},
+			"installer_args": schema.ListAttribute{
+				MarkdownDescription: "Extra arguments passed to coreos-installer when the host is installed, e.g. `[\"--append-karg\", \"console=ttyS0\"]`. Can only be changed before the host starts installing.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ignition_config_overrides": schema.StringAttribute{
+				MarkdownDescription: "JSON ignition config merged into the pointer ignition of this host only, e.g. to lay down a file on one node.",
+				Optional:            true,
+				Validators: []validator.String{
+					validJSON(),
 				},
 			},
 
+			"wait_for_installed": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the host is installed (`installed` or `added-to-existing-cluster`) before completing. Fails if the host installation fails or the create/update timeout (default 90 minutes) expires.",
+				Optional:            true,
+			},
+
 			// Computed attributes
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Current status of the host.",
@@ -270,19 +375,30 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Note: Hosts are discovered automatically when they boot from the ISO
-	// The "create" operation here is really about configuring an existing discovered host
-	// We first need to wait for a host to be discovered in the specified infra-env
+	createTimeout, diags := data.Timeouts.Create(ctx, 90*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// For now, we require the host ID to be provided as an import operation
-	// A full implementation would include polling for discovered hosts
+	// Hosts are discovered automatically when they boot from the ISO, so the
+	// "create" operation adopts the discovered host with the configured MAC
+	// address and configures it
+	if data.ID.IsNull() || data.ID.IsUnknown() || data.ID.ValueString() == "" {
+		if data.MACAddress.IsNull() {
+			resp.Diagnostics.AddError(
+				"Host MAC Address Required",
+				"Hosts are discovered when they boot from the infrastructure environment ISO. Set \"mac_address\" to manage the host with that MAC address once it is discovered, or use 'terraform import' to manage an existing discovered host.",
+			)
+			return
+		}
 
-	if data.ID.IsNull() || data.ID.ValueString() == "" {
-		resp.Diagnostics.AddError(
-			"Host ID Required",
-			"Host resources cannot be created directly. Hosts are discovered when they boot from the infrastructure environment ISO. Use 'terraform import' to manage existing discovered hosts.",
-		)
-		return
+		discovered, err := waitForHostDiscovery(ctx, r.client, data.InfraEnvID.ValueString(), data.MACAddress.ValueString(), createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Host Not Discovered", fmt.Sprintf("No host with MAC address %s was discovered: %s", data.MACAddress.ValueString(), err))
+			return
+		}
+		data.ID = types.StringValue(discovered.ID)
 	}
 
 	// Get the host to verify it exists
@@ -298,6 +414,13 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if data.WaitForInstalled.ValueBool() {
+		if err := waitForHostInstalled(ctx, r.client, data.InfraEnvID.ValueString(), data.ID.ValueString(), createTimeout); err != nil {
+			resp.Diagnostics.AddError("Host Installation Failed", fmt.Sprintf("Host %s did not install: %s", data.ID.ValueString(), err))
+			return
+		}
+	}
+
 	// Read the updated host state
 	updatedHost, err := r.client.GetHost(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString())
 	if err != nil {
@@ -332,6 +455,11 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Get the host from the API
 	host, err := r.client.GetHost(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Host not found", fmt.Sprintf("Host %s no longer exists and will be removed from state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading host", fmt.Sprintf("Could not read host %s: %s", data.ID.ValueString(), err))
 		return
 	}
@@ -353,6 +481,12 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var state HostResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current host state
 	host, err := r.client.GetHost(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString())
 	if err != nil {
@@ -360,12 +494,33 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Only push the role when it changed, otherwise a resolved auto-assign
+	// would be reset on every update. accept-suggested is pushed again once
+	// the service has a suggestion the host does not have yet.
+	config := data
+	if data.Role.Equal(state.Role) && !suggestedRolePending(data.Role, host) {
+		config.Role = types.StringNull()
+	}
+
 	// Configure the host based on the updated plan
-	if err := r.configureHost(ctx, &data, host); err != nil {
+	if err := r.configureHost(ctx, &config, host); err != nil {
 		resp.Diagnostics.AddError("Error updating host", fmt.Sprintf("Could not update host %s: %s", data.ID.ValueString(), err))
 		return
 	}
 
+	if data.WaitForInstalled.ValueBool() {
+		updateTimeout, diags := data.Timeouts.Update(ctx, 90*time.Minute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := waitForHostInstalled(ctx, r.client, data.InfraEnvID.ValueString(), data.ID.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError("Host Installation Failed", fmt.Sprintf("Host %s did not install: %s", data.ID.ValueString(), err))
+			return
+		}
+	}
+
 	// Read the updated host state
 	updatedHost, err := r.client.GetHost(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString())
 	if err != nil {
@@ -417,8 +572,8 @@ func (r *HostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *HostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import state expects "infra_env_id/host_id" format
-	idParts := len(req.ID)
-	if idParts == 0 {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: infra_env_id/host_id. Got: %q", req.ID),
@@ -426,8 +581,8 @@ func (r *HostResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	// For simplicity, assume the ID is just the host ID and require infra_env_id to be set in config
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("infra_env_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
 }
 
 // Helper functions
@@ -447,19 +602,72 @@ func (r *HostResource) configureHost(ctx context.Context, data *HostResourceMode
 
 	// Check if role needs updating
 	if !data.Role.IsNull() {
-		role := data.Role.ValueString()
+		role := hostRoleParam(data.Role.ValueString(), currentHost)
 		if currentHost.Role != role {
 			updateParams.Role = &role
 			needsUpdate = true
 		}
 	}
 
+	// Check if the machine config pool needs updating, a null pool name clears it
+	if !data.MachineConfigPoolName.IsUnknown() {
+		pool := data.MachineConfigPoolName.ValueString()
+		if currentHost.MachineConfigPoolName != pool {
+			updateParams.MachineConfigPoolName = &pool
+			needsUpdate = true
+		}
+	}
+
+	// Check if node labels need updating, a null node_labels clears them
+	if !data.NodeLabels.IsUnknown() {
+		labels := map[string]string{}
+		if diags := data.NodeLabels.ElementsAs(ctx, &labels, false); diags.HasError() {
+			return fmt.Errorf("failed to read node_labels")
+		}
+
+		currentLabels, err := parseNodeLabels(currentHost.NodeLabels)
+		if err != nil {
+			return err
+		}
+		if !maps.Equal(labels, currentLabels) {
+			updateParams.NodeLabels = nodeLabelsParam(labels)
+			needsUpdate = true
+		}
+	}
+
+	// Check which disks need to be added to or removed from the skip formatting
+	// list, a null skip_formatting_disks formats all disks again
+	if !data.SkipFormattingDisks.IsUnknown() {
+		var disks []string
+		if diags := data.SkipFormattingDisks.ElementsAs(ctx, &disks, false); diags.HasError() {
+			return fmt.Errorf("failed to read skip_formatting_disks")
+		}
+
+		if changes := skipFormattingChanges(splitDiskList(currentHost.SkipFormattingDisks), disks); len(changes) > 0 {
+			updateParams.DisksSkipFormatting = changes
+			needsUpdate = true
+		}
+	}
+
+	// Select the installation disk if it changed
+	if !data.InstallationDiskID.IsNull() && !data.InstallationDiskID.IsUnknown() {
+		diskID := data.InstallationDiskID.ValueString()
+		if currentHost.InstallationDiskID != diskID {
+			updateParams.DisksSelectedConfig = []models.DiskConfig{{ID: diskID, Role: "install"}}
+			needsUpdate = true
+		}
+	}
+
 	// Update host configuration if needed
 	if needsUpdate {
 		tflog.Info(ctx, "Updating host configuration", map[string]any{
-			"host_id":            data.ID.ValueString(),
-			"requested_hostname": updateParams.RequestedHostname,
-			"role":               updateParams.Role,
+			"host_id":              data.ID.ValueString(),
+			"requested_hostname":   updateParams.RequestedHostname,
+			"role":                 updateParams.Role,
+			"installation_disk_id": data.InstallationDiskID.ValueString(),
+			"machine_config_pool":  updateParams.MachineConfigPoolName,
+			"node_labels":          updateParams.NodeLabels,
+			"skip_formatting":      updateParams.DisksSkipFormatting,
 		})
 
 		_, err := r.client.UpdateHost(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString(), updateParams)
@@ -468,6 +676,25 @@ func (r *HostResource) configureHost(ctx context.Context, data *HostResourceMode
 		}
 	}
 
+	if err := r.configureInstallerArgs(ctx, data, currentHost); err != nil {
+		return err
+	}
+
+	// Ignition overrides are compared as JSON, the service may reformat them.
+	// A null ignition_config_overrides clears overrides set on the host.
+	if !data.IgnitionConfigOverrides.IsUnknown() {
+		ignition := data.IgnitionConfigOverrides.ValueString()
+		if ignition != currentHost.IgnitionConfigOverrides && !jsonEquivalent(ignition, currentHost.IgnitionConfigOverrides) {
+			tflog.Info(ctx, "Updating host ignition config overrides", map[string]any{
+				"host_id": data.ID.ValueString(),
+			})
+
+			if err := r.client.UpdateHostIgnition(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString(), ignition); err != nil {
+				return fmt.Errorf("failed to update host ignition config overrides: %w", err)
+			}
+		}
+	}
+
 	// Handle cluster binding/unbinding
 	desiredClusterID := ""
 	if !data.ClusterID.IsNull() {
@@ -507,6 +734,57 @@ func (r *HostResource) configureHost(ctx context.Context, data *HostResourceMode
 	return nil
 }
 
+// configureInstallerArgs replaces the installer arguments of the host when they
+// differ from the plan. A null installer_args clears arguments set on the host.
+func (r *HostResource) configureInstallerArgs(ctx context.Context, data *HostResourceModel, currentHost *models.Host) error {
+	if data.InstallerArgs.IsUnknown() {
+		return nil
+	}
+
+	var args []string
+	if !data.InstallerArgs.IsNull() {
+		if diags := data.InstallerArgs.ElementsAs(ctx, &args, false); diags.HasError() {
+			return fmt.Errorf("failed to read installer_args")
+		}
+	}
+
+	currentArgs, err := parseInstallerArgs(currentHost.InstallerArgs)
+	if err != nil {
+		return err
+	}
+	if slices.Equal(args, currentArgs) {
+		return nil
+	}
+
+	if !hostConfigurableStatuses[currentHost.Status] {
+		return fmt.Errorf("installer_args can only be changed before the host starts installing, host is in status %s", currentHost.Status)
+	}
+
+	tflog.Info(ctx, "Updating host installer arguments", map[string]any{
+		"host_id":        data.ID.ValueString(),
+		"installer_args": args,
+	})
+
+	if _, err := r.client.UpdateHostInstallerArgs(ctx, data.InfraEnvID.ValueString(), data.ID.ValueString(), args); err != nil {
+		return fmt.Errorf("failed to update host installer arguments: %w", err)
+	}
+	return nil
+}
+
+// parseInstallerArgs decodes the JSON array of installer arguments the API
+// returns for a host
+func parseInstallerArgs(raw string) ([]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var args []string
+	if err := json.Unmarshal([]byte(raw), &args); err != nil {
+		return nil, fmt.Errorf("failed to parse installer_args %q: %w", raw, err)
+	}
+	return args, nil
+}
+
 func (r *HostResource) apiToTerraformModel(ctx context.Context, host *models.Host, data *HostResourceModel) {
 	data.ID = types.StringValue(host.ID)
 	data.InfraEnvID = types.StringValue(host.InfraEnvID)
@@ -525,33 +803,204 @@ func (r *HostResource) apiToTerraformModel(ctx context.Context, host *models.Hos
 		data.RequestedHostname = types.StringNull()
 	}
 
-	if host.Role != "" {
-		data.Role = types.StringValue(host.Role)
+	// Once the service resolves auto-assign to a concrete role, keep auto-assign
+	// as the configured intent and report the resolved role as effective_role
+	data.Role = hostRoleValue(data.Role, host)
+	data.EffectiveRole = effectiveHostRole(host)
+	data.InstallationDiskID = stringValueOrNull(host.InstallationDiskID)
+	data.MachineConfigPoolName = stringValueOrNull(host.MachineConfigPoolName)
+	data.DisksToBeFormatted, _ = types.ListValueFrom(ctx, types.StringType, splitDiskList(host.DisksToBeFormatted))
+
+	// Keep an empty skip_formatting_disks set as configured rather than flipping it to null
+	if skipDisks := splitDiskList(host.SkipFormattingDisks); len(skipDisks) > 0 {
+		data.SkipFormattingDisks, _ = types.SetValueFrom(ctx, types.StringType, skipDisks)
+	} else if data.SkipFormattingDisks.IsUnknown() || len(data.SkipFormattingDisks.Elements()) > 0 {
+		data.SkipFormattingDisks = types.SetNull(types.StringType)
+	}
+
+	// Keep an empty node_labels map as configured rather than flipping it to null
+	if labels, err := parseNodeLabels(host.NodeLabels); err == nil {
+		if len(labels) > 0 {
+			data.NodeLabels, _ = types.MapValueFrom(ctx, types.StringType, labels)
+		} else if data.NodeLabels.IsUnknown() || len(data.NodeLabels.Elements()) > 0 {
+			data.NodeLabels = types.MapNull(types.StringType)
+		}
+	}
+
+	// Keep the configured ignition override when the service only reformatted the JSON
+	if host.IgnitionConfigOverrides != "" {
+		if data.IgnitionConfigOverrides.IsNull() || data.IgnitionConfigOverrides.IsUnknown() ||
+			!jsonEquivalent(data.IgnitionConfigOverrides.ValueString(), host.IgnitionConfigOverrides) {
+			data.IgnitionConfigOverrides = types.StringValue(host.IgnitionConfigOverrides)
+		}
 	} else {
-		data.Role = types.StringValue("auto-assign")
+		data.IgnitionConfigOverrides = types.StringNull()
+	}
+
+	// Keep an empty installer_args list as configured rather than flipping it to null
+	if args, err := parseInstallerArgs(host.InstallerArgs); err == nil {
+		if len(args) > 0 {
+			data.InstallerArgs, _ = types.ListValueFrom(ctx, types.StringType, args)
+		} else if data.InstallerArgs.IsUnknown() || len(data.InstallerArgs.Elements()) > 0 {
+			data.InstallerArgs = types.ListNull(types.StringType)
+		}
 	}
 
 	// Convert progress information
+	data.Progress = types.ObjectNull(hostProgressAttrTypes)
 	if host.Progress != nil {
-		data.Progress = &HostProgressModel{
-			CurrentStage: types.StringValue(host.Progress.CurrentStage),
-			ProgressInfo: types.StringValue(host.Progress.ProgressInfo),
+		progress := HostProgressModel{
+			CurrentStage:   types.StringValue(host.Progress.CurrentStage),
+			ProgressInfo:   types.StringValue(host.Progress.ProgressInfo),
+			StageStartedAt: types.StringNull(),
+			StageUpdatedAt: types.StringNull(),
 		}
 
 		if !host.Progress.StageStartedAt.IsZero() {
-			data.Progress.StageStartedAt = types.StringValue(host.Progress.StageStartedAt.Format("2006-01-02T15:04:05Z"))
+			progress.StageStartedAt = types.StringValue(host.Progress.StageStartedAt.Format("2006-01-02T15:04:05Z"))
 		}
 
 		if !host.Progress.StageUpdatedAt.IsZero() {
-			data.Progress.StageUpdatedAt = types.StringValue(host.Progress.StageUpdatedAt.Format("2006-01-02T15:04:05Z"))
+			progress.StageUpdatedAt = types.StringValue(host.Progress.StageUpdatedAt.Format("2006-01-02T15:04:05Z"))
+		}
+
+		data.Progress, _ = types.ObjectValueFrom(ctx, hostProgressAttrTypes, progress)
+	}
+
+	if data.HostName.IsUnknown() {
+		data.HostName = types.StringNull()
+		if host.HostName != "" {
+			data.HostName = types.StringValue(host.HostName)
 		}
 	}
 
 	if !host.CreatedAt.IsZero() {
 		data.CreatedAt = types.StringValue(host.CreatedAt.Format("2006-01-02T15:04:05Z"))
+	} else if data.CreatedAt.IsUnknown() {
+		data.CreatedAt = types.StringNull()
 	}
 
 	if !host.UpdatedAt.IsZero() {
 		data.UpdatedAt = types.StringValue(host.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	} else if data.UpdatedAt.IsUnknown() {
+		data.UpdatedAt = types.StringNull()
+	}
+}
+
+// splitDiskList splits the comma-separated disk lists the API reports for a host
+func splitDiskList(raw string) []string {
+	disks := []string{}
+	for _, disk := range strings.Split(raw, ",") {
+		if disk = strings.TrimSpace(disk); disk != "" {
+			disks = append(disks, disk)
+		}
+	}
+	return disks
+}
+
+// skipFormattingChanges returns the updates that turn the current skip
+// formatting disks into the desired ones: desired disks not yet skipped are
+// added and skipped disks no longer desired are removed
+func skipFormattingChanges(current, desired []string) []models.DiskSkipFormatting {
+	var changes []models.DiskSkipFormatting
+	for _, disk := range desired {
+		if !slices.Contains(current, disk) {
+			changes = append(changes, models.DiskSkipFormatting{DiskID: disk, SkipFormatting: true})
+		}
+	}
+	for _, disk := range current {
+		if !slices.Contains(desired, disk) {
+			changes = append(changes, models.DiskSkipFormatting{DiskID: disk, SkipFormatting: false})
+		}
+	}
+	return changes
+}
+
+// effectiveHostRole returns the concrete role of a host, falling back to the
+// suggested role while the host is still auto-assign
+func effectiveHostRole(host *models.Host) types.String {
+	for _, role := range []string{host.Role, host.SuggestedRole} {
+		if role != "" && role != "auto-assign" {
+			return types.StringValue(role)
+		}
+	}
+	return types.StringNull()
+}
+
+// waitForHostInstalled polls the host until it is installed, fails or the timeout expires
+func waitForHostInstalled(ctx context.Context, c *client.Client, infraEnvID, hostID string, timeout time.Duration) error {
+	ticker := time.NewTicker(installationPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while waiting for host installation")
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				return fmt.Errorf("host installation timeout exceeded (%v)", timeout)
+			}
+
+			host, err := c.GetHost(ctx, infraEnvID, hostID)
+			if err != nil {
+				return fmt.Errorf("failed to get host status: %w", err)
+			}
+
+			tflog.Debug(ctx, "Checking host installation status", map[string]interface{}{
+				"host_id":     hostID,
+				"status":      host.Status,
+				"status_info": host.StatusInfo,
+			})
+
+			switch host.Status {
+			case "installed", "added-to-existing-cluster":
+				return nil
+			case "error", "cancelled":
+				return fmt.Errorf("host installation failed with status %s: %s", host.Status, host.StatusInfo)
+			}
+		}
+	}
+}
+
+// waitForHostDiscovery polls the infra-env hosts until one with the given MAC
+// address is discovered or the timeout expires
+func waitForHostDiscovery(ctx context.Context, c *client.Client, infraEnvID, macAddress string, timeout time.Duration) (*models.Host, error) {
+	ticker := time.NewTicker(hostDiscoveryPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		hosts, err := c.ListHosts(ctx, infraEnvID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list hosts: %w", err)
+		}
+
+		for i := range hosts {
+			if hosts[i].HasMACAddress(macAddress) {
+				tflog.Info(ctx, "Discovered host", map[string]interface{}{
+					"host_id":     hosts[i].ID,
+					"mac_address": macAddress,
+				})
+				return &hosts[i], nil
+			}
+		}
+
+		tflog.Debug(ctx, "Waiting for host discovery", map[string]interface{}{
+			"infra_env_id": infraEnvID,
+			"mac_address":  macAddress,
+			"host_count":   len(hosts),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context cancelled while waiting for host discovery")
+		case <-ticker.C:
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("host discovery timeout exceeded (%v)", timeout)
+			}
+		}
 	}
 }
@@ -0,0 +1,884 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEffectiveHostRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     models.Host
+		expected string
+	}{
+		{name: "explicit role", host: models.Host{Role: "worker", SuggestedRole: "master"}, expected: "worker"},
+		{name: "auto-assign with suggestion", host: models.Host{Role: "auto-assign", SuggestedRole: "master"}, expected: "master"},
+		{name: "auto-assign without suggestion", host: models.Host{Role: "auto-assign"}},
+		{name: "no role", host: models.Host{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := effectiveHostRole(&tt.host)
+			if tt.expected == "" {
+				if !got.IsNull() {
+					t.Errorf("Expected null effective role, got %s", got)
+				}
+				return
+			}
+			if got.ValueString() != tt.expected {
+				t.Errorf("Expected effective role %q, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHostResource_AutoAssignResolvesToMaster(t *testing.T) {
+	ctx := context.Background()
+
+	var patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts/test-host-id" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPatch {
+			patches++
+		}
+		// The service has resolved auto-assign to a concrete role
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Host{
+			ID:            "test-host-id",
+			InfraEnvID:    "test-infra-env-id",
+			ClusterID:     "test-cluster-id",
+			Status:        "installed",
+			Role:          "master",
+			SuggestedRole: "master",
+		})
+	}))
+	defer server.Close()
+
+	r := &HostResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "test-host-id"),
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"cluster_id":   tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"role":         tftypes.NewValue(tftypes.String, "auto-assign"),
+	})
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", readResp.Diagnostics)
+	}
+
+	var data HostResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+
+	if data.Role.ValueString() != "auto-assign" {
+		t.Errorf("Expected role to stay auto-assign, got %s", data.Role)
+	}
+	if data.EffectiveRole.ValueString() != "master" {
+		t.Errorf("Expected effective_role master, got %s", data.EffectiveRole)
+	}
+
+	// Applying again with an unchanged role must not reset the assigned role
+	plan := tfsdk.Plan{Schema: readResp.State.Schema, Raw: readResp.State.Raw}
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned diagnostics: %+v", updateResp.Diagnostics)
+	}
+	if patches != 0 {
+		t.Errorf("Expected no host update for an unchanged auto-assign role, got %d", patches)
+	}
+}
+
+func TestHostResource_WaitForInstalled(t *testing.T) {
+	originalInterval := installationPollInterval
+	installationPollInterval = 5 * time.Millisecond
+	defer func() { installationPollInterval = originalInterval }()
+
+	tests := []struct {
+		name        string
+		statuses    []string
+		timeout     string
+		expectError string
+	}{
+		{name: "installed", statuses: []string{"installing", "installing-in-progress", "installed"}, timeout: "10s"},
+		{name: "added to existing cluster", statuses: []string{"installing", "added-to-existing-cluster"}, timeout: "10s"},
+		{name: "installation fails", statuses: []string{"installing", "error"}, timeout: "10s", expectError: "status error"},
+		{name: "timeout", statuses: []string{"installing"}, timeout: "50ms", expectError: "timeout exceeded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Serve statuses in order, repeating the last one
+				n := int(atomic.AddInt32(&polls, 1)) - 1
+				if n >= len(tt.statuses) {
+					n = len(tt.statuses) - 1
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:         "test-host-id",
+					InfraEnvID: "test-infra-env-id",
+					Status:     tt.statuses[n],
+					StatusInfo: "Host status " + tt.statuses[n],
+					Role:       "worker",
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                 tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":       tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":               tftypes.NewValue(tftypes.String, "worker"),
+				"wait_for_installed": tftypes.NewValue(tftypes.Bool, true),
+				"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "update": tftypes.String}}, map[string]tftypes.Value{
+					"create": tftypes.NewValue(tftypes.String, nil),
+					"update": tftypes.NewValue(tftypes.String, tt.timeout),
+				}),
+			})
+
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if tt.expectError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %+v", tt.expectError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if data.Status.ValueString() != tt.statuses[len(tt.statuses)-1] {
+				t.Errorf("Expected status %s, got %s", tt.statuses[len(tt.statuses)-1], data.Status)
+			}
+		})
+	}
+}
+
+func TestHostResource_CreateDiscoversHostByMAC(t *testing.T) {
+	originalInterval := hostDiscoveryPollInterval
+	hostDiscoveryPollInterval = 5 * time.Millisecond
+	defer func() { hostDiscoveryPollInterval = originalInterval }()
+
+	ctx := context.Background()
+
+	discovered := models.Host{
+		ID:         "test-host-id",
+		InfraEnvID: "test-infra-env-id",
+		Status:     "known",
+		Role:       "auto-assign",
+		Inventory:  `{"interfaces":[{"name":"eth0","mac_address":"52:54:00:AA:BB:01"}]}`,
+	}
+
+	var lists int
+	var updated models.HostUpdateParams
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts":
+			lists++
+			// The host boots and registers between the first and second poll
+			hosts := []models.Host{{ID: "other-host-id", InfraEnvID: "test-infra-env-id", Inventory: `{"interfaces":[{"mac_address":"52:54:00:aa:bb:99"}]}`}}
+			if lists > 1 {
+				hosts = append(hosts, discovered)
+			}
+			_ = json.NewEncoder(w).Encode(hosts)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id":
+			_ = json.NewEncoder(w).Encode(discovered)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Errorf("Failed to decode update params: %v", err)
+			}
+			discovered.Role = *updated.Role
+			discovered.RequestedHostname = *updated.RequestedHostname
+			_ = json.NewEncoder(w).Encode(discovered)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &HostResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// Computed attributes are unknown in a plan for a new resource
+	values := map[string]tftypes.Value{
+		"infra_env_id":       tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"mac_address":        tftypes.NewValue(tftypes.String, "52:54:00:aa:bb:01"),
+		"requested_hostname": tftypes.NewValue(tftypes.String, "master-0"),
+		"role":               tftypes.NewValue(tftypes.String, "master"),
+	}
+	for _, name := range []string{"id", "effective_role", "host_name", "status", "status_info", "progress", "created_at", "updated_at"} {
+		values[name] = tftypes.NewValue(objType.AttributeTypes[name], tftypes.UnknownValue)
+	}
+	state := newResourceState(t, r, values)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if lists != 2 {
+		t.Errorf("Expected the host to be found on the second poll, got %d polls", lists)
+	}
+	if updated.Role == nil || *updated.Role != "master" {
+		t.Errorf("Expected role master to be set, got %+v", updated.Role)
+	}
+
+	var data HostResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "test-host-id" {
+		t.Errorf("Expected id test-host-id, got %s", data.ID)
+	}
+	if data.RequestedHostname.ValueString() != "master-0" {
+		t.Errorf("Expected requested_hostname master-0, got %s", data.RequestedHostname)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Errorf("Expected no unknown values in state, got %s", resp.State.Raw)
+	}
+}
+
+func TestHostResource_CreateDiscoveryTimeout(t *testing.T) {
+	originalInterval := hostDiscoveryPollInterval
+	hostDiscoveryPollInterval = 5 * time.Millisecond
+	defer func() { hostDiscoveryPollInterval = originalInterval }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	r := &HostResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"mac_address":  tftypes.NewValue(tftypes.String, "52:54:00:aa:bb:01"),
+		"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "update": tftypes.String}}, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, "30ms"),
+			"update": tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Host Not Discovered" {
+		t.Fatalf("Expected Host Not Discovered error, got %+v", resp.Diagnostics)
+	}
+}
+
+func TestHostResource_InstallerArgs(t *testing.T) {
+	argsValue := func(args ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(args))
+		for _, arg := range args {
+			values = append(values, tftypes.NewValue(tftypes.String, arg))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	tests := []struct {
+		name          string
+		status        string
+		currentArgs   string
+		plannedArgs   tftypes.Value
+		expectedBody  string
+		expectedState []string
+		expectError   string
+	}{
+		{
+			name:          "set on a known host",
+			status:        "known",
+			plannedArgs:   argsValue("--append-karg", "console=ttyS0"),
+			expectedBody:  `{"args":["--append-karg","console=ttyS0"]}`,
+			expectedState: []string{"--append-karg", "console=ttyS0"},
+		},
+		{
+			name:          "unchanged",
+			status:        "known",
+			currentArgs:   `["--append-karg","console=ttyS0"]`,
+			plannedArgs:   argsValue("--append-karg", "console=ttyS0"),
+			expectedState: []string{"--append-karg", "console=ttyS0"},
+		},
+		{
+			name:         "removed from configuration",
+			status:       "insufficient",
+			currentArgs:  `["--append-karg","console=ttyS0"]`,
+			plannedArgs:  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			expectedBody: `{"args":[]}`,
+		},
+		{
+			name:        "host already installing",
+			status:      "installing-in-progress",
+			plannedArgs: argsValue("--append-karg", "console=ttyS0"),
+			expectError: "before the host starts installing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			currentArgs := tt.currentArgs
+			var body string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPatch && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id/installer-args":
+					raw, _ := io.ReadAll(r.Body)
+					body = strings.TrimSpace(string(raw))
+					var params models.InstallerArgsParams
+					_ = json.Unmarshal(raw, &params)
+					currentArgs = ""
+					if len(params.Args) > 0 {
+						encoded, _ := json.Marshal(params.Args)
+						currentArgs = string(encoded)
+					}
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id":
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:            "test-host-id",
+					InfraEnvID:    "test-infra-env-id",
+					Status:        tt.status,
+					Role:          "worker",
+					InstallerArgs: currentArgs,
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":   tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":           tftypes.NewValue(tftypes.String, "worker"),
+				"installer_args": tt.plannedArgs,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if tt.expectError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %+v", tt.expectError, resp.Diagnostics)
+				}
+				if body != "" {
+					t.Errorf("Expected no installer args update, got %s", body)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if body != tt.expectedBody {
+				t.Errorf("Expected PATCH body %q, got %q", tt.expectedBody, body)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			var args []string
+			if !data.InstallerArgs.IsNull() {
+				resp.Diagnostics.Append(data.InstallerArgs.ElementsAs(ctx, &args, false)...)
+			}
+			if !slices.Equal(args, tt.expectedState) {
+				t.Errorf("Expected installer_args %v, got %s", tt.expectedState, data.InstallerArgs)
+			}
+		})
+	}
+}
+
+func TestHostResource_IgnitionConfigOverrides(t *testing.T) {
+	const override = `{"ignition": {"version": "3.2.0"}, "storage": {"files": [{"path": "/etc/motd", "contents": {"source": "data:,hello"}}]}}`
+	// The service stores the override compacted
+	const stored = `{"ignition":{"version":"3.2.0"},"storage":{"files":[{"contents":{"source":"data:,hello"},"path":"/etc/motd"}]}}`
+
+	tests := []struct {
+		name           string
+		currentConfig  string
+		plannedConfig  tftypes.Value
+		expectUpdate   bool
+		expectedConfig string
+		expectedState  string
+	}{
+		{
+			name:           "set",
+			plannedConfig:  tftypes.NewValue(tftypes.String, override),
+			expectUpdate:   true,
+			expectedConfig: override,
+			expectedState:  override,
+		},
+		{
+			name:          "unchanged but reformatted by the service",
+			currentConfig: stored,
+			plannedConfig: tftypes.NewValue(tftypes.String, override),
+			expectedState: override,
+		},
+		{
+			name:          "removed from configuration",
+			currentConfig: stored,
+			plannedConfig: tftypes.NewValue(tftypes.String, nil),
+			expectUpdate:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			currentConfig := tt.currentConfig
+			var sent *string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPatch && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id/ignition":
+					var params models.HostIgnitionParams
+					if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+						t.Errorf("Failed to decode ignition params: %v", err)
+					}
+					sent = &params.Config
+					currentConfig = ""
+					if params.Config != "" {
+						currentConfig = stored
+					}
+					w.WriteHeader(http.StatusCreated)
+					return
+				case r.Method == http.MethodGet && r.URL.Path == "/v2/infra-envs/test-infra-env-id/hosts/test-host-id":
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:                      "test-host-id",
+					InfraEnvID:              "test-infra-env-id",
+					Status:                  "known",
+					Role:                    "worker",
+					IgnitionConfigOverrides: currentConfig,
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                        tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":              tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":                      tftypes.NewValue(tftypes.String, "worker"),
+				"ignition_config_overrides": tt.plannedConfig,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			switch {
+			case !tt.expectUpdate && sent != nil:
+				t.Errorf("Expected no ignition update, got %q", *sent)
+			case tt.expectUpdate && sent == nil:
+				t.Errorf("Expected ignition update with %q, got none", tt.expectedConfig)
+			case tt.expectUpdate && *sent != tt.expectedConfig:
+				t.Errorf("Expected ignition config %q, got %q", tt.expectedConfig, *sent)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.IgnitionConfigOverrides.ValueString() != tt.expectedState {
+				t.Errorf("Expected ignition_config_overrides %q, got %s", tt.expectedState, data.IgnitionConfigOverrides)
+			}
+			if tt.expectedState == "" && !data.IgnitionConfigOverrides.IsNull() {
+				t.Errorf("Expected ignition_config_overrides to be null, got %s", data.IgnitionConfigOverrides)
+			}
+		})
+	}
+}
+
+func TestHostResource_InstallationDiskID(t *testing.T) {
+	const diskID = "/dev/disk/by-id/wwn-0x5000c500a0b1c2d3"
+
+	tests := []struct {
+		name          string
+		currentDiskID string
+		plannedDiskID tftypes.Value
+		expectPatch   bool
+		expectedState string
+	}{
+		{name: "select a disk", currentDiskID: "/dev/disk/by-id/wwn-0x5000c500a0b1c2d4", plannedDiskID: tftypes.NewValue(tftypes.String, diskID), expectPatch: true, expectedState: diskID},
+		{name: "already selected", currentDiskID: diskID, plannedDiskID: tftypes.NewValue(tftypes.String, diskID), expectedState: diskID},
+		{name: "selected by the service", currentDiskID: diskID, plannedDiskID: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), expectedState: diskID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			currentDiskID := tt.currentDiskID
+			var patched *models.HostUpdateParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts/test-host-id" {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				if r.Method == http.MethodPatch {
+					patched = &models.HostUpdateParams{}
+					if err := json.NewDecoder(r.Body).Decode(patched); err != nil {
+						t.Errorf("Failed to decode host update params: %v", err)
+					}
+					if len(patched.DisksSelectedConfig) > 0 {
+						currentDiskID = patched.DisksSelectedConfig[0].ID
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:                 "test-host-id",
+					InfraEnvID:         "test-infra-env-id",
+					Status:             "known",
+					Role:               "worker",
+					InstallationDiskID: currentDiskID,
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                   tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":         tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":                 tftypes.NewValue(tftypes.String, "worker"),
+				"installation_disk_id": tt.plannedDiskID,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if !tt.expectPatch {
+				if patched != nil {
+					t.Errorf("Expected no host update, got %+v", patched)
+				}
+			} else {
+				if patched == nil {
+					t.Fatal("Expected the installation disk to be selected")
+				}
+				expected := []models.DiskConfig{{ID: diskID, Role: "install"}}
+				if !slices.Equal(patched.DisksSelectedConfig, expected) {
+					t.Errorf("Expected disks_selected_config %+v, got %+v", expected, patched.DisksSelectedConfig)
+				}
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.InstallationDiskID.ValueString() != tt.expectedState {
+				t.Errorf("Expected installation_disk_id %s, got %s", tt.expectedState, data.InstallationDiskID)
+			}
+		})
+	}
+}
+
+func TestSkipFormattingChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		desired  []string
+		expected []models.DiskSkipFormatting
+	}{
+		{name: "unchanged", current: []string{"/dev/sdb"}, desired: []string{"/dev/sdb"}},
+		{name: "add", current: []string{}, desired: []string{"/dev/sdb"}, expected: []models.DiskSkipFormatting{{DiskID: "/dev/sdb", SkipFormatting: true}}},
+		{name: "remove", current: []string{"/dev/sdb"}, desired: nil, expected: []models.DiskSkipFormatting{{DiskID: "/dev/sdb", SkipFormatting: false}}},
+		{
+			name:    "replace",
+			current: []string{"/dev/sdb"},
+			desired: []string{"/dev/sdc"},
+			expected: []models.DiskSkipFormatting{
+				{DiskID: "/dev/sdc", SkipFormatting: true},
+				{DiskID: "/dev/sdb", SkipFormatting: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := skipFormattingChanges(tt.current, tt.desired); !slices.Equal(got, tt.expected) {
+				t.Errorf("skipFormattingChanges() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiskIDPattern(t *testing.T) {
+	for _, id := range []string{"/dev/sdb", "/dev/disk/by-id/wwn-0x5000c500a0b1c2d3", "/dev/disk/by-path/pci-0000:00:1f.2-ata-1"} {
+		if !diskIDPattern.MatchString(id) {
+			t.Errorf("Expected %q to be a valid disk ID", id)
+		}
+	}
+	for _, id := range []string{"", "sdb", "dev/sdb", "/dev/", "/dev/sdb,/dev/sdc", "/dev/sd b"} {
+		if diskIDPattern.MatchString(id) {
+			t.Errorf("Expected %q to be rejected", id)
+		}
+	}
+}
+
+func TestHostResource_SkipFormattingDisks(t *testing.T) {
+	tests := []struct {
+		name            string
+		current         string
+		planned         tftypes.Value
+		expectedChanges []models.DiskSkipFormatting
+		expectedSkip    []string
+	}{
+		{
+			name:            "skip a disk",
+			planned:         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "/dev/sdb")}),
+			expectedChanges: []models.DiskSkipFormatting{{DiskID: "/dev/sdb", SkipFormatting: true}},
+			expectedSkip:    []string{"/dev/sdb"},
+		},
+		{
+			name:         "already skipped",
+			current:      "/dev/sdb",
+			planned:      tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "/dev/sdb")}),
+			expectedSkip: []string{"/dev/sdb"},
+		},
+		{
+			name:            "removed from config",
+			current:         "/dev/sdb,/dev/sdc",
+			planned:         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			expectedChanges: []models.DiskSkipFormatting{{DiskID: "/dev/sdb", SkipFormatting: false}, {DiskID: "/dev/sdc", SkipFormatting: false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			current := tt.current
+			var patched *models.HostUpdateParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPatch {
+					patched = &models.HostUpdateParams{}
+					if err := json.NewDecoder(r.Body).Decode(patched); err != nil {
+						t.Errorf("Failed to decode host update params: %v", err)
+					}
+					disks := splitDiskList(current)
+					for _, change := range patched.DisksSkipFormatting {
+						if change.SkipFormatting {
+							disks = append(disks, change.DiskID)
+						} else {
+							disks = slices.DeleteFunc(disks, func(d string) bool { return d == change.DiskID })
+						}
+					}
+					current = strings.Join(disks, ",")
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:                  "test-host-id",
+					InfraEnvID:          "test-infra-env-id",
+					Status:              "known",
+					Role:                "worker",
+					SkipFormattingDisks: current,
+					DisksToBeFormatted:  "/dev/sda,/dev/sdb,/dev/sdc",
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":                    tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id":          tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":                  tftypes.NewValue(tftypes.String, "worker"),
+				"skip_formatting_disks": tt.planned,
+			})
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if tt.expectedChanges == nil {
+				if patched != nil {
+					t.Errorf("Expected no host update, got %+v", patched)
+				}
+			} else if patched == nil || !slices.Equal(patched.DisksSkipFormatting, tt.expectedChanges) {
+				t.Errorf("Expected disks_skip_formatting %+v, got %+v", tt.expectedChanges, patched)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			var skip []string
+			if !data.SkipFormattingDisks.IsNull() {
+				data.SkipFormattingDisks.ElementsAs(ctx, &skip, false)
+			}
+			if !slices.Equal(skip, tt.expectedSkip) {
+				t.Errorf("Expected skip_formatting_disks %v, got %v", tt.expectedSkip, skip)
+			}
+
+			var formatted []string
+			data.DisksToBeFormatted.ElementsAs(ctx, &formatted, false)
+			if !slices.Equal(formatted, []string{"/dev/sda", "/dev/sdb", "/dev/sdc"}) {
+				t.Errorf("Unexpected disks_to_be_formatted %v", formatted)
+			}
+		})
+	}
+}
+
+func TestHostResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name        string
+		importID    string
+		expectError bool
+	}{
+		{name: "composite id", importID: "test-infra-env-id/test-host-id"},
+		{name: "host id only", importID: "test-host-id", expectError: true},
+		{name: "empty infra env id", importID: "/test-host-id", expectError: true},
+		{name: "empty host id", importID: "test-infra-env-id/", expectError: true},
+		{name: "too many parts", importID: "test-infra-env-id/test-host-id/extra", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts/test-host-id" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(models.Host{
+					ID:         "test-host-id",
+					InfraEnvID: "test-infra-env-id",
+					Status:     "known",
+					Role:       "worker",
+				})
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			empty := newResourceState(t, r, nil)
+			importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: empty.Schema, Raw: tftypes.NewValue(empty.Raw.Type(), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importID}, importResp)
+
+			if tt.expectError {
+				if !importResp.Diagnostics.HasError() || importResp.Diagnostics.Errors()[0].Summary() != "Unexpected Import Identifier" {
+					t.Fatalf("Expected Unexpected Import Identifier error, got %+v", importResp.Diagnostics)
+				}
+				return
+			}
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("ImportState() returned diagnostics: %+v", importResp.Diagnostics)
+			}
+
+			readResp := &resource.ReadResponse{State: importResp.State}
+			r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", readResp.Diagnostics)
+			}
+
+			var data HostResourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			if data.InfraEnvID.ValueString() != "test-infra-env-id" || data.ID.ValueString() != "test-host-id" {
+				t.Errorf("Expected infra_env_id test-infra-env-id and id test-host-id, got %s and %s", data.InfraEnvID, data.ID)
+			}
+			if data.Status.ValueString() != "known" {
+				t.Errorf("Expected status known, got %s", data.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+const (
+	// hostRoleAutoAssign lets the service pick the role when the cluster installs
+	hostRoleAutoAssign = "auto-assign"
+	// hostRoleAcceptSuggested pins the host to the role the service suggests
+	// for it. It is a provider setting, the API never sees it.
+	hostRoleAcceptSuggested = "accept-suggested"
+)
+
+// hostRoleParam resolves the configured role to the role sent to the API:
+//   - an explicit role (master, worker, bootstrap) is sent as is, whatever the
+//     service suggests
+//   - auto-assign is sent as is, deferring the choice to the service
+//   - accept-suggested sends the suggested role, or auto-assign while the
+//     service has no suggestion yet
+func hostRoleParam(role string, host *models.Host) string {
+	if role != hostRoleAcceptSuggested {
+		return role
+	}
+	if host.SuggestedRole != "" && host.SuggestedRole != hostRoleAutoAssign {
+		return host.SuggestedRole
+	}
+	return hostRoleAutoAssign
+}
+
+// suggestedRolePending reports whether an accept-suggested host is still
+// waiting for its suggested role to be applied
+func suggestedRolePending(role types.String, host *models.Host) bool {
+	if role.ValueString() != hostRoleAcceptSuggested {
+		return false
+	}
+	return hostRoleParam(hostRoleAcceptSuggested, host) != hostRoleAutoAssign && host.Role != host.SuggestedRole
+}
+
+// hostRoleValue returns the role kept in state. auto-assign and
+// accept-suggested are kept as the configured intent once the service resolves
+// them, the resolved role is reported in effective_role.
+func hostRoleValue(configured types.String, host *models.Host) types.String {
+	switch role := configured.ValueString(); {
+	case role == hostRoleAcceptSuggested:
+		return configured
+	case host.Role != "" && role != hostRoleAutoAssign:
+		return types.StringValue(host.Role)
+	default:
+		return types.StringValue(hostRoleAutoAssign)
+	}
+}
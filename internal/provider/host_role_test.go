@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHostRoleParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		host     models.Host
+		expected string
+	}{
+		{name: "explicit role wins over suggestion", role: "worker", host: models.Host{SuggestedRole: "master"}, expected: "worker"},
+		{name: "explicit role without suggestion", role: "master", host: models.Host{}, expected: "master"},
+		{name: "auto-assign defers to the service", role: "auto-assign", host: models.Host{SuggestedRole: "master"}, expected: "auto-assign"},
+		{name: "accept-suggested applies suggestion", role: "accept-suggested", host: models.Host{Role: "auto-assign", SuggestedRole: "master"}, expected: "master"},
+		{name: "accept-suggested without suggestion", role: "accept-suggested", host: models.Host{Role: "auto-assign"}, expected: "auto-assign"},
+		{name: "accept-suggested with auto-assign suggestion", role: "accept-suggested", host: models.Host{SuggestedRole: "auto-assign"}, expected: "auto-assign"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hostRoleParam(tt.role, &tt.host); got != tt.expected {
+				t.Errorf("Expected role %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHostRoleValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		host       models.Host
+		expected   string
+	}{
+		{name: "explicit role follows the host", configured: types.StringValue("worker"), host: models.Host{Role: "master"}, expected: "master"},
+		{name: "auto-assign is kept", configured: types.StringValue("auto-assign"), host: models.Host{Role: "master"}, expected: "auto-assign"},
+		{name: "accept-suggested is kept", configured: types.StringValue("accept-suggested"), host: models.Host{Role: "master", SuggestedRole: "master"}, expected: "accept-suggested"},
+		{name: "imported host", configured: types.StringNull(), host: models.Host{Role: "worker"}, expected: "worker"},
+		{name: "no role reported", configured: types.StringValue("master"), host: models.Host{}, expected: "auto-assign"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hostRoleValue(tt.configured, &tt.host); got.ValueString() != tt.expected {
+				t.Errorf("Expected role %q, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHostResource_Update_AcceptSuggestedRole(t *testing.T) {
+	tests := []struct {
+		name          string
+		stateRole     string
+		hostRole      string
+		suggestedRole string
+		expectedPatch string
+	}{
+		{name: "switch to accept-suggested", stateRole: "auto-assign", hostRole: "auto-assign", suggestedRole: "master", expectedPatch: "master"},
+		{name: "suggestion arrived later", stateRole: "accept-suggested", hostRole: "auto-assign", suggestedRole: "worker", expectedPatch: "worker"},
+		{name: "suggestion already applied", stateRole: "accept-suggested", hostRole: "master", suggestedRole: "master"},
+		{name: "no suggestion yet", stateRole: "accept-suggested", hostRole: "auto-assign"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			host := models.Host{
+				ID:            "test-host-id",
+				InfraEnvID:    "test-infra-env-id",
+				Status:        "known",
+				Role:          tt.hostRole,
+				SuggestedRole: tt.suggestedRole,
+			}
+			var patched *models.HostUpdateParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts/test-host-id" {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				if r.Method == http.MethodPatch {
+					patched = &models.HostUpdateParams{}
+					if err := json.NewDecoder(r.Body).Decode(patched); err != nil {
+						t.Errorf("Failed to decode host update params: %v", err)
+					}
+					if patched.Role != nil {
+						host.Role = *patched.Role
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(host)
+			}))
+			defer server.Close()
+
+			r := &HostResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			values := map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "test-host-id"),
+				"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"role":         tftypes.NewValue(tftypes.String, tt.stateRole),
+			}
+			state := newResourceState(t, r, values)
+			values["role"] = tftypes.NewValue(tftypes.String, hostRoleAcceptSuggested)
+			planState := newResourceState(t, r, values)
+			plan := tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if tt.expectedPatch == "" {
+				if patched != nil {
+					t.Errorf("Expected no host update, got %+v", patched)
+				}
+			} else if patched == nil || patched.Role == nil || *patched.Role != tt.expectedPatch {
+				t.Errorf("Expected role %q to be sent, got %+v", tt.expectedPatch, patched)
+			}
+
+			var data HostResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Role.ValueString() != hostRoleAcceptSuggested {
+				t.Errorf("Expected role to stay accept-suggested, got %s", data.Role)
+			}
+			if tt.suggestedRole != "" && data.EffectiveRole.ValueString() != tt.suggestedRole {
+				t.Errorf("Expected effective_role %s, got %s", tt.suggestedRole, data.EffectiveRole)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
@@ -226,129 +225,29 @@ func (d *HostValidationsDataSource) Read(ctx context.Context, req datasource.Rea
 		}
 	}
 
-	// Convert filter arrays to string slices for comparison
-	var validationTypesFilter []string
-	if len(data.ValidationTypes) > 0 {
-		for _, vt := range data.ValidationTypes {
-			if !vt.IsNull() && !vt.IsUnknown() {
-				validationTypesFilter = append(validationTypesFilter, vt.ValueString())
-			}
-		}
-	}
-
-	var statusFilter []string
-	if len(data.StatusFilter) > 0 {
-		for _, status := range data.StatusFilter {
-			if !status.IsNull() && !status.IsUnknown() {
-				statusFilter = append(statusFilter, status.ValueString())
-			}
-		}
-	}
-
-	var validationNamesFilter []string
-	if len(data.ValidationNames) > 0 {
-		for _, name := range data.ValidationNames {
-			if !name.IsNull() && !name.IsUnknown() {
-				validationNamesFilter = append(validationNamesFilter, name.ValueString())
-			}
-		}
-	}
-
-	var categoriesFilter []string
-	if len(data.Categories) > 0 {
-		for _, category := range data.Categories {
-			if !category.IsNull() && !category.IsUnknown() {
-				categoriesFilter = append(categoriesFilter, category.ValueString())
-			}
-		}
-	}
+	filters := newValidationFilters(data.ValidationTypes, data.StatusFilter, data.ValidationNames, data.Categories)
 
 	// Process host validations and apply filters
 	var filteredValidations []HostValidationModel
 	for _, host := range hostValidations.Hosts {
-		for groupName, validationsGroup := range host.ValidationsInfo {
-			for _, validation := range validationsGroup {
-				// Determine validation type (blocking/non-blocking)
-				validationType := "non-blocking"
-				validationID := validation.ValidationID
-				if validationID == "" {
-					validationID = validation.ID
-				}
-				if models.IsBlockingValidation(validationID) {
-					validationType = "blocking"
-				}
-
-				// Apply validation type filter
-				if len(validationTypesFilter) > 0 {
-					found := false
-					for _, filterType := range validationTypesFilter {
-						if strings.EqualFold(validationType, filterType) {
-							found = true
-							break
-						}
-					}
-					if !found {
-						continue
-					}
-				}
-
-				// Apply status filter
-				if len(statusFilter) > 0 {
-					found := false
-					for _, filterStatus := range statusFilter {
-						if strings.EqualFold(validation.Status, filterStatus) {
-							found = true
-							break
-						}
-					}
-					if !found {
-						continue
-					}
+		for _, groupName := range sortedValidationGroups(host.ValidationsInfo) {
+			for _, validation := range host.ValidationsInfo[groupName] {
+				result := classifyValidation(validation)
+				if !filters.matches(validation, result) {
+					continue
 				}
 
-				// Apply validation names filter
-				if len(validationNamesFilter) > 0 {
-					found := false
-					for _, filterName := range validationNamesFilter {
-						if validationID == filterName || validation.ID == filterName {
-							found = true
-							break
-						}
-					}
-					if !found {
-						continue
-					}
-				}
-
-				// Apply categories filter
-				if len(categoriesFilter) > 0 {
-					category := string(models.GetValidationCategory(validationID))
-					found := false
-					for _, filterCategory := range categoriesFilter {
-						if strings.EqualFold(category, filterCategory) {
-							found = true
-							break
-						}
-					}
-					if !found {
-						continue
-					}
-				}
-
-				// Build validation model
-				validationModel := HostValidationModel{
+				filteredValidations = append(filteredValidations, HostValidationModel{
 					ID:              types.StringValue(validation.ID),
 					HostID:          types.StringValue(host.ID),
 					Status:          types.StringValue(validation.Status),
 					Message:         types.StringValue(validation.Message),
-					ValidationID:    types.StringValue(validationID),
+					ValidationID:    types.StringValue(result.ValidationID),
 					ValidationName:  types.StringValue(validation.ValidationName),
 					ValidationGroup: types.StringValue(groupName),
-					ValidationType:  types.StringValue(validationType),
-					Category:        types.StringValue(string(models.GetValidationCategory(validationID))),
-				}
-
-				filteredValidations = append(filteredValidations, validationModel)
+					ValidationType:  types.StringValue(result.ValidationType),
+					Category:        types.StringValue(result.Category),
+				})
 			}
 		}
 	}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HostsDataSource{}
+
+func NewHostsDataSource() datasource.DataSource {
+	return &HostsDataSource{}
+}
+
+// HostsDataSource defines the data source implementation.
+type HostsDataSource struct {
+	client *client.Client
+}
+
+// HostsDataSourceModel describes the data source data model.
+type HostsDataSourceModel struct {
+	ID         types.String     `tfsdk:"id"`
+	InfraEnvID types.String     `tfsdk:"infra_env_id"`
+	ClusterID  types.String     `tfsdk:"cluster_id"`
+	Status     types.String     `tfsdk:"status"`
+	Hosts      []HostEntryModel `tfsdk:"hosts"`
+}
+
+// HostEntryModel describes a single host discovered in an infra-env.
+type HostEntryModel struct {
+	ID                types.String `tfsdk:"id"`
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	RequestedHostname types.String `tfsdk:"requested_hostname"`
+	Status            types.String `tfsdk:"status"`
+	Role              types.String `tfsdk:"role"`
+	Inventory         types.String `tfsdk:"inventory"`
+}
+
+func (d *HostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosts"
+}
+
+func (d *HostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the hosts discovered in an infrastructure environment, optionally filtered by cluster and status. Useful to bind discovered hosts by MAC address or hostname with `for_each`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier.",
+				Computed:            true,
+			},
+			"infra_env_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the infrastructure environment to list hosts for",
+				Required:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Only return hosts bound to this cluster",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return hosts in this status (e.g. known, insufficient, installed)",
+				Optional:            true,
+			},
+			"hosts": schema.ListNestedAttribute{
+				MarkdownDescription: "Hosts discovered in the infrastructure environment",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the host (UUID)",
+							Computed:            true,
+						},
+						"cluster_id": schema.StringAttribute{
+							MarkdownDescription: "The cluster that this host is associated with",
+							Computed:            true,
+						},
+						"requested_hostname": schema.StringAttribute{
+							MarkdownDescription: "Requested hostname for this host",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current host status",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role assigned to this host (master, worker, auto-assign)",
+							Computed:            true,
+						},
+						"inventory": schema.StringAttribute{
+							MarkdownDescription: "JSON string containing hardware inventory information collected from the host",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HostsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infraEnvID := data.InfraEnvID.ValueString()
+	clusterID := data.ClusterID.ValueString()
+	status := data.Status.ValueString()
+
+	tflog.Info(ctx, "Fetching infra-env hosts", map[string]any{
+		"data_source":  "oai_hosts",
+		"infra_env_id": infraEnvID,
+		"cluster_id":   clusterID,
+		"status":       status,
+	})
+
+	hosts, err := d.client.ListHosts(ctx, infraEnvID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching hosts", fmt.Sprintf("Could not list hosts for infra-env %s: %s", infraEnvID, err))
+		return
+	}
+
+	data.Hosts = make([]HostEntryModel, 0, len(hosts))
+	for _, host := range hosts {
+		if clusterID != "" && host.ClusterID != clusterID {
+			continue
+		}
+		if status != "" && host.Status != status {
+			continue
+		}
+
+		data.Hosts = append(data.Hosts, HostEntryModel{
+			ID:                types.StringValue(host.ID),
+			ClusterID:         stringValueOrNull(host.ClusterID),
+			RequestedHostname: stringValueOrNull(host.RequestedHostname),
+			Status:            types.StringValue(host.Status),
+			Role:              stringValueOrNull(host.Role),
+			Inventory:         stringValueOrNull(host.Inventory),
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("hosts-%s", infraEnvID))
+
+	tflog.Info(ctx, "Successfully fetched infra-env hosts", map[string]any{
+		"host_count": len(data.Hosts),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// stringValueOrNull maps an empty API string to null, so unset host fields
+// read as null rather than ""
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
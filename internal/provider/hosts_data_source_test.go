@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHostsDataSource_Metadata(t *testing.T) {
+	ds := NewHostsDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	if metadataResp.TypeName != "openshift_assisted_installer_hosts" {
+		t.Errorf("Expected type name 'openshift_assisted_installer_hosts', got '%s'", metadataResp.TypeName)
+	}
+}
+
+func TestHostsDataSource_Read(t *testing.T) {
+	const inventory = `{"interfaces":[{"mac_address":"52:54:00:aa:bb:01"}]}`
+
+	tests := []struct {
+		name        string
+		clusterID   string
+		status      string
+		expectedIDs []string
+	}{
+		{name: "all hosts", expectedIDs: []string{"host-1", "host-2"}},
+		{name: "filter by cluster", clusterID: "test-cluster-id", expectedIDs: []string{"host-1"}},
+		{name: "filter by status", status: "insufficient", expectedIDs: []string{"host-2"}},
+		{name: "no match", clusterID: "test-cluster-id", status: "insufficient", expectedIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/infra-envs/test-infra-env-id/hosts" {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]models.Host{
+					{
+						ID:                "host-1",
+						InfraEnvID:        "test-infra-env-id",
+						ClusterID:         "test-cluster-id",
+						Status:            "known",
+						RequestedHostname: "master-0",
+						Role:              "master",
+						Inventory:         inventory,
+					},
+					{
+						ID:         "host-2",
+						InfraEnvID: "test-infra-env-id",
+						Status:     "insufficient",
+					},
+				})
+			}))
+			defer server.Close()
+
+			ds := &HostsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			values := map[string]tftypes.Value{
+				"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+			}
+			if tt.clusterID != "" {
+				values["cluster_id"] = tftypes.NewValue(tftypes.String, tt.clusterID)
+			}
+			if tt.status != "" {
+				values["status"] = tftypes.NewValue(tftypes.String, tt.status)
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, values)
+			ds.Read(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state HostsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			if state.ID.ValueString() != "hosts-test-infra-env-id" {
+				t.Errorf("Expected id hosts-test-infra-env-id, got %s", state.ID)
+			}
+			if len(state.Hosts) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d hosts, got %d", len(tt.expectedIDs), len(state.Hosts))
+			}
+			for i, id := range tt.expectedIDs {
+				if state.Hosts[i].ID.ValueString() != id {
+					t.Errorf("Expected host %d to be %s, got %s", i, id, state.Hosts[i].ID)
+				}
+			}
+
+			for _, host := range state.Hosts {
+				switch host.ID.ValueString() {
+				case "host-1":
+					if host.RequestedHostname.ValueString() != "master-0" || host.Role.ValueString() != "master" || host.Status.ValueString() != "known" {
+						t.Errorf("Unexpected host-1 fields: %+v", host)
+					}
+					if host.Inventory.ValueString() != inventory {
+						t.Errorf("Expected inventory %s, got %s", inventory, host.Inventory)
+					}
+				case "host-2":
+					if !host.ClusterID.IsNull() || !host.RequestedHostname.IsNull() || !host.Inventory.IsNull() {
+						t.Errorf("Expected unset host-2 fields to be null, got %+v", host)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InfraEnvEventsDataSource{}
+
+func NewInfraEnvEventsDataSource() datasource.DataSource {
+	return &InfraEnvEventsDataSource{}
+}
+
+// InfraEnvEventsDataSource defines the data source implementation.
+type InfraEnvEventsDataSource struct {
+	client *client.Client
+}
+
+// InfraEnvEventsDataSourceModel describes the data source data model.
+type InfraEnvEventsDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	InfraEnvID types.String `tfsdk:"infra_env_id"`
+	HostID     types.String `tfsdk:"host_id"`
+	Severities types.List   `tfsdk:"severities"`
+	Message    types.String `tfsdk:"message"`
+	Order      types.String `tfsdk:"order"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	Offset     types.Int64  `tfsdk:"offset"`
+	Events     []EventModel `tfsdk:"events"`
+}
+
+func (d *InfraEnvEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infra_env_events"
+}
+
+func (d *InfraEnvEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieves events for an infrastructure environment, such as discovery image generation and host registration. Useful for troubleshooting discovery before the hosts are bound to a cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for this data source instance",
+				Computed:            true,
+			},
+			"infra_env_id": schema.StringAttribute{
+				MarkdownDescription: "Infrastructure environment ID to retrieve events for",
+				Required:            true,
+			},
+			"host_id": schema.StringAttribute{
+				MarkdownDescription: "Filter events by host ID",
+				Optional:            true,
+			},
+			"severities": schema.ListAttribute{
+				MarkdownDescription: "Filter by event severities (info, warning, error, critical)",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("info", "warning", "error", "critical")),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Filter events by message pattern",
+				Optional:            true,
+			},
+			"order": schema.StringAttribute{
+				MarkdownDescription: "Order events by event_time (ascending, descending)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ascending", "descending"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of events to return",
+				Optional:            true,
+			},
+			"offset": schema.Int64Attribute{
+				MarkdownDescription: "Number of events to skip",
+				Optional:            true,
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "List of events matching the filter criteria",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Event name",
+							Computed:            true,
+						},
+						"cluster_id": schema.StringAttribute{
+							MarkdownDescription: "Cluster ID associated with this event",
+							Computed:            true,
+						},
+						"host_id": schema.StringAttribute{
+							MarkdownDescription: "Host ID associated with this event",
+							Computed:            true,
+						},
+						"infra_env_id": schema.StringAttribute{
+							MarkdownDescription: "Infrastructure environment ID associated with this event",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "Event severity (info, warning, error, critical)",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Event category (user, metrics)",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Event message",
+							Computed:            true,
+						},
+						"event_time": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the event occurred",
+							Computed:            true,
+						},
+						"request_id": schema.StringAttribute{
+							MarkdownDescription: "Request ID that caused this event",
+							Computed:            true,
+						},
+						"props": schema.StringAttribute{
+							MarkdownDescription: "Additional event properties in JSON format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InfraEnvEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InfraEnvEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InfraEnvEventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	params := make(map[string]string)
+
+	if !data.HostID.IsNull() && !data.HostID.IsUnknown() {
+		params["host_id"] = data.HostID.ValueString()
+	}
+	if !data.Message.IsNull() && !data.Message.IsUnknown() {
+		params["message"] = data.Message.ValueString()
+	}
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
+		params["order"] = data.Order.ValueString()
+	}
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() {
+		params["limit"] = fmt.Sprintf("%d", data.Limit.ValueInt64())
+	}
+	if !data.Offset.IsNull() && !data.Offset.IsUnknown() {
+		params["offset"] = fmt.Sprintf("%d", data.Offset.ValueInt64())
+	}
+
+	// The API takes list parameters as comma-separated values
+	if !data.Severities.IsNull() && !data.Severities.IsUnknown() {
+		var severities []string
+		resp.Diagnostics.Append(data.Severities.ElementsAs(ctx, &severities, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		params["severities"] = strings.Join(severities, ",")
+	}
+
+	infraEnvID := data.InfraEnvID.ValueString()
+
+	eventsResp, err := d.client.GetInfraEnvEvents(ctx, infraEnvID, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read infrastructure environment events, got error: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("infra-env-events-%s", infraEnvID))
+	data.Events = eventModels(eventsResp.Events)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
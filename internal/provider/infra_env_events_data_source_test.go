@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInfraEnvEventsDataSource_Read(t *testing.T) {
+	eventTime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	mockEvents := []models.Event{
+		{
+			Name:       "image_info_updated",
+			InfraEnvID: "test-infra-env-id",
+			Severity:   "warning",
+			Category:   "user",
+			Message:    "Discovery image generation failed, retrying",
+			EventTime:  eventTime,
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v2/events" {
+			t.Errorf("Expected GET /v2/events, got %s %s", r.Method, r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string]string{
+			"infra_env_id": "test-infra-env-id",
+			"severities":   "warning,error",
+			"order":        "descending",
+		}
+		for key, value := range expected {
+			if got := query.Get(key); got != value {
+				t.Errorf("Expected query parameter %s=%s, got %q", key, value, got)
+			}
+		}
+		if query.Has("cluster_id") {
+			t.Errorf("Expected no cluster_id query parameter, got %q", query.Get("cluster_id"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(mockEvents)
+	}))
+	defer server.Close()
+
+	ds := &InfraEnvEventsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"order":        tftypes.NewValue(tftypes.String, "descending"),
+		"severities": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "warning"),
+			tftypes.NewValue(tftypes.String, "error"),
+		}),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var result InfraEnvEventsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &result)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+
+	if result.ID.ValueString() != "infra-env-events-test-infra-env-id" {
+		t.Errorf("Expected id infra-env-events-test-infra-env-id, got %s", result.ID)
+	}
+	if len(result.Events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(result.Events))
+	}
+	event := result.Events[0]
+	if event.Name.ValueString() != "image_info_updated" || event.Severity.ValueString() != "warning" {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+	if event.InfraEnvID.ValueString() != "test-infra-env-id" {
+		t.Errorf("Expected infra_env_id test-infra-env-id, got %s", event.InfraEnvID)
+	}
+	if event.EventTime.ValueString() != "2025-06-01T12:00:00Z" {
+		t.Errorf("Expected event_time 2025-06-01T12:00:00Z, got %s", event.EventTime)
+	}
+}
+
+func TestInfraEnvEventsDataSource_Schema(t *testing.T) {
+	resp := &datasource.SchemaResponse{}
+	NewInfraEnvEventsDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", resp.Diagnostics)
+	}
+
+	infraEnvID, ok := resp.Schema.Attributes["infra_env_id"]
+	if !ok || !infraEnvID.IsRequired() {
+		t.Error("infra_env_id should be required")
+	}
+	if _, ok := resp.Schema.Attributes["cluster_id"]; ok {
+		t.Error("cluster_id should not be an argument of the infra env events data source")
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InfraEnvImageResource{}
+
+func NewInfraEnvImageResource() resource.Resource {
+	return &InfraEnvImageResource{}
+}
+
+// InfraEnvImageResource downloads the discovery ISO of an infrastructure
+// environment to a local file.
+type InfraEnvImageResource struct {
+	client *client.Client
+}
+
+// InfraEnvImageResourceModel describes the resource data model.
+type InfraEnvImageResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	InfraEnvID types.String   `tfsdk:"infra_env_id"`
+	OutputPath types.String   `tfsdk:"output_path"`
+	SizeBytes  types.Int64    `tfsdk:"size_bytes"`
+	SHA256     types.String   `tfsdk:"sha256"`
+	ExpiresAt  types.String   `tfsdk:"expires_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *InfraEnvImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infra_env_image"
+}
+
+func (r *InfraEnvImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads the discovery ISO of an infrastructure environment to a local file. The ISO is downloaded again when the infrastructure environment regenerates its image, or when the file is removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier (same as infra_env_id).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"infra_env_id": schema.StringAttribute{
+				MarkdownDescription: "Infrastructure environment ID to download the discovery ISO of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file to write the ISO to. Parent directories are created as needed. The ISO embeds the pull secret, so the file is only readable by the current user. Removed when the resource is destroyed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the downloaded ISO in bytes.",
+				Computed:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex encoded SHA-256 checksum of the downloaded ISO.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiration time of the downloaded image. When the infrastructure environment reports a different expiration, its image was regenerated and the ISO is downloaded again.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *InfraEnvImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InfraEnvImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InfraEnvImageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, client.DefaultDownloadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	infraEnvID := data.InfraEnvID.ValueString()
+	infraEnv, err := r.client.GetInfraEnv(ctx, infraEnvID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading infrastructure environment", fmt.Sprintf("Could not read infrastructure environment %s: %s", infraEnvID, err))
+		return
+	}
+	if infraEnv.DownloadURL == "" {
+		resp.Diagnostics.AddError(
+			"Discovery ISO Not Available",
+			fmt.Sprintf("Infrastructure environment %s has no download URL yet. Retry once its discovery image has been generated.", infraEnvID),
+		)
+		return
+	}
+
+	outputPath := data.OutputPath.ValueString()
+	tflog.Info(ctx, "Downloading discovery ISO", map[string]any{
+		"infra_env_id": infraEnvID,
+		"output_path":  outputPath,
+	})
+
+	expiresAt := timestampValue(infraEnv.ExpiresAt)
+	size, checksum, err := r.downloadImage(ctx, infraEnv.DownloadURL, expiresAt.ValueString(), outputPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error downloading discovery ISO", fmt.Sprintf("Could not download the discovery ISO of infrastructure environment %s to %s: %s", infraEnvID, outputPath, err))
+		return
+	}
+
+	tflog.Info(ctx, "Downloaded discovery ISO", map[string]any{
+		"infra_env_id": infraEnvID,
+		"output_path":  outputPath,
+		"size_bytes":   size,
+	})
+
+	data.ID = types.StringValue(infraEnvID)
+	data.SizeBytes = types.Int64Value(size)
+	data.SHA256 = types.StringValue(checksum)
+	data.ExpiresAt = expiresAt
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read removes the resource from state when the downloaded ISO is stale, so
+// that the next apply downloads it again: when the infrastructure environment
+// regenerated its image, or the file was removed or changed size.
+func (r *InfraEnvImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InfraEnvImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infraEnvID := data.InfraEnvID.ValueString()
+	infraEnv, err := r.client.GetInfraEnv(ctx, infraEnvID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Infrastructure environment not found", fmt.Sprintf("Infrastructure environment %s no longer exists, its discovery ISO will be removed from state", infraEnvID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading infrastructure environment", fmt.Sprintf("Could not read infrastructure environment %s: %s", infraEnvID, err))
+		return
+	}
+
+	if expiresAt := timestampValue(infraEnv.ExpiresAt); !expiresAt.Equal(data.ExpiresAt) {
+		tflog.Info(ctx, "Discovery image was regenerated, the ISO will be downloaded again", map[string]any{
+			"infra_env_id":        infraEnvID,
+			"previous_expires_at": data.ExpiresAt.ValueString(),
+			"expires_at":          expiresAt.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	outputPath := data.OutputPath.ValueString()
+	info, err := os.Stat(outputPath)
+	if err != nil || info.Size() != data.SizeBytes.ValueInt64() {
+		tflog.Info(ctx, "Downloaded discovery ISO is missing or changed, the ISO will be downloaded again", map[string]any{
+			"infra_env_id": infraEnvID,
+			"output_path":  outputPath,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only applies timeouts changes, every other argument forces a new download
+func (r *InfraEnvImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InfraEnvImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InfraEnvImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InfraEnvImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputPath := data.OutputPath.ValueString()
+	if err := os.Remove(outputPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddError("Error removing discovery ISO", fmt.Sprintf("Could not remove %s: %s", outputPath, err))
+	}
+}
+
+// partialDownload describes the image a partial file was downloaded from. It
+// is saved next to the partial file so that a later apply only resumes it
+// while the infrastructure environment serves the same image.
+type partialDownload struct {
+	Validator string `json:"validator"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// downloadImage streams the ISO to a partial file next to outputPath, which
+// replaces outputPath once complete so an interrupted download never leaves a
+// truncated ISO behind. A partial file left by an interrupted download is
+// resumed rather than fetched again, as long as the image it came from still
+// has the same expires_at and ETag or Last-Modified. It returns the size and
+// SHA-256 checksum of the ISO.
+func (r *InfraEnvImageResource) downloadImage(ctx context.Context, downloadURL, expiresAt, outputPath string) (int64, string, error) {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return 0, "", err
+	}
+
+	partialPath := filepath.Join(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".part")
+	metadataPath := partialPath + ".json"
+
+	// Without matching metadata the partial file may belong to an image that
+	// has since been regenerated, and is started over
+	var partial partialDownload
+	flags := os.O_RDWR | os.O_CREATE
+	if content, err := os.ReadFile(metadataPath); err != nil || json.Unmarshal(content, &partial) != nil || partial.ExpiresAt != expiresAt {
+		partial = partialDownload{ExpiresAt: expiresAt}
+		flags |= os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(partialPath, flags, 0o600)
+	if err != nil {
+		return 0, "", err
+	}
+	keep := false
+	defer func() {
+		_ = file.Close()
+		if !keep {
+			_ = os.Remove(partialPath)
+			_ = os.Remove(metadataPath)
+		}
+	}()
+
+	size, err := r.client.DownloadInfraEnvImage(ctx, downloadURL, file, &partial.Validator)
+	if err != nil {
+		// Keep what was streamed so the next apply picks up where this one
+		// stopped, provided the image can be recognised again
+		if errors.Is(err, client.ErrDownloadInterrupted) && partial.Validator != "" {
+			content, marshalErr := json.Marshal(partial)
+			keep = marshalErr == nil && os.WriteFile(metadataPath, content, 0o600) == nil
+		}
+		return 0, "", err
+	}
+
+	// A resumed download was written in several passes, so hash the file
+	// rather than the response bodies
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return 0, "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return 0, "", err
+	}
+	if err := os.Rename(partialPath, outputPath); err != nil {
+		return 0, "", err
+	}
+	_ = os.Remove(metadataPath)
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
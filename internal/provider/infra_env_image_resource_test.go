@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+var testImageExpiresAt = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+const testImageETag = `"test-image"`
+
+// newInfraEnvImageServer serves an infrastructure environment whose discovery
+// ISO is the given body, and requires the bearer token on the download
+func newInfraEnvImageServer(t *testing.T, body []byte) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/infra-envs/test-infra-env-id":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(models.InfraEnv{
+				ID:          "test-infra-env-id",
+				DownloadURL: server.URL + "/images/test-infra-env-id/discovery.iso",
+				ExpiresAt:   testImageExpiresAt,
+			})
+		case "/images/test-infra-env-id/discovery.iso":
+			if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+				t.Errorf("Authorization = %q, want Bearer test-token", got)
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("ETag", testImageETag)
+			http.ServeContent(w, r, "discovery.iso", time.Time{}, bytes.NewReader(body))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestInfraEnvImageResource_Create(t *testing.T) {
+	iso := []byte("CD001 fake discovery ISO contents")
+	server := newInfraEnvImageServer(t, iso)
+	defer server.Close()
+
+	r := &InfraEnvImageResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+		}),
+	}
+
+	ctx := context.Background()
+	outputPath := filepath.Join(t.TempDir(), "images", "discovery.iso")
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"output_path":  tftypes.NewValue(tftypes.String, outputPath),
+		"id":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"size_bytes":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"sha256":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"expires_at":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics: %+v", resp.Diagnostics)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read downloaded ISO: %v", err)
+	}
+	if string(content) != string(iso) {
+		t.Errorf("Downloaded ISO = %q, want %q", content, iso)
+	}
+	if info, err := os.Stat(outputPath); err == nil && info.Mode().Perm() != 0o600 {
+		t.Errorf("Downloaded ISO mode = %v, want 0600", info.Mode().Perm())
+	}
+	if leftovers, _ := filepath.Glob(filepath.Join(filepath.Dir(outputPath), ".discovery.iso.*")); len(leftovers) != 0 {
+		t.Errorf("Expected the temporary file to be renamed, found %v", leftovers)
+	}
+
+	var data InfraEnvImageResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	sum := sha256.Sum256(iso)
+	if data.SHA256.ValueString() != hex.EncodeToString(sum[:]) {
+		t.Errorf("sha256 = %s, want %s", data.SHA256.ValueString(), hex.EncodeToString(sum[:]))
+	}
+	if data.SizeBytes.ValueInt64() != int64(len(iso)) {
+		t.Errorf("size_bytes = %d, want %d", data.SizeBytes.ValueInt64(), len(iso))
+	}
+	if data.ExpiresAt.ValueString() != "2026-10-16T12:00:00Z" {
+		t.Errorf("expires_at = %s, want 2026-10-16T12:00:00Z", data.ExpiresAt.ValueString())
+	}
+	if data.ID.ValueString() != "test-infra-env-id" {
+		t.Errorf("id = %s, want test-infra-env-id", data.ID.ValueString())
+	}
+}
+
+func TestInfraEnvImageResource_Create_ResumesPartialDownload(t *testing.T) {
+	iso := []byte("CD001 fake discovery ISO contents")
+	server := newInfraEnvImageServer(t, iso)
+	defer server.Close()
+
+	// The partial file differs from the image, so a resume shows in the result
+	prefix := []byte("0123456789")
+	expiresAt := testImageExpiresAt.Format(time.RFC3339)
+
+	tests := []struct {
+		name     string
+		metadata *partialDownload
+		expected []byte
+	}{
+		{
+			name:     "same image resumes",
+			metadata: &partialDownload{Validator: testImageETag, ExpiresAt: expiresAt},
+			expected: append(append([]byte{}, prefix...), iso[len(prefix):]...),
+		},
+		{
+			name:     "unknown image restarts",
+			expected: iso,
+		},
+		{
+			name:     "expired image restarts",
+			metadata: &partialDownload{Validator: testImageETag, ExpiresAt: "2026-10-15T12:00:00Z"},
+			expected: iso,
+		},
+		{
+			name:     "regenerated image restarts",
+			metadata: &partialDownload{Validator: `"previous-image"`, ExpiresAt: expiresAt},
+			expected: iso,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &InfraEnvImageResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:     server.URL,
+					AccessToken: "test-token",
+				}),
+			}
+
+			// An earlier apply was interrupted after the first bytes
+			ctx := context.Background()
+			outputPath := filepath.Join(t.TempDir(), "discovery.iso")
+			partialPath := filepath.Join(filepath.Dir(outputPath), ".discovery.iso.part")
+			metadataPath := partialPath + ".json"
+			if err := os.WriteFile(partialPath, prefix, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if tt.metadata != nil {
+				content, err := json.Marshal(tt.metadata)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(metadataPath, content, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"output_path":  tftypes.NewValue(tftypes.String, outputPath),
+				"id":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"size_bytes":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"sha256":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"expires_at":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() diagnostics: %+v", resp.Diagnostics)
+			}
+
+			content, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("Failed to read downloaded ISO: %v", err)
+			}
+			if string(content) != string(tt.expected) {
+				t.Errorf("Downloaded ISO = %q, want %q", content, tt.expected)
+			}
+			for _, path := range []string{partialPath, metadataPath} {
+				if _, err := os.Stat(path); !os.IsNotExist(err) {
+					t.Errorf("Expected %s to be removed, got %v", filepath.Base(path), err)
+				}
+			}
+
+			var data InfraEnvImageResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			sum := sha256.Sum256(tt.expected)
+			if data.SHA256.ValueString() != hex.EncodeToString(sum[:]) {
+				t.Errorf("sha256 = %s, want %s", data.SHA256.ValueString(), hex.EncodeToString(sum[:]))
+			}
+			if data.SizeBytes.ValueInt64() != int64(len(iso)) {
+				t.Errorf("size_bytes = %d, want %d", data.SizeBytes.ValueInt64(), len(iso))
+			}
+		})
+	}
+}
+
+func TestInfraEnvImageResource_Read(t *testing.T) {
+	iso := []byte("CD001 fake discovery ISO contents")
+	server := newInfraEnvImageServer(t, iso)
+	defer server.Close()
+
+	r := &InfraEnvImageResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:     server.URL,
+			AccessToken: "test-token",
+		}),
+	}
+
+	tests := []struct {
+		name          string
+		expiresAt     string
+		writeFile     bool
+		expectRemoved bool
+	}{
+		{
+			name:      "up to date",
+			expiresAt: "2026-10-16T12:00:00Z",
+			writeFile: true,
+		},
+		{
+			name:          "image regenerated",
+			expiresAt:     "2026-10-16T08:00:00Z",
+			writeFile:     true,
+			expectRemoved: true,
+		},
+		{
+			name:          "file removed",
+			expiresAt:     "2026-10-16T12:00:00Z",
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputPath := filepath.Join(t.TempDir(), "discovery.iso")
+			if tt.writeFile {
+				if err := os.WriteFile(outputPath, iso, 0o600); err != nil {
+					t.Fatalf("Failed to write ISO: %v", err)
+				}
+			}
+
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"output_path":  tftypes.NewValue(tftypes.String, outputPath),
+				"size_bytes":   tftypes.NewValue(tftypes.Number, len(iso)),
+				"sha256":       tftypes.NewValue(tftypes.String, "checksum"),
+				"expires_at":   tftypes.NewValue(tftypes.String, tt.expiresAt),
+			})
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics: %+v", resp.Diagnostics)
+			}
+			if removed := resp.State.Raw.IsNull(); removed != tt.expectRemoved {
+				t.Errorf("Expected removed from state: %v, got %v", tt.expectRemoved, removed)
+			}
+		})
+	}
+}
+
+func TestInfraEnvImageResource_Delete(t *testing.T) {
+	r := &InfraEnvImageResource{}
+	outputPath := filepath.Join(t.TempDir(), "discovery.iso")
+	if err := os.WriteFile(outputPath, []byte("iso"), 0o600); err != nil {
+		t.Fatalf("Failed to write ISO: %v", err)
+	}
+
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"infra_env_id": tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"output_path":  tftypes.NewValue(tftypes.String, outputPath),
+	})
+
+	// Deleting twice must succeed once the file is gone
+	for i := 0; i < 2; i++ {
+		resp := &resource.DeleteResponse{State: state}
+		r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Delete() diagnostics: %+v", resp.Diagnostics)
+		}
+	}
+
+	if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed, got %v", outputPath, err)
+	}
+}
@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +31,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &InfraEnvResource{}
 var _ resource.ResourceWithImportState = &InfraEnvResource{}
+var _ resource.ResourceWithValidateConfig = &InfraEnvResource{}
+var _ resource.ResourceWithModifyPlan = &InfraEnvResource{}
 
 func NewInfraEnvResource() resource.Resource {
 	return &InfraEnvResource{}
@@ -35,25 +45,31 @@ type InfraEnvResource struct {
 
 // InfraEnvResourceModel describes the resource data model.
 type InfraEnvResourceModel struct {
-	ID                     types.String                  `tfsdk:"id"`
-	Name                   types.String                  `tfsdk:"name"`
-	ClusterID              types.String                  `tfsdk:"cluster_id"`
-	CPUArchitecture        types.String                  `tfsdk:"cpu_architecture"`
-	PullSecret             types.String                  `tfsdk:"pull_secret"`
-	SSHAuthorizedKey       types.String                  `tfsdk:"ssh_authorized_key"`
-	ImageType              types.String                  `tfsdk:"image_type"`
-	OpenShiftVersion       types.String                  `tfsdk:"openshift_version"`
-	AdditionalNTPSources   types.String                  `tfsdk:"additional_ntp_sources"`
-	AdditionalTrustBundle  types.String                  `tfsdk:"additional_trust_bundle"`
-	Proxy                  *InfraEnvProxyModel           `tfsdk:"proxy"`
-	StaticNetworkConfig    []InfraEnvStaticNetworkModel  `tfsdk:"static_network_config"`
-	KernelArguments        []InfraEnvKernelArgumentModel `tfsdk:"kernel_arguments"`
-	IgnitionConfigOverride types.String                  `tfsdk:"ignition_config_override"`
+	ID                     types.String                 `tfsdk:"id"`
+	Name                   types.String                 `tfsdk:"name"`
+	ClusterID              types.String                 `tfsdk:"cluster_id"`
+	CPUArchitecture        types.String                 `tfsdk:"cpu_architecture"`
+	PullSecret             types.String                 `tfsdk:"pull_secret"`
+	SSHAuthorizedKey       types.String                 `tfsdk:"ssh_authorized_key"`
+	SyncClusterSSHKey      types.Bool                   `tfsdk:"sync_cluster_ssh_key"`
+	ImageType              types.String                 `tfsdk:"image_type"`
+	OpenShiftVersion       types.String                 `tfsdk:"openshift_version"`
+	AdditionalNTPSources   types.String                 `tfsdk:"additional_ntp_sources"`
+	AdditionalTrustBundle  types.String                 `tfsdk:"additional_trust_bundle"`
+	Proxy                  *InfraEnvProxyModel          `tfsdk:"proxy"`
+	StaticNetworkConfig    []InfraEnvStaticNetworkModel `tfsdk:"static_network_config"`
+	KernelArguments        types.List                   `tfsdk:"kernel_arguments"`
+	IgnitionConfigOverride types.String                 `tfsdk:"ignition_config_override"`
 
 	// Computed fields
-	DownloadURL types.String `tfsdk:"download_url"`
-	ExpiresAt   types.String `tfsdk:"expires_at"`
-	Type        types.String `tfsdk:"type"`
+	DownloadURL  types.String `tfsdk:"download_url"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	ISOExpired   types.Bool   `tfsdk:"iso_expired"`
+	ISOExpiresIn types.String `tfsdk:"iso_expires_in"`
+	Type         types.String `tfsdk:"type"`
+	KernelURL    types.String `tfsdk:"kernel_url"`
+	InitrdURL    types.String `tfsdk:"initrd_url"`
+	RootfsURL    types.String `tfsdk:"rootfs_url"`
 }
 
 type InfraEnvProxyModel struct {
@@ -77,6 +93,11 @@ type InfraEnvKernelArgumentModel struct {
 	Value     types.String `tfsdk:"value"`
 }
 
+var kernelArgumentAttrTypes = map[string]attr.Type{
+	"operation": types.StringType,
+	"value":     types.StringType,
+}
+
 func (r *InfraEnvResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_infra_env"
 }
@@ -111,7 +132,7 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "CPU architecture for the infrastructure environment.",
 				Required:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("x86_64", "aarch64", "arm64", "ppc64le", "s390x", "multi"),
+					stringvalidator.OneOf(cpuArchitectures...),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -128,6 +149,12 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"sync_cluster_ssh_key": schema.BoolAttribute{
+				MarkdownDescription: "Keep `ssh_authorized_key` in sync with the `ssh_public_key` of the cluster given by `cluster_id`. When the cluster's key changes, the next plan updates the infrastructure environment, which regenerates the discovery ISO. Conflicts with `ssh_authorized_key`. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"image_type": schema.StringAttribute{
 				MarkdownDescription: "Type of discovery image to generate.",
 				Optional:            true,
@@ -160,16 +187,25 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"http_proxy": schema.StringAttribute{
-						MarkdownDescription: "HTTP proxy URL.",
+						MarkdownDescription: "HTTP proxy URL, must use `http://`.",
 						Optional:            true,
+						Validators: []validator.String{
+							validProxyURL("http"),
+						},
 					},
 					"https_proxy": schema.StringAttribute{
-						MarkdownDescription: "HTTPS proxy URL.",
+						MarkdownDescription: "HTTPS proxy URL, `http://` or `https://`.",
 						Optional:            true,
+						Validators: []validator.String{
+							validProxyURL("http", "https"),
+						},
 					},
 					"no_proxy": schema.StringAttribute{
-						MarkdownDescription: "Comma-separated list of hosts/domains to exclude from proxy.",
+						MarkdownDescription: "Comma-separated list of domains, IP addresses and CIDRs to exclude from proxy, or `*`.",
 						Optional:            true,
+						Validators: []validator.String{
+							validNoProxy(),
+						},
 					},
 				},
 			},
@@ -179,8 +215,11 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"network_yaml": schema.StringAttribute{
-							MarkdownDescription: "Network configuration in YAML format.",
+							MarkdownDescription: "Network configuration in nmstate YAML format. Must set at least one of `interfaces`, `routes` and `dns-resolver`.",
 							Required:            true,
+							Validators: []validator.String{
+								validNMState(),
+							},
 						},
 						"mac_interface_map": schema.ListNestedAttribute{
 							MarkdownDescription: "Mapping between MAC addresses and logical interface names.",
@@ -188,8 +227,11 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"mac_address": schema.StringAttribute{
-										MarkdownDescription: "MAC address of the interface.",
+										MarkdownDescription: "MAC address of the interface, such as `52:54:00:12:34:56`.",
 										Required:            true,
+										Validators: []validator.String{
+											validMACAddress(),
+										},
 									},
 									"logical_nic_name": schema.StringAttribute{
 										MarkdownDescription: "Logical name for the interface.",
@@ -202,8 +244,12 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"kernel_arguments": schema.ListNestedAttribute{
-				MarkdownDescription: "Kernel arguments to apply to discovered hosts.",
+				MarkdownDescription: "Kernel arguments to apply to discovered hosts. Reflects the kernel arguments applied by the service.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"operation": schema.StringAttribute{
@@ -221,8 +267,12 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"ignition_config_override": schema.StringAttribute{
-				MarkdownDescription: "Custom ignition configuration to override defaults.",
+				MarkdownDescription: "Custom ignition configuration to override defaults. Reflects the override applied by the service.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			// Computed attributes
@@ -234,10 +284,30 @@ func (r *InfraEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "Expiration time for the discovery ISO.",
 				Computed:            true,
 			},
+			"iso_expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the discovery ISO had expired when the infrastructure environment was last read.",
+				Computed:            true,
+			},
+			"iso_expires_in": schema.StringAttribute{
+				MarkdownDescription: "Time left until the discovery ISO expires when the infrastructure environment was last read, as a duration such as `3h59m10s`. `0s` once expired.",
+				Computed:            true,
+			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Type of the infrastructure environment.",
 				Computed:            true,
 			},
+			"kernel_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the kernel to PXE boot hosts into discovery, as listed in the iPXE script of the infrastructure environment.",
+				Computed:            true,
+			},
+			"initrd_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the initrd to PXE boot hosts into discovery, as listed in the iPXE script of the infrastructure environment.",
+				Computed:            true,
+			},
+			"rootfs_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the rootfs to PXE boot hosts into discovery, passed to the kernel as `coreos.live.rootfs_url`.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -272,6 +342,11 @@ func (r *InfraEnvResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if err := r.resolveClusterSSHKey(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error creating infrastructure environment", err.Error())
+		return
+	}
+
 	// Convert Terraform model to API model
 	createParams := r.terraformToCreateAPIModel(ctx, &data)
 	if resp.Diagnostics.HasError() {
@@ -293,6 +368,7 @@ func (r *InfraEnvResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Update model with response data
 	r.apiToTerraformModel(ctx, infraEnv, &data)
+	resp.Diagnostics.Append(r.readBootArtifacts(ctx, &data)...)
 
 	tflog.Info(ctx, "Successfully created infrastructure environment", map[string]any{
 		"infra_env_id": data.ID.ValueString(),
@@ -316,12 +392,18 @@ func (r *InfraEnvResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Get the infrastructure environment from the API
 	infraEnv, err := r.client.GetInfraEnv(ctx, data.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Infrastructure environment not found", fmt.Sprintf("Infrastructure environment %s no longer exists and will be removed from state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading infrastructure environment", fmt.Sprintf("Could not read infrastructure environment %s: %s", data.ID.ValueString(), err))
 		return
 	}
 
 	// Update model with current API state
 	r.apiToTerraformModel(ctx, infraEnv, &data)
+	resp.Diagnostics.Append(r.readBootArtifacts(ctx, &data)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -337,9 +419,25 @@ func (r *InfraEnvResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	if err := r.resolveClusterSSHKey(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error updating infrastructure environment", err.Error())
+		return
+	}
+
 	// Convert Terraform model to API model
 	updateParams := r.terraformToUpdateAPIModel(ctx, &data)
 
+	// An empty list clears the static network config, while leaving it out
+	// keeps the configuration of the service
+	var priorStaticNetworkConfig []InfraEnvStaticNetworkModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("static_network_config"), &priorStaticNetworkConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(data.StaticNetworkConfig) == 0 && len(priorStaticNetworkConfig) > 0 {
+		updateParams.StaticNetworkConfig = &[]models.HostStaticNetworkConfig{}
+	}
+
 	tflog.Info(ctx, "Updating infrastructure environment", map[string]any{
 		"infra_env_id": data.ID.ValueString(),
 		"name":         data.Name.ValueString(),
@@ -354,6 +452,7 @@ func (r *InfraEnvResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Update model with response data
 	r.apiToTerraformModel(ctx, infraEnv, &data)
+	resp.Diagnostics.Append(r.readBootArtifacts(ctx, &data)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -389,6 +488,31 @@ func (r *InfraEnvResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// readBootArtifacts sets the PXE boot artifact URLs from the iPXE script of
+// the infrastructure environment. Only PXE boot depends on them, so failing to
+// get the script is a warning and leaves the URLs null.
+func (r *InfraEnvResource) readBootArtifacts(ctx context.Context, data *InfraEnvResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.KernelURL = types.StringNull()
+	data.InitrdURL = types.StringNull()
+	data.RootfsURL = types.StringNull()
+
+	artifacts, err := r.client.GetInfraEnvBootArtifacts(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Boot Artifacts Unavailable",
+			fmt.Sprintf("Could not get the iPXE script of infrastructure environment %s, so kernel_url, initrd_url and rootfs_url are not set: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.KernelURL = stringValueOrNull(artifacts.KernelURL)
+	data.InitrdURL = stringValueOrNull(artifacts.InitrdURL)
+	data.RootfsURL = stringValueOrNull(artifacts.RootfsURL)
+	return diags
+}
+
 // Helper functions to convert between Terraform and API models
 
 func (r *InfraEnvResource) terraformToCreateAPIModel(ctx context.Context, data *InfraEnvResourceModel) *models.InfraEnvCreateParams {
@@ -414,7 +538,7 @@ func (r *InfraEnvResource) terraformToCreateAPIModel(ctx context.Context, data *
 		params.OpenshiftVersion = data.OpenShiftVersion.ValueString()
 	}
 
-	if !data.IgnitionConfigOverride.IsNull() {
+	if !data.IgnitionConfigOverride.IsNull() && !data.IgnitionConfigOverride.IsUnknown() {
 		params.IgnitionConfigOverride = data.IgnitionConfigOverride.ValueString()
 	}
 
@@ -442,35 +566,10 @@ func (r *InfraEnvResource) terraformToCreateAPIModel(ctx context.Context, data *
 	}
 
 	// Convert static network config
-	if len(data.StaticNetworkConfig) > 0 {
-		params.StaticNetworkConfig = make([]models.HostStaticNetworkConfig, len(data.StaticNetworkConfig))
-		for i, config := range data.StaticNetworkConfig {
-			params.StaticNetworkConfig[i] = models.HostStaticNetworkConfig{
-				NetworkYAML: config.NetworkYAML.ValueString(),
-			}
-
-			if len(config.MACInterfaceMap) > 0 {
-				params.StaticNetworkConfig[i].MACInterfaceMap = make([]models.MACInterfaceMapEntry, len(config.MACInterfaceMap))
-				for j, macMap := range config.MACInterfaceMap {
-					params.StaticNetworkConfig[i].MACInterfaceMap[j] = models.MACInterfaceMapEntry{
-						MACAddress:     macMap.MACAddress.ValueString(),
-						LogicalNICName: macMap.LogicalNICName.ValueString(),
-					}
-				}
-			}
-		}
-	}
+	params.StaticNetworkConfig = staticNetworkConfigToAPI(data.StaticNetworkConfig)
 
 	// Convert kernel arguments
-	if len(data.KernelArguments) > 0 {
-		params.KernelArguments = make([]models.KernelArgument, len(data.KernelArguments))
-		for i, arg := range data.KernelArguments {
-			params.KernelArguments[i] = models.KernelArgument{
-				Operation: arg.Operation.ValueString(),
-				Value:     arg.Value.ValueString(),
-			}
-		}
-	}
+	params.KernelArguments = r.kernelArgumentsFromModel(ctx, data)
 
 	return params
 }
@@ -499,7 +598,7 @@ func (r *InfraEnvResource) terraformToUpdateAPIModel(ctx context.Context, data *
 		params.ImageType = &imageType
 	}
 
-	if !data.IgnitionConfigOverride.IsNull() {
+	if !data.IgnitionConfigOverride.IsNull() && !data.IgnitionConfigOverride.IsUnknown() {
 		ignition := data.IgnitionConfigOverride.ValueString()
 		params.IgnitionConfigOverride = &ignition
 	}
@@ -530,36 +629,37 @@ func (r *InfraEnvResource) terraformToUpdateAPIModel(ctx context.Context, data *
 
 	// Convert static network config
 	if len(data.StaticNetworkConfig) > 0 {
-		params.StaticNetworkConfig = make([]models.HostStaticNetworkConfig, len(data.StaticNetworkConfig))
-		for i, config := range data.StaticNetworkConfig {
-			params.StaticNetworkConfig[i] = models.HostStaticNetworkConfig{
-				NetworkYAML: config.NetworkYAML.ValueString(),
-			}
-
-			if len(config.MACInterfaceMap) > 0 {
-				params.StaticNetworkConfig[i].MACInterfaceMap = make([]models.MACInterfaceMapEntry, len(config.MACInterfaceMap))
-				for j, macMap := range config.MACInterfaceMap {
-					params.StaticNetworkConfig[i].MACInterfaceMap[j] = models.MACInterfaceMapEntry{
-						MACAddress:     macMap.MACAddress.ValueString(),
-						LogicalNICName: macMap.LogicalNICName.ValueString(),
-					}
-				}
-			}
-		}
+		configs := staticNetworkConfigToAPI(data.StaticNetworkConfig)
+		params.StaticNetworkConfig = &configs
 	}
 
 	// Convert kernel arguments
-	if len(data.KernelArguments) > 0 {
-		params.KernelArguments = make([]models.KernelArgument, len(data.KernelArguments))
-		for i, arg := range data.KernelArguments {
-			params.KernelArguments[i] = models.KernelArgument{
-				Operation: arg.Operation.ValueString(),
-				Value:     arg.Value.ValueString(),
-			}
+	params.KernelArguments = r.kernelArgumentsFromModel(ctx, data)
+
+	return params
+}
+
+// kernelArgumentsFromModel converts the kernel_arguments list to the API model
+func (r *InfraEnvResource) kernelArgumentsFromModel(ctx context.Context, data *InfraEnvResourceModel) []models.KernelArgument {
+	if data.KernelArguments.IsNull() || data.KernelArguments.IsUnknown() {
+		return nil
+	}
+
+	var args []InfraEnvKernelArgumentModel
+	data.KernelArguments.ElementsAs(ctx, &args, false)
+	if len(args) == 0 {
+		return nil
+	}
+
+	result := make([]models.KernelArgument, len(args))
+	for i, arg := range args {
+		result[i] = models.KernelArgument{
+			Operation: arg.Operation.ValueString(),
+			Value:     arg.Value.ValueString(),
 		}
 	}
 
-	return params
+	return result
 }
 
 // normalizePEMCertificate normalizes PEM certificate content to prevent Terraform consistency errors
@@ -567,17 +667,17 @@ func normalizePEMCertificate(pemContent string) string {
 	if pemContent == "" {
 		return ""
 	}
-	
+
 	// Trim leading and trailing whitespace first
 	normalized := strings.TrimSpace(pemContent)
 	if normalized == "" {
 		return ""
 	}
-	
+
 	// Normalize line endings
 	normalized = strings.ReplaceAll(normalized, "\r\n", "\n")
 	normalized = strings.ReplaceAll(normalized, "\r", "\n")
-	
+
 	// Remove extra blank lines while preserving structure
 	lines := strings.Split(normalized, "\n")
 	var cleanLines []string
@@ -586,13 +686,13 @@ func normalizePEMCertificate(pemContent string) string {
 		trimmedLine := strings.TrimRight(line, " \t")
 		cleanLines = append(cleanLines, trimmedLine)
 	}
-	
+
 	// Rejoin and ensure single trailing newline
 	normalized = strings.Join(cleanLines, "\n")
 	if normalized != "" && !strings.HasSuffix(normalized, "\n") {
 		normalized += "\n"
 	}
-	
+
 	return normalized
 }
 
@@ -632,6 +732,7 @@ func (r *InfraEnvResource) apiToTerraformModel(ctx context.Context, infraEnv *mo
 	} else {
 		data.ExpiresAt = types.StringNull()
 	}
+	data.ISOExpired, data.ISOExpiresIn = isoExpiry(infraEnv.ExpiresAt, time.Now())
 
 	if infraEnv.AdditionalNTPSources != "" {
 		data.AdditionalNTPSources = types.StringValue(infraEnv.AdditionalNTPSources)
@@ -645,4 +746,67 @@ func (r *InfraEnvResource) apiToTerraformModel(ctx context.Context, infraEnv *mo
 	} else {
 		data.AdditionalTrustBundle = types.StringNull()
 	}
+
+	// Keep the configured ignition override when the service only reformatted the JSON
+	if infraEnv.IgnitionConfigOverride != "" {
+		if data.IgnitionConfigOverride.IsNull() || data.IgnitionConfigOverride.IsUnknown() ||
+			!jsonEquivalent(data.IgnitionConfigOverride.ValueString(), infraEnv.IgnitionConfigOverride) {
+			data.IgnitionConfigOverride = types.StringValue(infraEnv.IgnitionConfigOverride)
+		}
+	} else {
+		data.IgnitionConfigOverride = types.StringNull()
+	}
+
+	// Kernel arguments are returned as a JSON formatted string array
+	var kernelArgs []models.KernelArgument
+	if infraEnv.KernelArguments != "" {
+		if err := json.Unmarshal([]byte(infraEnv.KernelArguments), &kernelArgs); err != nil {
+			tflog.Warn(ctx, "Could not parse kernel arguments from API response", map[string]any{
+				"infra_env_id": infraEnv.ID,
+				"error":        err.Error(),
+			})
+		}
+	}
+	if len(kernelArgs) > 0 {
+		args := make([]InfraEnvKernelArgumentModel, len(kernelArgs))
+		for i, arg := range kernelArgs {
+			args[i] = InfraEnvKernelArgumentModel{
+				Operation: types.StringValue(arg.Operation),
+				Value:     types.StringValue(arg.Value),
+			}
+		}
+		listValue, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: kernelArgumentAttrTypes}, args)
+		data.KernelArguments = listValue
+	} else {
+		data.KernelArguments = types.ListNull(types.ObjectType{AttrTypes: kernelArgumentAttrTypes})
+	}
+
+	data.StaticNetworkConfig = staticNetworkConfigFromAPI(ctx, infraEnv, data.StaticNetworkConfig)
+}
+
+// isoExpiry reports whether a discovery ISO expiring at expiresAt has expired
+// at now, and the time left until it expires. Both are null for an ISO
+// without expiry.
+func isoExpiry(expiresAt, now time.Time) (types.Bool, types.String) {
+	if expiresAt.IsZero() {
+		return types.BoolNull(), types.StringNull()
+	}
+
+	remaining := expiresAt.Sub(now).Round(time.Second)
+	if remaining <= 0 {
+		return types.BoolValue(true), types.StringValue("0s")
+	}
+	return types.BoolValue(false), types.StringValue(remaining.String())
+}
+
+// jsonEquivalent reports whether two strings hold semantically identical JSON documents
+func jsonEquivalent(a, b string) bool {
+	var left, right interface{}
+	if err := json.Unmarshal([]byte(a), &left); err != nil {
+		return a == b
+	}
+	if err := json.Unmarshal([]byte(b), &right); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(left, right)
 }
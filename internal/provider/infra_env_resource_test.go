@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// testIPXEScript is an iPXE script as served by the Assisted Service
+const testIPXEScript = `#!ipxe
+initrd --name initrd https://images.example.com/images/test-infra-env-id/pxe-initrd?arch=x86_64&version=4.15
+kernel https://images.example.com/boot-artifacts/kernel?arch=x86_64&version=4.15 initrd=initrd coreos.live.rootfs_url=https://images.example.com/boot-artifacts/rootfs?arch=x86_64&version=4.15 random.trust_cpu=on
+boot
+`
+
+func TestInfraEnvResource_apiToTerraformModel_IgnitionAndKernelArgs(t *testing.T) {
+	ctx := context.Background()
+	r := &InfraEnvResource{}
+
+	configuredIgnition := `{"ignition": {"version": "3.1.0"}, "storage": {"files": []}}`
+	data := InfraEnvResourceModel{
+		IgnitionConfigOverride: StringValue(configuredIgnition),
+		KernelArguments:        types.ListUnknown(types.ObjectType{AttrTypes: kernelArgumentAttrTypes}),
+	}
+
+	infraEnv := &models.InfraEnv{
+		ID:                     "infra-env-id",
+		Name:                   "test-infra-env",
+		IgnitionConfigOverride: `{"ignition":{"version":"3.1.0"},"storage":{"files":[]}}`,
+		KernelArguments:        `[{"operation":"append","value":"console=ttyS0"},{"operation":"delete","value":"quiet"}]`,
+	}
+
+	r.apiToTerraformModel(ctx, infraEnv, &data)
+
+	// Reformatted but equivalent JSON keeps the configured value
+	if data.IgnitionConfigOverride.ValueString() != configuredIgnition {
+		t.Errorf("Expected configured ignition override to be kept, got %q", data.IgnitionConfigOverride.ValueString())
+	}
+
+	var args []InfraEnvKernelArgumentModel
+	if diags := data.KernelArguments.ElementsAs(ctx, &args, false); diags.HasError() {
+		t.Fatalf("Failed to read kernel arguments: %v", diags)
+	}
+	if len(args) != 2 {
+		t.Fatalf("Expected 2 kernel arguments, got %d", len(args))
+	}
+	if args[0].Operation.ValueString() != "append" || args[0].Value.ValueString() != "console=ttyS0" {
+		t.Errorf("Unexpected first kernel argument: %+v", args[0])
+	}
+	if args[1].Operation.ValueString() != "delete" || args[1].Value.ValueString() != "quiet" {
+		t.Errorf("Unexpected second kernel argument: %+v", args[1])
+	}
+
+	// Converting back must produce the same API arguments
+	params := r.terraformToUpdateAPIModel(ctx, &data)
+	if len(params.KernelArguments) != 2 || params.KernelArguments[0].Value != "console=ttyS0" {
+		t.Errorf("Expected kernel arguments to round-trip, got %+v", params.KernelArguments)
+	}
+	if params.IgnitionConfigOverride == nil || *params.IgnitionConfigOverride != configuredIgnition {
+		t.Errorf("Expected ignition override to round-trip, got %v", params.IgnitionConfigOverride)
+	}
+}
+
+func TestInfraEnvResource_apiToTerraformModel_StaticNetworkConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &InfraEnvResource{}
+
+	// As returned by the service, with entries reordered and YAML reformatted
+	infraEnv := &models.InfraEnv{
+		ID:   "infra-env-id",
+		Name: "test-infra-env",
+		StaticNetworkConfig: `[` +
+			`{"network_yaml":"interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n","mac_interface_map":[{"mac_address":"52:54:00:00:00:02","logical_nic_name":"eth0"}]},` +
+			`{"network_yaml":"interfaces:\n- name: eth0\n  type: ethernet\n  state: down\n","mac_interface_map":[{"mac_address":"52:54:00:00:00:01","logical_nic_name":"eth0"}]}` +
+			`]`,
+		KernelArguments: `[{"operation":"append","value":"rd.neednet=1"}]`,
+	}
+
+	t.Run("import", func(t *testing.T) {
+		var data InfraEnvResourceModel
+		r.apiToTerraformModel(ctx, infraEnv, &data)
+
+		if len(data.StaticNetworkConfig) != 2 {
+			t.Fatalf("Expected 2 static network configs, got %d", len(data.StaticNetworkConfig))
+		}
+		first := data.StaticNetworkConfig[0]
+		if first.NetworkYAML.ValueString() != "interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n" {
+			t.Errorf("Unexpected network_yaml: %q", first.NetworkYAML.ValueString())
+		}
+		if len(first.MACInterfaceMap) != 1 ||
+			first.MACInterfaceMap[0].MACAddress.ValueString() != "52:54:00:00:00:02" ||
+			first.MACInterfaceMap[0].LogicalNICName.ValueString() != "eth0" {
+			t.Errorf("Unexpected mac_interface_map: %+v", first.MACInterfaceMap)
+		}
+
+		var args []InfraEnvKernelArgumentModel
+		if diags := data.KernelArguments.ElementsAs(ctx, &args, false); diags.HasError() {
+			t.Fatalf("Failed to read kernel arguments: %v", diags)
+		}
+		if len(args) != 1 || args[0].Value.ValueString() != "rd.neednet=1" {
+			t.Errorf("Unexpected kernel arguments: %+v", args)
+		}
+	})
+
+	t.Run("equivalent configuration keeps configured entries", func(t *testing.T) {
+		configured := []InfraEnvStaticNetworkModel{
+			{
+				NetworkYAML: StringValue("interfaces:\n  - name: eth0\n    type: ethernet\n    state: down\n"),
+				MACInterfaceMap: []InfraEnvMACInterfaceModel{
+					{MACAddress: StringValue("52:54:00:00:00:01"), LogicalNICName: StringValue("eth0")},
+				},
+			},
+			{
+				NetworkYAML: StringValue("interfaces:\n  - name: eth0\n    type: ethernet\n    state: up\n"),
+				MACInterfaceMap: []InfraEnvMACInterfaceModel{
+					{MACAddress: StringValue("52:54:00:00:00:02"), LogicalNICName: StringValue("eth0")},
+				},
+			},
+		}
+		data := InfraEnvResourceModel{StaticNetworkConfig: configured}
+		r.apiToTerraformModel(ctx, infraEnv, &data)
+
+		if !reflect.DeepEqual(data.StaticNetworkConfig, configured) {
+			t.Errorf("Expected the configured static network config to be kept, got %+v", data.StaticNetworkConfig)
+		}
+	})
+
+	t.Run("out-of-band change is reported", func(t *testing.T) {
+		configured := []InfraEnvStaticNetworkModel{
+			{NetworkYAML: StringValue("interfaces:\n  - name: eth1\n")},
+		}
+		data := InfraEnvResourceModel{StaticNetworkConfig: configured}
+		r.apiToTerraformModel(ctx, infraEnv, &data)
+
+		if len(data.StaticNetworkConfig) != 2 {
+			t.Errorf("Expected the static network config of the service, got %+v", data.StaticNetworkConfig)
+		}
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		data := InfraEnvResourceModel{StaticNetworkConfig: []InfraEnvStaticNetworkModel{
+			{NetworkYAML: StringValue("interfaces:\n  - name: eth0\n")},
+		}}
+		r.apiToTerraformModel(ctx, &models.InfraEnv{ID: "infra-env-id"}, &data)
+
+		if data.StaticNetworkConfig != nil {
+			t.Errorf("Expected no static network config, got %+v", data.StaticNetworkConfig)
+		}
+	})
+}
+
+func TestInfraEnvResource_apiToTerraformModel_EffectiveValues(t *testing.T) {
+	ctx := context.Background()
+	r := &InfraEnvResource{}
+
+	data := InfraEnvResourceModel{
+		IgnitionConfigOverride: types.StringUnknown(),
+		KernelArguments:        types.ListUnknown(types.ObjectType{AttrTypes: kernelArgumentAttrTypes}),
+	}
+
+	r.apiToTerraformModel(ctx, &models.InfraEnv{ID: "infra-env-id"}, &data)
+
+	if !data.IgnitionConfigOverride.IsNull() {
+		t.Errorf("Expected null ignition override, got %v", data.IgnitionConfigOverride)
+	}
+	if !data.KernelArguments.IsNull() {
+		t.Errorf("Expected null kernel arguments, got %v", data.KernelArguments)
+	}
+
+	// Values applied by the service are surfaced even if not configured
+	r.apiToTerraformModel(ctx, &models.InfraEnv{
+		ID:                     "infra-env-id",
+		IgnitionConfigOverride: `{"ignition":{"version":"3.2.0"}}`,
+		KernelArguments:        `[{"operation":"append","value":"rd.break"}]`,
+	}, &data)
+
+	if data.IgnitionConfigOverride.ValueString() != `{"ignition":{"version":"3.2.0"}}` {
+		t.Errorf("Expected effective ignition override, got %q", data.IgnitionConfigOverride.ValueString())
+	}
+	if len(data.KernelArguments.Elements()) != 1 {
+		t.Errorf("Expected 1 effective kernel argument, got %d", len(data.KernelArguments.Elements()))
+	}
+}
+
+func TestJSONEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{`{"a": 1, "b": [1, 2]}`, `{"b":[1,2],"a":1}`, true},
+		{`{"a": 1}`, `{"a": 2}`, false},
+		{`not json`, `not json`, true},
+		{`not json`, `{"a": 1}`, false},
+	}
+
+	for _, tt := range tests {
+		if got := jsonEquivalent(tt.a, tt.b); got != tt.expected {
+			t.Errorf("jsonEquivalent(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestISOExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		expiresAt         time.Time
+		expectedExpired   types.Bool
+		expectedExpiresIn types.String
+	}{
+		{
+			name:              "no expiry",
+			expectedExpired:   types.BoolNull(),
+			expectedExpiresIn: types.StringNull(),
+		},
+		{
+			name:              "future expiry",
+			expiresAt:         now.Add(3*time.Hour + 59*time.Minute + 10*time.Second + 400*time.Millisecond),
+			expectedExpired:   types.BoolValue(false),
+			expectedExpiresIn: types.StringValue("3h59m10s"),
+		},
+		{
+			name:              "past expiry",
+			expiresAt:         now.Add(-time.Minute),
+			expectedExpired:   types.BoolValue(true),
+			expectedExpiresIn: types.StringValue("0s"),
+		},
+		{
+			name:              "expiring now",
+			expiresAt:         now,
+			expectedExpired:   types.BoolValue(true),
+			expectedExpiresIn: types.StringValue("0s"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expired, expiresIn := isoExpiry(tt.expiresAt, now)
+			if !expired.Equal(tt.expectedExpired) {
+				t.Errorf("Expected iso_expired %s, got %s", tt.expectedExpired, expired)
+			}
+			if !expiresIn.Equal(tt.expectedExpiresIn) {
+				t.Errorf("Expected iso_expires_in %s, got %s", tt.expectedExpiresIn, expiresIn)
+			}
+		})
+	}
+}
+
+func TestInfraEnvResource_apiToTerraformModel_ISOExpiry(t *testing.T) {
+	r := &InfraEnvResource{}
+
+	var data InfraEnvResourceModel
+	r.apiToTerraformModel(context.Background(), &models.InfraEnv{ID: "infra-env-id", ExpiresAt: time.Now().Add(-time.Hour)}, &data)
+	if !data.ISOExpired.ValueBool() || data.ISOExpiresIn.ValueString() != "0s" {
+		t.Errorf("Expected an expired ISO, got iso_expired %s and iso_expires_in %s", data.ISOExpired, data.ISOExpiresIn)
+	}
+
+	r.apiToTerraformModel(context.Background(), &models.InfraEnv{ID: "infra-env-id", ExpiresAt: time.Now().Add(4 * time.Hour)}, &data)
+	if data.ISOExpired.IsNull() || data.ISOExpired.ValueBool() {
+		t.Errorf("Expected a valid ISO, got iso_expired %s", data.ISOExpired)
+	}
+	if remaining, err := time.ParseDuration(data.ISOExpiresIn.ValueString()); err != nil || remaining <= 3*time.Hour || remaining > 4*time.Hour {
+		t.Errorf("Expected about 4h until expiry, got %s", data.ISOExpiresIn)
+	}
+}
+
+func TestInfraEnvResource_Read_BootArtifacts(t *testing.T) {
+	tests := []struct {
+		name           string
+		scriptStatus   int
+		expectedKernel types.String
+		expectedInitrd types.String
+		expectedRootfs types.String
+		expectWarning  bool
+	}{
+		{
+			name:           "iPXE script",
+			scriptStatus:   http.StatusOK,
+			expectedKernel: types.StringValue("https://images.example.com/boot-artifacts/kernel?arch=x86_64&version=4.15"),
+			expectedInitrd: types.StringValue("https://images.example.com/images/test-infra-env-id/pxe-initrd?arch=x86_64&version=4.15"),
+			expectedRootfs: types.StringValue("https://images.example.com/boot-artifacts/rootfs?arch=x86_64&version=4.15"),
+		},
+		{
+			name:           "iPXE script unavailable",
+			scriptStatus:   http.StatusNotFound,
+			expectedKernel: types.StringNull(),
+			expectedInitrd: types.StringNull(),
+			expectedRootfs: types.StringNull(),
+			expectWarning:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v2/infra-envs/test-infra-env-id":
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(models.InfraEnv{
+						ID:              "test-infra-env-id",
+						Name:            "test-infra-env",
+						CPUArchitecture: "x86_64",
+					})
+				case "/v2/infra-envs/test-infra-env-id/downloads/files":
+					if got := r.URL.Query().Get("file_name"); got != "ipxe-script" {
+						t.Errorf("Expected file_name=ipxe-script, got %q", got)
+					}
+					w.WriteHeader(tt.scriptStatus)
+					if tt.scriptStatus == http.StatusOK {
+						_, _ = w.Write([]byte(testIPXEScript))
+					}
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			r := &InfraEnvResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+			ctx := context.Background()
+			state := newResourceState(t, r, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+				"name": tftypes.NewValue(tftypes.String, "test-infra-env"),
+			})
+			resp := &resource.ReadResponse{State: state}
+
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics: %+v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.expectWarning {
+				t.Errorf("Expected warning: %v, got diagnostics: %+v", tt.expectWarning, resp.Diagnostics)
+			}
+
+			var data InfraEnvResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if !data.KernelURL.Equal(tt.expectedKernel) {
+				t.Errorf("kernel_url = %s, want %s", data.KernelURL, tt.expectedKernel)
+			}
+			if !data.InitrdURL.Equal(tt.expectedInitrd) {
+				t.Errorf("initrd_url = %s, want %s", data.InitrdURL, tt.expectedInitrd)
+			}
+			if !data.RootfsURL.Equal(tt.expectedRootfs) {
+				t.Errorf("rootfs_url = %s, want %s", data.RootfsURL, tt.expectedRootfs)
+			}
+		})
+	}
+}
+
+func TestInfraEnvResource_Schema_KeepsAppliedOverrides(t *testing.T) {
+	ctx := context.Background()
+	r := &InfraEnvResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := newResourceState(t, r, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test-infra-env"),
+	})
+
+	// Unconfigured, the attributes keep what the service applied instead of
+	// being planned as unknown on every update
+	ignition := `{"ignition":{"version":"3.1.0"}}`
+	stringResp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	for _, modifier := range schemaResp.Schema.Attributes["ignition_config_override"].(schema.StringAttribute).PlanModifiers {
+		modifier.PlanModifyString(ctx, planmodifier.StringRequest{
+			ConfigValue: types.StringNull(),
+			PlanValue:   stringResp.PlanValue,
+			State:       state,
+			StateValue:  types.StringValue(ignition),
+		}, stringResp)
+	}
+	if stringResp.PlanValue.ValueString() != ignition {
+		t.Errorf("Expected ignition_config_override to be planned from state, got %s", stringResp.PlanValue)
+	}
+
+	kernelArgs := types.ListValueMust(types.ObjectType{AttrTypes: kernelArgumentAttrTypes}, []attr.Value{
+		types.ObjectValueMust(kernelArgumentAttrTypes, map[string]attr.Value{
+			"operation": types.StringValue("append"),
+			"value":     types.StringValue("rd.debug"),
+		}),
+	})
+	listResp := &planmodifier.ListResponse{PlanValue: types.ListUnknown(kernelArgs.ElementType(ctx))}
+	for _, modifier := range schemaResp.Schema.Attributes["kernel_arguments"].(schema.ListNestedAttribute).PlanModifiers {
+		modifier.PlanModifyList(ctx, planmodifier.ListRequest{
+			ConfigValue: types.ListNull(kernelArgs.ElementType(ctx)),
+			PlanValue:   listResp.PlanValue,
+			State:       state,
+			StateValue:  kernelArgs,
+		}, listResp)
+	}
+	if !listResp.PlanValue.Equal(kernelArgs) {
+		t.Errorf("Expected kernel_arguments to be planned from state, got %s", listResp.PlanValue)
+	}
+}
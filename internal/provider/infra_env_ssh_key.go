@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ValidateConfig requires a cluster to take the SSH key from when
+// sync_cluster_ssh_key is set
+func (r *InfraEnvResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var sync types.Bool
+	var clusterID, sshKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sync_cluster_ssh_key"), &sync)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_id"), &clusterID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssh_authorized_key"), &sshKey)...)
+	if resp.Diagnostics.HasError() || !sync.ValueBool() {
+		return
+	}
+
+	if clusterID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sync_cluster_ssh_key"),
+			"Missing Cluster",
+			"\"sync_cluster_ssh_key\" requires \"cluster_id\" to be set.",
+		)
+	}
+	if !sshKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_authorized_key"),
+			"Conflicting SSH Key Configuration",
+			"\"ssh_authorized_key\" cannot be set together with \"sync_cluster_ssh_key\", the key is taken from the cluster.",
+		)
+	}
+}
+
+// ModifyPlan compares the SSH key of the infrastructure environment with the
+// key of its cluster. Hosts booted from a discovery ISO with a stale key
+// cannot be reached, so with sync_cluster_ssh_key the cluster's key is
+// planned, which regenerates the ISO. Otherwise a differing key is reported
+// as a warning.
+func (r *InfraEnvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the infrastructure environment is destroyed
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data InfraEnvResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync := data.SyncClusterSSHKey.ValueBool()
+
+	// The key is read from the cluster on apply once it exists
+	if data.ClusterID.IsNull() || data.ClusterID.IsUnknown() {
+		if sync {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ssh_authorized_key"), types.StringUnknown())...)
+		}
+		return
+	}
+
+	if r.client.Offline() {
+		resp.Diagnostics.AddWarning(
+			"Cluster SSH Key Not Checked",
+			fmt.Sprintf("The provider is in offline mode, so the SSH key of cluster %s was not compared with \"ssh_authorized_key\".", data.ClusterID.ValueString()),
+		)
+		return
+	}
+
+	cluster, err := r.client.GetCluster(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		if !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning(
+				"Could not check cluster SSH key",
+				fmt.Sprintf("Could not get cluster %s to compare its SSH key: %s", data.ClusterID.ValueString(), err),
+			)
+		}
+		return
+	}
+
+	clusterKey := strings.TrimSpace(cluster.SSHPublicKey)
+	if clusterKey == "" || strings.TrimSpace(data.SSHAuthorizedKey.ValueString()) == clusterKey {
+		return
+	}
+
+	if sync {
+		tflog.Info(ctx, "Cluster SSH key changed, updating infrastructure environment", map[string]any{
+			"infra_env_id": data.ID.ValueString(),
+			"cluster_id":   data.ClusterID.ValueString(),
+		})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ssh_authorized_key"), cluster.SSHPublicKey)...)
+		return
+	}
+
+	// Only warn about a key that is not being changed by this plan, a new key
+	// may come from a cluster change that is not applied yet
+	if req.State.Raw.IsNull() || data.SSHAuthorizedKey.IsNull() || data.SSHAuthorizedKey.IsUnknown() {
+		return
+	}
+	var priorKey types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ssh_authorized_key"), &priorKey)...)
+	if priorKey.Equal(data.SSHAuthorizedKey) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("ssh_authorized_key"),
+			"SSH Key Differs From Cluster",
+			fmt.Sprintf("The SSH key of this infrastructure environment differs from the SSH key of cluster %s. Hosts booted from its discovery ISO "+
+				"cannot be reached with the cluster's key. Set \"sync_cluster_ssh_key\" to keep the keys in sync.", data.ClusterID.ValueString()),
+		)
+	}
+}
+
+// resolveClusterSSHKey sets the SSH key from the cluster when it could not be
+// planned because the cluster did not exist yet
+func (r *InfraEnvResource) resolveClusterSSHKey(ctx context.Context, data *InfraEnvResourceModel) error {
+	if !data.SyncClusterSSHKey.ValueBool() || !data.SSHAuthorizedKey.IsUnknown() {
+		return nil
+	}
+
+	cluster, err := r.client.GetCluster(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		return fmt.Errorf("could not get the SSH key of cluster %s: %w", data.ClusterID.ValueString(), err)
+	}
+
+	data.SSHAuthorizedKey = stringValueOrNull(cluster.SSHPublicKey)
+	return nil
+}
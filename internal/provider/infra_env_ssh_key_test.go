@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	oldClusterSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOld old@example.com"
+	newClusterSSHKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINew new@example.com"
+)
+
+// newInfraEnvSSHKeyServer serves a cluster with the given SSH key and
+// regenerates the discovery ISO on infrastructure environment updates
+func newInfraEnvSSHKeyServer(t *testing.T, clusterKey string, updated *models.InfraEnvUpdateParams) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id":
+			_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id", SSHPublicKey: clusterKey})
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/infra-envs/test-infra-env-id":
+			if err := json.NewDecoder(r.Body).Decode(updated); err != nil {
+				t.Errorf("Failed to decode infra env update: %v", err)
+			}
+			_ = json.NewEncoder(w).Encode(models.InfraEnv{
+				ID:               "test-infra-env-id",
+				Name:             "test-infra-env",
+				ClusterID:        "test-cluster-id",
+				CPUArchitecture:  "x86_64",
+				SSHAuthorizedKey: *updated.SSHAuthorizedKey,
+				DownloadURL:      "https://example.com/images/regenerated.iso",
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/infra-envs/test-infra-env-id/downloads/files":
+			_, _ = w.Write([]byte(testIPXEScript))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newInfraEnvSSHKeyState(t *testing.T, r *InfraEnvResource, sshKey string, sync bool) tfsdk.State {
+	t.Helper()
+
+	return newResourceState(t, r, map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "test-infra-env-id"),
+		"name":                 tftypes.NewValue(tftypes.String, "test-infra-env"),
+		"cluster_id":           tftypes.NewValue(tftypes.String, "test-cluster-id"),
+		"cpu_architecture":     tftypes.NewValue(tftypes.String, "x86_64"),
+		"pull_secret":          tftypes.NewValue(tftypes.String, "pull-secret"),
+		"ssh_authorized_key":   tftypes.NewValue(tftypes.String, sshKey),
+		"sync_cluster_ssh_key": tftypes.NewValue(tftypes.Bool, sync),
+		"download_url":         tftypes.NewValue(tftypes.String, "https://example.com/images/discovery.iso"),
+	})
+}
+
+func TestInfraEnvResource_ModifyPlan_ClusterSSHKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		stateKey      string
+		sync          bool
+		expectedKey   string
+		expectWarning bool
+	}{
+		{
+			name:        "synced key follows the cluster",
+			stateKey:    oldClusterSSHKey,
+			sync:        true,
+			expectedKey: newClusterSSHKey,
+		},
+		{
+			name:          "unsynced stale key warns",
+			stateKey:      oldClusterSSHKey,
+			expectedKey:   oldClusterSSHKey,
+			expectWarning: true,
+		},
+		{
+			name:        "unsynced matching key",
+			stateKey:    newClusterSSHKey,
+			expectedKey: newClusterSSHKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := newInfraEnvSSHKeyServer(t, newClusterSSHKey, nil)
+			defer server.Close()
+
+			r := &InfraEnvResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newInfraEnvSSHKeyState(t, r, tt.stateKey, tt.sync)
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var data InfraEnvResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+			if data.SSHAuthorizedKey.ValueString() != tt.expectedKey {
+				t.Errorf("Expected planned ssh_authorized_key %q, got %q", tt.expectedKey, data.SSHAuthorizedKey.ValueString())
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if tt.expectWarning {
+				if len(warnings) != 1 || warnings[0].Summary() != "SSH Key Differs From Cluster" {
+					t.Errorf("Expected a stale SSH key warning, got %+v", warnings)
+				}
+			} else if len(warnings) != 0 {
+				t.Errorf("Expected no warnings, got %+v", warnings)
+			}
+		})
+	}
+}
+
+func TestInfraEnvResource_Update_ClusterSSHKey(t *testing.T) {
+	ctx := context.Background()
+
+	var updated models.InfraEnvUpdateParams
+	server := newInfraEnvSSHKeyServer(t, newClusterSSHKey, &updated)
+	defer server.Close()
+
+	r := &InfraEnvResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	// The plan carries the cluster's new key after ModifyPlan
+	state := newInfraEnvSSHKeyState(t, r, oldClusterSSHKey, true)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	modifyResp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, modifyResp)
+	if modifyResp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() returned diagnostics: %+v", modifyResp.Diagnostics)
+	}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: modifyResp.Plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if updated.SSHAuthorizedKey == nil || *updated.SSHAuthorizedKey != newClusterSSHKey {
+		t.Errorf("Expected the cluster's new key to be sent, got %v", updated.SSHAuthorizedKey)
+	}
+
+	var data InfraEnvResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.DownloadURL.ValueString() != "https://example.com/images/regenerated.iso" {
+		t.Errorf("Expected the regenerated ISO URL, got %s", data.DownloadURL.ValueString())
+	}
+}
+
+func TestInfraEnvResource_ValidateConfig_SyncClusterSSHKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError string
+	}{
+		{
+			name: "sync with cluster",
+			values: map[string]tftypes.Value{
+				"cluster_id":           tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"sync_cluster_ssh_key": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		{
+			name: "sync without cluster",
+			values: map[string]tftypes.Value{
+				"sync_cluster_ssh_key": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: "Missing Cluster",
+		},
+		{
+			name: "sync with own key",
+			values: map[string]tftypes.Value{
+				"cluster_id":           tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"ssh_authorized_key":   tftypes.NewValue(tftypes.String, oldClusterSSHKey),
+				"sync_cluster_ssh_key": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: "Conflicting SSH Key Configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &InfraEnvResource{}
+			state := newResourceState(t, r, tt.values)
+			config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+			if tt.expectError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("Expected no error, got %+v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.expectError {
+				t.Errorf("Expected error %q, got %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInfraEnvResource_ModifyPlan_Offline(t *testing.T) {
+	ctx := context.Background()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	r := &InfraEnvResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+			Offline:      true,
+		}),
+	}
+
+	state := newInfraEnvSSHKeyState(t, r, oldClusterSSHKey, true)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if requests != 0 {
+		t.Errorf("Expected no API requests in offline mode, got %d", requests)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Cluster SSH Key Not Checked" {
+		t.Errorf("Expected an offline mode warning, got %+v", warnings)
+	}
+
+	var data InfraEnvResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+	if data.SSHAuthorizedKey.ValueString() != oldClusterSSHKey {
+		t.Errorf("Expected the planned key to be left unchanged, got %q", data.SSHAuthorizedKey.ValueString())
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// staticNetworkConfigToAPI converts the static_network_config entries to the API model
+func staticNetworkConfigToAPI(configs []InfraEnvStaticNetworkModel) []models.HostStaticNetworkConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	result := make([]models.HostStaticNetworkConfig, len(configs))
+	for i, config := range configs {
+		result[i] = models.HostStaticNetworkConfig{
+			NetworkYAML: config.NetworkYAML.ValueString(),
+		}
+
+		if len(config.MACInterfaceMap) > 0 {
+			result[i].MACInterfaceMap = make([]models.MACInterfaceMapEntry, len(config.MACInterfaceMap))
+			for j, macMap := range config.MACInterfaceMap {
+				result[i].MACInterfaceMap[j] = models.MACInterfaceMapEntry{
+					MACAddress:     macMap.MACAddress.ValueString(),
+					LogicalNICName: macMap.LogicalNICName.ValueString(),
+				}
+			}
+		}
+	}
+	return result
+}
+
+// staticNetworkConfigFromAPI converts the static network configuration the
+// service returns, a JSON formatted array, to the resource model. The service
+// may reorder the entries and reformat their YAML, so the configured entries
+// are kept when they describe the same configuration.
+func staticNetworkConfigFromAPI(ctx context.Context, infraEnv *models.InfraEnv, configured []InfraEnvStaticNetworkModel) []InfraEnvStaticNetworkModel {
+	var configs []models.HostStaticNetworkConfig
+	if infraEnv.StaticNetworkConfig != "" {
+		if err := json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &configs); err != nil {
+			tflog.Warn(ctx, "Could not parse static network config from API response", map[string]any{
+				"infra_env_id": infraEnv.ID,
+				"error":        err.Error(),
+			})
+			return configured
+		}
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+
+	result := make([]InfraEnvStaticNetworkModel, len(configs))
+	for i, config := range configs {
+		result[i] = InfraEnvStaticNetworkModel{
+			NetworkYAML: types.StringValue(config.NetworkYAML),
+		}
+		for _, entry := range config.MACInterfaceMap {
+			result[i].MACInterfaceMap = append(result[i].MACInterfaceMap, InfraEnvMACInterfaceModel{
+				MACAddress:     types.StringValue(entry.MACAddress),
+				LogicalNICName: types.StringValue(entry.LogicalNICName),
+			})
+		}
+	}
+
+	if staticNetworkConfigEquivalent(configured, result) {
+		return configured
+	}
+	return result
+}
+
+// staticNetworkConfigEquivalent reports whether two static network
+// configurations hold the same entries in any order, comparing the YAML by
+// content and the MAC addresses case-insensitively
+func staticNetworkConfigEquivalent(a, b []InfraEnvStaticNetworkModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	remaining := slices.Clone(b)
+	for _, left := range a {
+		index := slices.IndexFunc(remaining, func(right InfraEnvStaticNetworkModel) bool {
+			return yamlEquivalent(left.NetworkYAML.ValueString(), right.NetworkYAML.ValueString()) &&
+				macInterfaceMapKey(left.MACInterfaceMap) == macInterfaceMapKey(right.MACInterfaceMap)
+		})
+		if index < 0 {
+			return false
+		}
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+	return true
+}
+
+// macInterfaceMapKey returns a key identifying a MAC interface map regardless
+// of the order of its entries and the case of the MAC addresses
+func macInterfaceMapKey(entries []InfraEnvMACInterfaceModel) string {
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = strings.ToLower(entry.MACAddress.ValueString()) + "=" + entry.LogicalNICName.ValueString()
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+// yamlEquivalent reports whether two YAML documents hold the same content
+func yamlEquivalent(a, b string) bool {
+	var left, right interface{}
+	if err := yaml.Unmarshal([]byte(a), &left); err != nil {
+		return a == b
+	}
+	if err := yaml.Unmarshal([]byte(b), &right); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(left, right)
+}
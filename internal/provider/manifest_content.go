@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// manifestContentAttributes are the mutually exclusive ways to provide the
+// content of a manifest
+var manifestContentAttributes = []string{"content", "content_base64", "source_path"}
+
+// resolveManifestContent returns the decoded manifest content from whichever
+// of content, content_base64 or source_path is set. Content that is already
+// base64-encoded is decoded, so configurations written when content had to be
+// pre-encoded keep working.
+func resolveManifestContent(data ManifestResourceModel) ([]byte, error) {
+	var content []byte
+
+	switch {
+	case !data.ContentBase64.IsNull():
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data.ContentBase64.ValueString()))
+		if err != nil {
+			return nil, fmt.Errorf("content_base64 is not valid base64: %w", err)
+		}
+		content = decoded
+	case !data.SourcePath.IsNull():
+		raw, err := os.ReadFile(data.SourcePath.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not read source_path: %w", err)
+		}
+		content = raw
+	default:
+		content = []byte(data.Content.ValueString())
+		if decoded, ok := decodeBase64Manifest(data.Content.ValueString()); ok {
+			content = decoded
+		}
+	}
+
+	if strings.TrimSpace(string(content)) == "" {
+		return nil, fmt.Errorf("manifest content cannot be empty")
+	}
+
+	return content, nil
+}
+
+// decodeBase64Manifest decodes content that is a single base64 string. YAML
+// and JSON manifests always contain characters outside the base64 alphabet
+// such as ':' or '{', so raw manifests are never mistaken for base64.
+func decodeBase64Manifest(content string) ([]byte, bool) {
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" || strings.ContainsAny(trimmed, " \t\r\n") {
+		return nil, false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(trimmed)
+	if err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// manifestContentSHA256 returns the hex encoded SHA-256 of the decoded content
+func manifestContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeManifestContent returns content with line endings and surrounding
+// whitespace normalized, so that content the service stores differently is
+// not reported as drift
+func normalizeManifestContent(content []byte) string {
+	return strings.TrimSpace(strings.ReplaceAll(string(content), "\r\n", "\n"))
+}
+
+// applyServerManifestContent compares the content stored on the service with
+// the state and records drift. The content is kept in the attribute it was
+// configured with, and content_sha256 changes so that the next plan updates
+// the manifest. It reports whether the content drifted.
+func applyServerManifestContent(data *ManifestResourceModel, serverContent []byte) bool {
+	if decoded, ok := decodeBase64Manifest(string(serverContent)); ok {
+		serverContent = decoded
+	}
+
+	// A source_path is compared through the SHA-256 only, the file may have
+	// changed since the last apply
+	if data.SourcePath.IsNull() && (!data.Content.IsNull() || !data.ContentBase64.IsNull()) {
+		if content, err := resolveManifestContent(*data); err == nil &&
+			normalizeManifestContent(content) == normalizeManifestContent(serverContent) {
+			return false
+		}
+	} else if data.ContentSHA256.ValueString() == manifestContentSHA256(serverContent) {
+		return false
+	}
+
+	switch {
+	case !data.ContentBase64.IsNull():
+		data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(serverContent))
+	case data.SourcePath.IsNull():
+		// Imported manifests have no content yet
+		data.Content = types.StringValue(string(serverContent))
+	}
+	data.ContentSHA256 = types.StringValue(manifestContentSHA256(serverContent))
+
+	return true
+}
+
+// ValidateConfig requires exactly one source of manifest content
+func (r *ManifestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set []string
+	for _, name := range manifestContentAttributes {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// An unknown value will be set once known
+		if !value.IsNull() {
+			set = append(set, name)
+		}
+	}
+
+	if len(set) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Manifest Content",
+			fmt.Sprintf("Exactly one of \"content\", \"content_base64\" or \"source_path\" must be set, got %d.", len(set)),
+		)
+	}
+}
+
+// ModifyPlan computes content_sha256 from the planned content so that a
+// changed file at source_path shows up as a diff although its path did not
+// change
+func (r *ManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the manifest is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ManifestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsUnknown() || data.ContentBase64.IsUnknown() || data.SourcePath.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringUnknown())...)
+		return
+	}
+
+	content, err := resolveManifestContent(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid manifest content", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), manifestContentSHA256(content))...)
+}
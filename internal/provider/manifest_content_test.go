@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testManifestYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: custom-config
+  namespace: openshift-config
+`
+
+func writeTestManifest(t *testing.T, content string) string {
+	t.Helper()
+
+	sourcePath := filepath.Join(t.TempDir(), "manifest.yaml")
+	if err := os.WriteFile(sourcePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	return sourcePath
+}
+
+func TestResolveManifestContent(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testManifestYAML))
+
+	tests := []struct {
+		name        string
+		data        func(t *testing.T) ManifestResourceModel
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "raw yaml",
+			data:     func(t *testing.T) ManifestResourceModel { return manifestModel(testManifestYAML, "", "") },
+			expected: testManifestYAML,
+		},
+		{
+			name: "raw json",
+			data: func(t *testing.T) ManifestResourceModel {
+				return manifestModel(`{"apiVersion":"v1","kind":"Namespace"}`, "", "")
+			},
+			expected: `{"apiVersion":"v1","kind":"Namespace"}`,
+		},
+		{
+			name:     "pre-encoded content",
+			data:     func(t *testing.T) ManifestResourceModel { return manifestModel(encoded+"\n", "", "") },
+			expected: testManifestYAML,
+		},
+		{
+			name:     "content_base64",
+			data:     func(t *testing.T) ManifestResourceModel { return manifestModel("", encoded, "") },
+			expected: testManifestYAML,
+		},
+		{
+			name: "source_path",
+			data: func(t *testing.T) ManifestResourceModel {
+				return manifestModel("", "", writeTestManifest(t, testManifestYAML))
+			},
+			expected: testManifestYAML,
+		},
+		{
+			name:        "empty content",
+			data:        func(t *testing.T) ManifestResourceModel { return manifestModel("  \n", "", "") },
+			expectError: "cannot be empty",
+		},
+		{
+			name: "empty decoded content",
+			data: func(t *testing.T) ManifestResourceModel {
+				return manifestModel("", base64.StdEncoding.EncodeToString([]byte("\n")), "")
+			},
+			expectError: "cannot be empty",
+		},
+		{
+			name:        "invalid content_base64",
+			data:        func(t *testing.T) ManifestResourceModel { return manifestModel("", "not base64!", "") },
+			expectError: "not valid base64",
+		},
+		{
+			name: "missing file",
+			data: func(t *testing.T) ManifestResourceModel {
+				return manifestModel("", "", filepath.Join(t.TempDir(), "missing.yaml"))
+			},
+			expectError: "could not read source_path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := resolveManifestContent(tt.data(t))
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveManifestContent() error = %v", err)
+			}
+			if string(content) != tt.expected {
+				t.Errorf("Expected content %q, got %q", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestManifestResource_Create_Content(t *testing.T) {
+	tests := []struct {
+		name   string
+		values func(t *testing.T) map[string]tftypes.Value
+	}{
+		{
+			name: "raw content",
+			values: func(t *testing.T) map[string]tftypes.Value {
+				return map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, testManifestYAML)}
+			},
+		},
+		{
+			name: "source_path",
+			values: func(t *testing.T) map[string]tftypes.Value {
+				return map[string]tftypes.Value{"source_path": tftypes.NewValue(tftypes.String, writeTestManifest(t, testManifestYAML))}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var created *models.CreateManifestParams
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPost:
+					created = &models.CreateManifestParams{}
+					if err := json.NewDecoder(r.Body).Decode(created); err != nil {
+						t.Errorf("Failed to decode manifest: %v", err)
+					}
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`{}`))
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode([]models.Manifest{{FileName: "custom-config.yaml", Folder: "manifests", ManifestSource: "user"}})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			r := &ManifestResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			values := tt.values(t)
+			values["cluster_id"] = tftypes.NewValue(tftypes.String, "test-cluster-id")
+			values["file_name"] = tftypes.NewValue(tftypes.String, "custom-config.yaml")
+			values["folder"] = tftypes.NewValue(tftypes.String, "manifests")
+			state := newResourceState(t, r, values)
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if created == nil {
+				t.Fatal("Expected the manifest to be created")
+			}
+			if created.Content != base64.StdEncoding.EncodeToString([]byte(testManifestYAML)) {
+				t.Errorf("Expected content to be base64-encoded once, got %s", created.Content)
+			}
+
+			var data ManifestResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.ContentSHA256.ValueString() != manifestContentSHA256([]byte(testManifestYAML)) {
+				t.Errorf("Unexpected content_sha256 %s", data.ContentSHA256)
+			}
+		})
+	}
+}
+
+func TestManifestResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name:   "content",
+			values: map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, testManifestYAML)},
+		},
+		{
+			name:   "unknown source_path",
+			values: map[string]tftypes.Value{"source_path": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		},
+		{
+			name:        "none",
+			values:      map[string]tftypes.Value{},
+			expectError: true,
+		},
+		{
+			name: "content and source_path",
+			values: map[string]tftypes.Value{
+				"content":     tftypes.NewValue(tftypes.String, testManifestYAML),
+				"source_path": tftypes.NewValue(tftypes.String, "manifest.yaml"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ManifestResource{}
+			state := newResourceState(t, r, tt.values)
+			config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error %v, got %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func manifestModel(content, contentBase64, sourcePath string) ManifestResourceModel {
+	data := ManifestResourceModel{
+		Content:       types.StringNull(),
+		ContentBase64: types.StringNull(),
+		SourcePath:    types.StringNull(),
+	}
+	if content != "" {
+		data.Content = types.StringValue(content)
+	}
+	if contentBase64 != "" {
+		data.ContentBase64 = types.StringValue(contentBase64)
+	}
+	if sourcePath != "" {
+		data.SourcePath = types.StringValue(sourcePath)
+	}
+	return data
+}
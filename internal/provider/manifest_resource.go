@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ManifestResource{}
 var _ resource.ResourceWithImportState = &ManifestResource{}
+var _ resource.ResourceWithValidateConfig = &ManifestResource{}
+var _ resource.ResourceWithModifyPlan = &ManifestResource{}
 
 func NewManifestResource() resource.Resource {
 	return &ManifestResource{}
@@ -36,13 +40,16 @@ type ManifestResource struct {
 
 // ManifestResourceModel describes the resource data model.
 type ManifestResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ClusterID types.String `tfsdk:"cluster_id"`
-	FileName  types.String `tfsdk:"file_name"`
-	Folder    types.String `tfsdk:"folder"`
-	Content   types.String `tfsdk:"content"`
+	ID            types.String `tfsdk:"id"`
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	FileName      types.String `tfsdk:"file_name"`
+	Folder        types.String `tfsdk:"folder"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	SourcePath    types.String `tfsdk:"source_path"`
 
 	// Computed fields
+	ContentSHA256  types.String `tfsdk:"content_sha256"`
 	ManifestSource types.String `tfsdk:"manifest_source"`
 }
 
@@ -70,16 +77,16 @@ func (r *ManifestResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"file_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the manifest file. Must have .yaml, .yml, or .json extension.",
+				MarkdownDescription: "Name of the manifest file, without a directory. Must have .yaml, .yml, or .json extension.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						// Must end with .yaml, .yml, or .json
-						regexp.MustCompile(`\.(yaml|yml|json)$`),
-						"file_name must end with .yaml, .yml, or .json",
+						// Must end with .yaml, .yml, or .json, the folder is set separately
+						regexp.MustCompile(`^[^/]+\.(yaml|yml|json)$`),
+						"file_name must end with .yaml, .yml, or .json and must not contain '/'",
 					),
 				},
 			},
@@ -96,11 +103,23 @@ func (r *ManifestResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"content": schema.StringAttribute{
-				MarkdownDescription: "Content of the manifest in YAML or JSON format. The content will be automatically base64-encoded for the API.",
-				Required:            true,
+				MarkdownDescription: "Content of the manifest in YAML or JSON format. The content will be automatically base64-encoded for the API; content that is already base64-encoded is sent as is. Exactly one of `content`, `content_base64` or `source_path` must be set.",
+				Optional:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded content of the manifest, e.g. from `filebase64()`.",
+				Optional:            true,
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a local YAML or JSON file to read the manifest content from. Changes to the file are detected through `content_sha256`.",
+				Optional:            true,
 			},
 
 			// Computed attributes
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 of the decoded manifest content.",
+				Computed:            true,
+			},
 			"manifest_source": schema.StringAttribute{
 				MarkdownDescription: "Source information for the manifest.",
 				Computed:            true,
@@ -139,12 +158,14 @@ func (r *ManifestResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Validate and encode content
-	encodedContent, err := r.encodeManifestContent(data.Content.ValueString())
+	// Resolve and encode content, the API expects base64
+	content, err := resolveManifestContent(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid manifest content", fmt.Sprintf("Could not encode manifest content: %s", err))
 		return
 	}
+	encodedContent := base64.StdEncoding.EncodeToString(content)
+	data.ContentSHA256 = types.StringValue(manifestContentSHA256(content))
 
 	// Create the manifest parameters
 	createParams := models.CreateManifestParams{
@@ -213,6 +234,12 @@ func (r *ManifestResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// List manifests for the cluster to find this one
 	manifests, err := r.client.ListManifests(ctx, data.ClusterID.ValueString())
 	if err != nil {
+		// The owning cluster is gone, so the manifest is too
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddWarning("Manifest not found", fmt.Sprintf("Cluster %s no longer exists, manifest %s will be removed from state", data.ClusterID.ValueString(), data.FileName.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading manifests", fmt.Sprintf("Could not read manifests for cluster %s: %s", data.ClusterID.ValueString(), err))
 		return
 	}
@@ -235,6 +262,25 @@ func (r *ManifestResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Update computed fields
 	data.ManifestSource = types.StringValue(foundManifest.ManifestSource)
 
+	// Compare the content on the service to detect changes made outside Terraform
+	content, err := r.client.DownloadManifestContent(ctx, data.ClusterID.ValueString(), data.FileName.ValueString(), data.Folder.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading manifest content", fmt.Sprintf("Could not download manifest %s: %s", data.FileName.ValueString(), err))
+		return
+	}
+
+	if applyServerManifestContent(&data, []byte(content)) {
+		tflog.Info(ctx, "Manifest content changed outside Terraform", map[string]any{
+			"cluster_id": data.ClusterID.ValueString(),
+			"file_name":  data.FileName.ValueString(),
+			"folder":     data.Folder.ValueString(),
+		})
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -249,17 +295,20 @@ func (r *ManifestResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Validate and encode content
-	encodedContent, err := r.encodeManifestContent(data.Content.ValueString())
+	// Resolve and encode content, the API expects base64
+	content, err := resolveManifestContent(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid manifest content", fmt.Sprintf("Could not encode manifest content: %s", err))
 		return
 	}
+	encodedContent := base64.StdEncoding.EncodeToString(content)
+	data.ContentSHA256 = types.StringValue(manifestContentSHA256(content))
 
 	// Create the update parameters
 	updateParams := models.UpdateManifestParams{
-		FileName: data.FileName.ValueString(),
-		Content:  encodedContent,
+		Folder:         data.Folder.ValueString(),
+		FileName:       data.FileName.ValueString(),
+		UpdatedContent: encodedContent,
 	}
 
 	tflog.Info(ctx, "Updating manifest", map[string]any{
@@ -332,9 +381,8 @@ func (r *ManifestResource) Delete(ctx context.Context, req resource.DeleteReques
 
 func (r *ManifestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import state expects "cluster_id/folder/file_name" format
-	// For simplicity, we'll use the ID as provided and parse it in the resource
-	idParts := req.ID
-	if idParts == "" {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: cluster_id/folder/file_name. Got: %q", req.ID),
@@ -342,19 +390,8 @@ func (r *ManifestResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	// Set the ID for now - the Read method will populate other fields
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-}
-
-// Helper functions
-
-func (r *ManifestResource) encodeManifestContent(content string) (string, error) {
-	// Validate that content is not empty
-	if content == "" {
-		return "", fmt.Errorf("manifest content cannot be empty")
-	}
-
-	// The API expects base64-encoded content
-	encoded := base64.StdEncoding.EncodeToString([]byte(content))
-	return encoded, nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_name"), idParts[2])...)
 }
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const driftedManifestYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: custom-config
+  namespace: openshift-config
+data:
+  edited: "true"
+`
+
+// newManifestServer serves the manifest list and the given manifest content,
+// and records the parameters of manifest updates. An empty content responds
+// with 404 Not Found as for a manifest deleted on the service.
+func newManifestServer(t *testing.T, listed bool, content string, updated *models.UpdateManifestParams) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id/manifests":
+			manifests := []models.Manifest{}
+			if listed {
+				manifests = append(manifests, models.Manifest{FileName: "custom-config.yaml", Folder: "manifests", ManifestSource: "user"})
+			}
+			_ = json.NewEncoder(w).Encode(manifests)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/clusters/test-cluster-id/manifests/files":
+			if r.URL.Query().Get("file_name") != "custom-config.yaml" || r.URL.Query().Get("folder") != "manifests" {
+				t.Errorf("Unexpected manifest download %s", r.URL.RawQuery)
+			}
+			if content == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte(content))
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/clusters/test-cluster-id/manifests":
+			if err := json.NewDecoder(r.Body).Decode(updated); err != nil {
+				t.Errorf("Failed to decode manifest update: %v", err)
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newManifestResourceState(t *testing.T, r *ManifestResource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+
+	values["id"] = tftypes.NewValue(tftypes.String, "test-cluster-id/manifests/custom-config.yaml")
+	values["cluster_id"] = tftypes.NewValue(tftypes.String, "test-cluster-id")
+	values["file_name"] = tftypes.NewValue(tftypes.String, "custom-config.yaml")
+	values["folder"] = tftypes.NewValue(tftypes.String, "manifests")
+	values["manifest_source"] = tftypes.NewValue(tftypes.String, "user")
+	return newResourceState(t, r, values)
+}
+
+func TestManifestResource_Read_Drift(t *testing.T) {
+	tests := []struct {
+		name            string
+		listed          bool
+		serverContent   string
+		values          map[string]tftypes.Value
+		expectRemoved   bool
+		expectedContent string
+		expectedBase64  string
+		expectedSHA256  string
+	}{
+		{
+			name:          "unchanged",
+			listed:        true,
+			serverContent: testManifestYAML,
+			values: map[string]tftypes.Value{
+				"content":        tftypes.NewValue(tftypes.String, testManifestYAML),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectedContent: testManifestYAML,
+			expectedSHA256:  manifestContentSHA256([]byte(testManifestYAML)),
+		},
+		{
+			name:          "unchanged apart from line endings",
+			listed:        true,
+			serverContent: "apiVersion: v1\r\nkind: ConfigMap\r\nmetadata:\r\n  name: custom-config\r\n  namespace: openshift-config",
+			values: map[string]tftypes.Value{
+				"content":        tftypes.NewValue(tftypes.String, testManifestYAML),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectedContent: testManifestYAML,
+			expectedSHA256:  manifestContentSHA256([]byte(testManifestYAML)),
+		},
+		{
+			name:          "drifted",
+			listed:        true,
+			serverContent: driftedManifestYAML,
+			values: map[string]tftypes.Value{
+				"content":        tftypes.NewValue(tftypes.String, testManifestYAML),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectedContent: driftedManifestYAML,
+			expectedSHA256:  manifestContentSHA256([]byte(driftedManifestYAML)),
+		},
+		{
+			name:          "drifted content_base64",
+			listed:        true,
+			serverContent: driftedManifestYAML,
+			values: map[string]tftypes.Value{
+				"content_base64": tftypes.NewValue(tftypes.String, base64.StdEncoding.EncodeToString([]byte(testManifestYAML))),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectedBase64: base64.StdEncoding.EncodeToString([]byte(driftedManifestYAML)),
+			expectedSHA256: manifestContentSHA256([]byte(driftedManifestYAML)),
+		},
+		{
+			name:          "deleted on server",
+			listed:        false,
+			serverContent: testManifestYAML,
+			values: map[string]tftypes.Value{
+				"content":        tftypes.NewValue(tftypes.String, testManifestYAML),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectRemoved: true,
+		},
+		{
+			name:   "content deleted on server",
+			listed: true,
+			values: map[string]tftypes.Value{
+				"content":        tftypes.NewValue(tftypes.String, testManifestYAML),
+				"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+			},
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := newManifestServer(t, tt.listed, tt.serverContent, nil)
+			defer server.Close()
+
+			r := &ManifestResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			state := newManifestResourceState(t, r, tt.values)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			if tt.expectRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Error("Expected the manifest to be removed from state")
+				}
+				return
+			}
+
+			var data ManifestResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Content.ValueString() != tt.expectedContent {
+				t.Errorf("Expected content %q, got %q", tt.expectedContent, data.Content.ValueString())
+			}
+			if data.ContentBase64.ValueString() != tt.expectedBase64 {
+				t.Errorf("Expected content_base64 %q, got %q", tt.expectedBase64, data.ContentBase64.ValueString())
+			}
+			if data.ContentSHA256.ValueString() != tt.expectedSHA256 {
+				t.Errorf("Expected content_sha256 %s, got %s", tt.expectedSHA256, data.ContentSHA256.ValueString())
+			}
+		})
+	}
+}
+
+func TestManifestResource_Read_DriftSourcePath(t *testing.T) {
+	ctx := context.Background()
+
+	server := newManifestServer(t, true, driftedManifestYAML, nil)
+	defer server.Close()
+
+	r := &ManifestResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	sourcePath := writeTestManifest(t, testManifestYAML)
+	state := newManifestResourceState(t, r, map[string]tftypes.Value{
+		"source_path":    tftypes.NewValue(tftypes.String, sourcePath),
+		"content_sha256": tftypes.NewValue(tftypes.String, manifestContentSHA256([]byte(testManifestYAML))),
+	})
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var data ManifestResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.SourcePath.ValueString() != sourcePath || !data.Content.IsNull() {
+		t.Errorf("Expected only source_path to be set, got source_path %s and content %s", data.SourcePath, data.Content)
+	}
+	if data.ContentSHA256.ValueString() != manifestContentSHA256([]byte(driftedManifestYAML)) {
+		t.Errorf("Expected content_sha256 of the drifted content, got %s", data.ContentSHA256.ValueString())
+	}
+}
+
+func TestManifestResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name        string
+		importID    string
+		expectError bool
+	}{
+		{name: "composite id", importID: "test-cluster-id/manifests/custom-config.yaml"},
+		{name: "cluster id only", importID: "test-cluster-id", expectError: true},
+		{name: "missing file name", importID: "test-cluster-id/manifests", expectError: true},
+		{name: "empty folder", importID: "test-cluster-id//custom-config.yaml", expectError: true},
+		{name: "too many parts", importID: "test-cluster-id/manifests/custom-config.yaml/extra", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := newManifestServer(t, true, testManifestYAML, nil)
+			defer server.Close()
+
+			r := &ManifestResource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			empty := newResourceState(t, r, nil)
+			importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: empty.Schema, Raw: tftypes.NewValue(empty.Raw.Type(), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importID}, importResp)
+
+			if tt.expectError {
+				if !importResp.Diagnostics.HasError() || importResp.Diagnostics.Errors()[0].Summary() != "Unexpected Import Identifier" {
+					t.Fatalf("Expected Unexpected Import Identifier error, got %+v", importResp.Diagnostics)
+				}
+				return
+			}
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("ImportState() returned diagnostics: %+v", importResp.Diagnostics)
+			}
+
+			readResp := &resource.ReadResponse{State: importResp.State}
+			r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", readResp.Diagnostics)
+			}
+
+			var data ManifestResourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			if data.ClusterID.ValueString() != "test-cluster-id" || data.Folder.ValueString() != "manifests" || data.FileName.ValueString() != "custom-config.yaml" {
+				t.Errorf("Expected cluster_id, folder and file_name from the import ID, got %s, %s and %s", data.ClusterID, data.Folder, data.FileName)
+			}
+			if data.Content.ValueString() != testManifestYAML {
+				t.Errorf("Expected content read from the service, got %q", data.Content.ValueString())
+			}
+			if data.ManifestSource.ValueString() != "user" {
+				t.Errorf("Expected manifest_source user, got %s", data.ManifestSource)
+			}
+		})
+	}
+}
+
+func TestManifestResource_Update(t *testing.T) {
+	ctx := context.Background()
+
+	var updated models.UpdateManifestParams
+	server := newManifestServer(t, true, testManifestYAML, &updated)
+	defer server.Close()
+
+	r := &ManifestResource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	state := newManifestResourceState(t, r, map[string]tftypes.Value{
+		"content":        tftypes.NewValue(tftypes.String, driftedManifestYAML),
+		"content_sha256": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if updated.Folder != "manifests" || updated.FileName != "custom-config.yaml" {
+		t.Errorf("Expected manifests/custom-config.yaml to be updated, got %s/%s", updated.Folder, updated.FileName)
+	}
+	if updated.UpdatedContent != base64.StdEncoding.EncodeToString([]byte(driftedManifestYAML)) {
+		t.Errorf("Expected updated_content to be the base64-encoded content, got %s", updated.UpdatedContent)
+	}
+
+	var data ManifestResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if data.ContentSHA256.ValueString() != manifestContentSHA256([]byte(driftedManifestYAML)) {
+		t.Errorf("Unexpected content_sha256 %s", data.ContentSHA256.ValueString())
+	}
+}
+
+func TestManifestResource_SchemaValidators(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&ManifestResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		attribute   string
+		value       string
+		expectError bool
+	}{
+		{attribute: "folder", value: "manifests"},
+		{attribute: "folder", value: "openshift"},
+		{attribute: "folder", value: "custom", expectError: true},
+		{attribute: "folder", value: "Manifests", expectError: true},
+		{attribute: "file_name", value: "custom-config.yaml"},
+		{attribute: "file_name", value: "99-worker.yml"},
+		{attribute: "file_name", value: "config.json"},
+		{attribute: "file_name", value: "config.txt", expectError: true},
+		{attribute: "file_name", value: "config.yaml.bak", expectError: true},
+		{attribute: "file_name", value: ".yaml", expectError: true},
+		{attribute: "file_name", value: "manifests/config.yaml", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+"="+tt.value, func(t *testing.T) {
+			attribute, ok := schemaResp.Schema.Attributes[tt.attribute].(schema.StringAttribute)
+			if !ok {
+				t.Fatalf("Expected %s to be a string attribute", tt.attribute)
+			}
+
+			resp := &validator.StringResponse{}
+			for _, v := range attribute.Validators {
+				v.ValidateString(ctx, validator.StringRequest{
+					Path:        path.Root(tt.attribute),
+					ConfigValue: types.StringValue(tt.value),
+				}, resp)
+			}
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error %v for %s %q, got %+v", tt.expectError, tt.attribute, tt.value, resp.Diagnostics)
+			}
+		})
+	}
+}
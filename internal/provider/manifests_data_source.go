@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManifestsDataSource{}
+
+func NewManifestsDataSource() datasource.DataSource {
+	return &ManifestsDataSource{}
+}
+
+// ManifestsDataSource defines the data source implementation.
+type ManifestsDataSource struct {
+	client *client.Client
+}
+
+// ManifestsDataSourceModel describes the data source data model.
+type ManifestsDataSourceModel struct {
+	ID             types.String         `tfsdk:"id"`
+	ClusterID      types.String         `tfsdk:"cluster_id"`
+	IncludeContent types.Bool           `tfsdk:"include_content"`
+	Manifests      []ManifestEntryModel `tfsdk:"manifests"`
+}
+
+// ManifestEntryModel describes a single manifest attached to a cluster.
+type ManifestEntryModel struct {
+	Folder         types.String `tfsdk:"folder"`
+	FileName       types.String `tfsdk:"file_name"`
+	ManifestSource types.String `tfsdk:"manifest_source"`
+	Content        types.String `tfsdk:"content"`
+}
+
+func (d *ManifestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_manifests"
+}
+
+func (d *ManifestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the manifests attached to a cluster, optionally with their content. Useful to inspect the manifests of a cluster created outside Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier.",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the cluster to list manifests for",
+				Required:            true,
+			},
+			"include_content": schema.BoolAttribute{
+				MarkdownDescription: "Whether to download the content of each manifest. Defaults to false, as this makes one request per manifest.",
+				Optional:            true,
+			},
+			"manifests": schema.ListNestedAttribute{
+				MarkdownDescription: "Manifests attached to the cluster",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"folder": schema.StringAttribute{
+							MarkdownDescription: "The folder that contains the manifest ('manifests' or 'openshift')",
+							Computed:            true,
+						},
+						"file_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the manifest file",
+							Computed:            true,
+						},
+						"manifest_source": schema.StringAttribute{
+							MarkdownDescription: "Describes whether manifest is sourced from a user or created by the system ('user' or 'system')",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The decoded content of the manifest file. Only set when `include_content` is true.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManifestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ManifestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ManifestsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	includeContent := data.IncludeContent.ValueBool()
+
+	tflog.Info(ctx, "Fetching cluster manifests", map[string]any{
+		"data_source":     "oai_manifests",
+		"cluster_id":      clusterID,
+		"include_content": includeContent,
+	})
+
+	manifests, err := d.client.ListManifests(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching manifests", fmt.Sprintf("Could not list manifests for cluster %s: %s", clusterID, err))
+		return
+	}
+
+	data.Manifests = make([]ManifestEntryModel, len(manifests))
+	for i, manifest := range manifests {
+		entry := ManifestEntryModel{
+			Folder:         types.StringValue(manifest.Folder),
+			FileName:       types.StringValue(manifest.FileName),
+			ManifestSource: types.StringValue(manifest.ManifestSource),
+			Content:        types.StringNull(),
+		}
+
+		if includeContent {
+			content, err := d.client.DownloadManifestContent(ctx, clusterID, manifest.FileName, manifest.Folder)
+			if err != nil {
+				resp.Diagnostics.AddError("Error fetching manifest content", fmt.Sprintf("Could not download manifest %s/%s: %s", manifest.Folder, manifest.FileName, err))
+				return
+			}
+			entry.Content = types.StringValue(content)
+		}
+
+		data.Manifests[i] = entry
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("manifests-%s", clusterID))
+
+	tflog.Info(ctx, "Successfully fetched cluster manifests", map[string]any{
+		"manifest_count": len(data.Manifests),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestManifestsDataSource_Metadata(t *testing.T) {
+	ds := NewManifestsDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	if metadataResp.TypeName != "openshift_assisted_installer_manifests" {
+		t.Errorf("Expected type name 'openshift_assisted_installer_manifests', got '%s'", metadataResp.TypeName)
+	}
+}
+
+func TestManifestsDataSource_Read(t *testing.T) {
+	const content = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: custom-config\n"
+
+	tests := []struct {
+		name              string
+		includeContent    bool
+		expectedDownloads int
+	}{
+		{name: "list only", includeContent: false, expectedDownloads: 0},
+		{name: "with content", includeContent: true, expectedDownloads: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var downloads int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v2/clusters/test-cluster-id/manifests":
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode([]models.Manifest{
+						{Folder: "openshift", FileName: "custom-config.yaml", ManifestSource: "user"},
+					})
+				case "/v2/clusters/test-cluster-id/manifests/files":
+					downloads++
+					query := r.URL.Query()
+					if query.Get("folder") != "openshift" || query.Get("file_name") != "custom-config.yaml" {
+						t.Errorf("Unexpected manifest download %s", r.URL.RawQuery)
+					}
+					_, _ = w.Write([]byte(content))
+				default:
+					t.Errorf("Unexpected path %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			ds := &ManifestsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"cluster_id":      tftypes.NewValue(tftypes.String, "test-cluster-id"),
+				"include_content": tftypes.NewValue(tftypes.Bool, tt.includeContent),
+			})
+			ds.Read(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state ManifestsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			if downloads != tt.expectedDownloads {
+				t.Errorf("Expected %d content downloads, got %d", tt.expectedDownloads, downloads)
+			}
+			if len(state.Manifests) != 1 {
+				t.Fatalf("Expected 1 manifest, got %d", len(state.Manifests))
+			}
+
+			manifest := state.Manifests[0]
+			if manifest.Folder.ValueString() != "openshift" || manifest.FileName.ValueString() != "custom-config.yaml" {
+				t.Errorf("Unexpected manifest %s/%s", manifest.Folder, manifest.FileName)
+			}
+			if manifest.ManifestSource.ValueString() != "user" {
+				t.Errorf("Expected manifest_source user, got %s", manifest.ManifestSource)
+			}
+			if tt.includeContent {
+				if manifest.Content.ValueString() != content {
+					t.Errorf("Expected content %q, got %q", content, manifest.Content.ValueString())
+				}
+			} else if !manifest.Content.IsNull() {
+				t.Errorf("Expected null content without include_content, got %q", manifest.Content.ValueString())
+			}
+		})
+	}
+}
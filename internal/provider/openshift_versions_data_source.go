@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,10 +27,11 @@ type OpenShiftVersionsDataSource struct {
 }
 
 type OpenShiftVersionsDataSourceModel struct {
-	ID         types.String            `tfsdk:"id"`
-	Version    types.String            `tfsdk:"version"`
-	OnlyLatest types.Bool              `tfsdk:"only_latest"`
-	Versions   []OpenShiftVersionModel `tfsdk:"versions"`
+	ID             types.String            `tfsdk:"id"`
+	Version        types.String            `tfsdk:"version"`
+	OnlyLatest     types.Bool              `tfsdk:"only_latest"`
+	DefaultVersion types.String            `tfsdk:"default_version"`
+	Versions       []OpenShiftVersionModel `tfsdk:"versions"`
 }
 
 type OpenShiftVersionModel struct {
@@ -58,8 +63,12 @@ func (d *OpenShiftVersionsDataSource) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "Return only the latest versions",
 				Optional:            true,
 			},
+			"default_version": schema.StringAttribute{
+				MarkdownDescription: "The version the Assisted Service marks as default, or null if none of the returned versions is the default",
+				Computed:            true,
+			},
 			"versions": schema.ListNestedAttribute{
-				MarkdownDescription: "List of available OpenShift versions",
+				MarkdownDescription: "List of available OpenShift versions, newest first",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -142,26 +151,31 @@ func (d *OpenShiftVersionsDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	// Sort newest first so the list order is stable between reads
+	versionKeys := make([]string, 0, len(*versions))
+	for version := range *versions {
+		versionKeys = append(versionKeys, version)
+	}
+	sort.Slice(versionKeys, func(i, j int) bool {
+		return compareOpenShiftVersions(versionKeys[i], versionKeys[j]) > 0
+	})
+
 	// Convert to model
-	data.Versions = make([]OpenShiftVersionModel, 0, len(*versions))
-	for version, versionInfo := range *versions {
+	data.DefaultVersion = types.StringNull()
+	data.Versions = make([]OpenShiftVersionModel, 0, len(versionKeys))
+	for _, version := range versionKeys {
+		versionInfo := (*versions)[version]
+
 		// Convert CPU architectures to terraform list
-		var archList types.List
+		archList := types.ListNull(types.StringType)
 		if len(versionInfo.CPUArchitectures) > 0 {
-			archElements := make([]types.String, len(versionInfo.CPUArchitectures))
-			for i, arch := range versionInfo.CPUArchitectures {
-				archElements[i] = types.StringValue(arch)
-			}
-			var diags = make([]interface{}, 0)
-			archList, _ = types.ListValueFrom(ctx, types.StringType, archElements)
-			if len(diags) > 0 {
-				resp.Diagnostics.AddWarning(
-					"Could not convert CPU architectures",
-					fmt.Sprintf("Failed to convert CPU architectures for version %s", version),
-				)
-			}
-		} else {
-			archList = types.ListNull(types.StringType)
+			var diags diag.Diagnostics
+			archList, diags = types.ListValueFrom(ctx, types.StringType, versionInfo.CPUArchitectures)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		if versionInfo.Default {
+			data.DefaultVersion = types.StringValue(version)
 		}
 
 		data.Versions = append(data.Versions, OpenShiftVersionModel{
@@ -188,3 +202,44 @@ func (d *OpenShiftVersionsDataSource) Read(ctx context.Context, req datasource.R
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// compareOpenShiftVersions compares two version strings such as "4.15.20" or
+// "4.16.0-ec.1" segment by segment, numerically where both segments are
+// numbers. It returns a negative number if a < b, zero if equal, and positive
+// if a > b.
+func compareOpenShiftVersions(a, b string) int {
+	split := func(v string) []string {
+		return strings.FieldsFunc(v, func(r rune) bool { return r == '.' || r == '-' })
+	}
+
+	as, bs := split(a), split(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return an - bn
+			}
+		case aErr == nil:
+			// A release segment sorts after a pre-release label
+			return 1
+		case bErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	// "4.16.0" is newer than "4.16.0-ec.1" but "4.16.1" is newer than "4.16"
+	if len(as) != len(bs) && strings.Contains(a, "-") != strings.Contains(b, "-") {
+		if strings.Contains(a, "-") {
+			return -1
+		}
+		return 1
+	}
+
+	return len(as) - len(bs)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 )
@@ -164,7 +165,100 @@ func TestOpenShiftVersionsDataSource_Read(t *testing.T) {
 	}
 }
 
-// Helper function removed - simplified test approach
+func TestOpenShiftVersionsDataSource_ReadState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("only_latest") != "true" {
+			t.Errorf("Expected only_latest=true, got query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"4.14.15": {
+				"display_name": "4.14.15",
+				"support_level": "maintenance",
+				"default": false,
+				"cpu_architectures": ["x86_64"]
+			},
+			"4.15.20": {
+				"display_name": "4.15.20",
+				"support_level": "production",
+				"default": true,
+				"cpu_architectures": ["x86_64", "arm64"]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	ds := &OpenShiftVersionsDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL,
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"only_latest": tftypes.NewValue(tftypes.Bool, true),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	var state OpenShiftVersionsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "openshift_versions_latest" {
+		t.Errorf("Expected ID openshift_versions_latest, got %s", state.ID.ValueString())
+	}
+	if state.DefaultVersion.ValueString() != "4.15.20" {
+		t.Errorf("Expected default_version 4.15.20, got %s", state.DefaultVersion)
+	}
+	if len(state.Versions) != 2 {
+		t.Fatalf("Expected 2 versions, got %d", len(state.Versions))
+	}
+
+	// Newest version first
+	latest := state.Versions[0]
+	if latest.Version.ValueString() != "4.15.20" || latest.SupportLevel.ValueString() != "production" || !latest.Default.ValueBool() {
+		t.Errorf("Unexpected first version: %+v", latest)
+	}
+	var archs []string
+	latest.CPUArchitectures.ElementsAs(context.Background(), &archs, false)
+	if len(archs) != 2 || archs[0] != "x86_64" || archs[1] != "arm64" {
+		t.Errorf("Unexpected cpu_architectures: %v", archs)
+	}
+
+	if state.Versions[1].Version.ValueString() != "4.14.15" || state.Versions[1].Default.ValueBool() {
+		t.Errorf("Unexpected second version: %+v", state.Versions[1])
+	}
+}
+
+func TestCompareOpenShiftVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "4.15.20", b: "4.15.20", expected: 0},
+		{a: "4.15.20", b: "4.15.3", expected: 1},
+		{a: "4.9.0", b: "4.10.0", expected: -1},
+		{a: "4.16.0", b: "4.16.0-ec.1", expected: 1},
+		{a: "4.16.0-ec.1", b: "4.16.0-ec.2", expected: -1},
+		{a: "4.16.0-rc.0", b: "4.16.0-ec.5", expected: 1},
+		{a: "4.16.1", b: "4.16", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			got := compareOpenShiftVersions(tt.a, tt.b)
+			if (got > 0) != (tt.expected > 0) || (got < 0) != (tt.expected < 0) {
+				t.Errorf("compareOpenShiftVersions(%q, %q) = %d, want sign of %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
 
 func TestOpenShiftVersionsDataSource_Schema(t *testing.T) {
 	dataSource := NewOpenShiftVersionsDataSource()
@@ -179,7 +273,7 @@ func TestOpenShiftVersionsDataSource_Schema(t *testing.T) {
 	}
 
 	// Check required attributes
-	requiredAttrs := []string{"id", "versions"}
+	requiredAttrs := []string{"id", "versions", "default_version"}
 	for _, attr := range requiredAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("Schema missing required attribute: %s", attr)
@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,9 @@ type OperatorBundlesDataSource struct {
 
 // OperatorBundlesDataSourceModel describes the data source data model.
 type OperatorBundlesDataSourceModel struct {
-	ID      types.String          `tfsdk:"id"`
-	Bundles []OperatorBundleModel `tfsdk:"bundles"`
+	ID       types.String          `tfsdk:"id"`
+	BundleID types.String          `tfsdk:"bundle_id"`
+	Bundles  []OperatorBundleModel `tfsdk:"bundles"`
 }
 
 type OperatorBundleModel struct {
@@ -48,6 +51,10 @@ func (d *OperatorBundlesDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:            true,
 				MarkdownDescription: "Data source identifier.",
 			},
+			"bundle_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of a specific bundle to retrieve (e.g., 'virtualization'). If not specified, all available bundles are returned.",
+			},
 			"bundles": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available operator bundles.",
 				Computed:            true,
@@ -103,38 +110,42 @@ func (d *OperatorBundlesDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	tflog.Info(ctx, "Fetching operator bundles", map[string]any{
-		"data_source": "oai_operator_bundles",
-	})
-
-	bundles, err := d.client.GetOperatorBundles(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Error fetching operator bundles", fmt.Sprintf("Could not read operator bundles: %s", err))
-		return
-	}
+	var bundles models.Bundles
+	if bundleID := data.BundleID.ValueString(); bundleID != "" {
+		tflog.Info(ctx, "Fetching operator bundle", map[string]any{
+			"bundle_id": bundleID,
+		})
 
-	// Convert to Terraform model
-	data.ID = types.StringValue("operator_bundles_all")
-	data.Bundles = make([]OperatorBundleModel, len(*bundles))
-
-	for i, bundle := range *bundles {
-		// Convert operator names to Terraform list
-		operatorElements := make([]types.String, len(bundle.Operators))
-		for j, operatorName := range bundle.Operators {
-			operatorElements[j] = types.StringValue(operatorName)
+		bundle, err := d.client.GetOperatorBundle(ctx, bundleID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching operator bundle", fmt.Sprintf("Could not read operator bundle %s: %s", bundleID, err))
+			return
 		}
-
-		operatorList, listDiags := types.ListValueFrom(ctx, types.StringType, operatorElements)
-		if listDiags.HasError() {
-			resp.Diagnostics.Append(listDiags...)
+		bundles = models.Bundles{*bundle}
+		data.ID = types.StringValue(bundleID)
+	} else {
+		tflog.Info(ctx, "Fetching operator bundles", map[string]any{
+			"data_source": "oai_operator_bundles",
+		})
+
+		all, err := d.client.GetOperatorBundles(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching operator bundles", fmt.Sprintf("Could not read operator bundles: %s", err))
 			return
 		}
+		bundles = *all
+		data.ID = types.StringValue("operator_bundles_all")
+	}
 
-		data.Bundles[i] = OperatorBundleModel{
-			ID:        types.StringValue(bundle.ID),
-			Title:     types.StringValue(bundle.Title),
-			Operators: operatorList,
+	// Convert to Terraform model
+	data.Bundles = make([]OperatorBundleModel, len(bundles))
+	for i, bundle := range bundles {
+		model, diags := operatorBundleToModel(ctx, bundle)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		data.Bundles[i] = model
 	}
 
 	tflog.Info(ctx, "Successfully fetched operator bundles", map[string]any{
@@ -144,3 +155,18 @@ func (d *OperatorBundlesDataSource) Read(ctx context.Context, req datasource.Rea
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// operatorBundleToModel converts an API bundle to its Terraform model
+func operatorBundleToModel(ctx context.Context, bundle models.Bundle) (OperatorBundleModel, diag.Diagnostics) {
+	// Bundles without operators get an empty list rather than null
+	operatorList, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, bundle.Operators...))
+	if diags.HasError() {
+		return OperatorBundleModel{}, diags
+	}
+
+	return OperatorBundleModel{
+		ID:        types.StringValue(bundle.ID),
+		Title:     types.StringValue(bundle.Title),
+		Operators: operatorList,
+	}, diags
+}
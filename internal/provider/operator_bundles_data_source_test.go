@@ -10,7 +10,9 @@ import (
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOperatorBundlesDataSource_Schema(t *testing.T) {
@@ -27,6 +29,7 @@ func TestOperatorBundlesDataSource_Schema(t *testing.T) {
 	// Verify schema structure
 	schema := schemaResp.Schema
 	assert.NotNil(t, schema.Attributes["id"])
+	assert.NotNil(t, schema.Attributes["bundle_id"])
 	assert.NotNil(t, schema.Attributes["bundles"])
 
 	bundlesAttr := schema.Attributes["bundles"]
@@ -54,46 +57,74 @@ func TestOperatorBundlesDataSource_Read(t *testing.T) {
 	}
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Verify request
-		assert.Equal(t, "/api/assisted-install/v2/operators/bundles", r.URL.Path)
 		assert.Equal(t, "GET", r.Method)
 		assert.Contains(t, r.Header.Get("Authorization"), "Bearer")
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockBundles)
+		switch r.URL.Path {
+		case "/api/assisted-install/v2/operators/bundles":
+			_ = json.NewEncoder(w).Encode(mockBundles)
+		case "/api/assisted-install/v2/operators/bundles/virtualization":
+			_ = json.NewEncoder(w).Encode(mockBundles[0])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
 	}))
 	defer server.Close()
 
-	// Create test client
-	testClient := client.NewClient(client.ClientConfig{
-		BaseURL:      server.URL + "/api/assisted-install",
-		OfflineToken: "test-token",
-	})
-
-	// Create data source
-	ds := &OperatorBundlesDataSource{
-		client: testClient,
+	tests := []struct {
+		name              string
+		bundleID          tftypes.Value
+		expectedID        string
+		expectedBundles   []string
+		expectedOperators [][]string
+	}{
+		{
+			name:              "all bundles",
+			bundleID:          tftypes.NewValue(tftypes.String, nil),
+			expectedID:        "operator_bundles_all",
+			expectedBundles:   []string{"virtualization", "openshift-ai-nvidia"},
+			expectedOperators: [][]string{{"kubevirt-hyperconverged"}, {"rhods-operator", "gpu-operator-certified"}},
+		},
+		{
+			name:              "single bundle by id",
+			bundleID:          tftypes.NewValue(tftypes.String, "virtualization"),
+			expectedID:        "virtualization",
+			expectedBundles:   []string{"virtualization"},
+			expectedOperators: [][]string{{"kubevirt-hyperconverged"}},
+		},
 	}
 
-	// Configure request/response
-	configReq := datasource.ConfigureRequest{
-		ProviderData: testClient,
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &OperatorBundlesDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL + "/api/assisted-install",
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"bundle_id": tt.bundleID,
+			})
+			ds.Read(context.Background(), req, resp)
+			require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+			var state OperatorBundlesDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+			assert.Equal(t, tt.expectedID, state.ID.ValueString())
+			require.Len(t, state.Bundles, len(tt.expectedBundles))
+			for i, bundle := range state.Bundles {
+				assert.Equal(t, tt.expectedBundles[i], bundle.ID.ValueString())
+
+				var operators []string
+				bundle.Operators.ElementsAs(context.Background(), &operators, false)
+				assert.Equal(t, tt.expectedOperators[i], operators)
+			}
+		})
 	}
-	configResp := &datasource.ConfigureResponse{}
-	ds.Configure(context.Background(), configReq, configResp)
-
-	assert.False(t, configResp.Diagnostics.HasError())
-
-	// This test is simplified to avoid the complex configuration setup
-	// The main functionality is tested through the client layer
-	// which is already comprehensively tested
-
-	// Test that the data source can be configured without errors
-	assert.NotNil(t, ds.client, "Client should be configured")
-
-	// The actual Read functionality depends on proper Terraform framework setup
-	// which is difficult to mock in unit tests
-	// Integration tests should be used for full Read method testing
 }
 
 func TestOperatorBundlesDataSource_Configure_InvalidProviderData(t *testing.T) {
@@ -142,30 +173,50 @@ func TestOperatorBundlesDataSource_Metadata(t *testing.T) {
 }
 
 func TestOperatorBundlesDataSource_ReadError(t *testing.T) {
-	// Mock server that returns error
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/assisted-install/v2/operators/bundles/unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"reason": "bundle not found"}`))
+			return
+		}
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(`{"error": "internal server error"}`))
 	}))
 	defer server.Close()
 
-	// Create test client
-	testClient := client.NewClient(client.ClientConfig{
-		BaseURL:      server.URL + "/api/assisted-install",
-		OfflineToken: "test-token",
-	})
-
-	// Create data source
-	ds := &OperatorBundlesDataSource{
-		client: testClient,
+	tests := []struct {
+		name          string
+		bundleID      tftypes.Value
+		expectedError string
+	}{
+		{
+			name:          "list bundles fails",
+			bundleID:      tftypes.NewValue(tftypes.String, nil),
+			expectedError: "Error fetching operator bundles",
+		},
+		{
+			name:          "unknown bundle id",
+			bundleID:      tftypes.NewValue(tftypes.String, "unknown"),
+			expectedError: "Error fetching operator bundle",
+		},
 	}
 
-	// This test is simplified to avoid complex framework mocking
-	// The error handling is tested at the client layer which is more appropriate
-
-	// Test that the data source can be created with a client
-	assert.NotNil(t, ds.client, "Data source should have a client configured")
-
-	// Error scenarios are better tested at the client layer
-	// where HTTP errors can be properly mocked and tested
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &OperatorBundlesDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL + "/api/assisted-install",
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"bundle_id": tt.bundleID,
+			})
+			ds.Read(context.Background(), req, resp)
+
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
 }
@@ -5,14 +5,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
@@ -32,9 +41,22 @@ type OAIProvider struct {
 
 // OAIProviderModel describes the provider data model.
 type OAIProviderModel struct {
-	Endpoint     types.String `tfsdk:"endpoint"`
-	OfflineToken types.String `tfsdk:"offline_token"`
-	Timeout      types.String `tfsdk:"timeout"`
+	Endpoint           types.String  `tfsdk:"endpoint"`
+	OfflineToken       types.String  `tfsdk:"offline_token"`
+	AccessToken        types.String  `tfsdk:"access_token"`
+	TokenEndpoint      types.String  `tfsdk:"token_endpoint"`
+	TokenClientID      types.String  `tfsdk:"token_client_id"`
+	Timeout            types.String  `tfsdk:"timeout"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	OrgID              types.String  `tfsdk:"org_id"`
+	ExtraHeaders       types.Map     `tfsdk:"extra_headers"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	Offline            types.Bool    `tfsdk:"offline"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	DryRun             types.Bool    `tfsdk:"dry_run"`
+	TokenCacheDir      types.String  `tfsdk:"token_cache_dir"`
 }
 
 func (p *OAIProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,10 +76,73 @@ func (p *OAIProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token used directly as the Bearer token, skipping the offline token exchange. Use this for self-hosted Assisted Service deployments without Red Hat SSO.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint used to exchange the offline token. Defaults to the Red Hat SSO endpoint.",
+				Optional:            true,
+			},
+			"token_client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth client ID used to exchange the offline token. Defaults to `cloud-services`.",
+				Optional:            true,
+			},
 			"timeout": schema.StringAttribute{
 				MarkdownDescription: "Timeout for API requests (e.g., '30s', '5m')",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times to retry API requests that fail with a network error or a 429, 500, 502, 503 or 504 response. Retries use exponential backoff with jitter. POST requests are only retried on 429. A `Retry-After` header on the response is honored. Set to 0 to disable retries. Defaults to 3.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID sent in the `X-Organization-Id` header, required by some multi-tenant Assisted Service deployments",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers to send with every API request. They cannot override `Authorization`, and are not sent when a request is redirected to another host.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization")),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second. Useful to avoid rate limiting when managing many clusters. Unlimited when not set.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"offline": schema.BoolAttribute{
+				MarkdownDescription: "Skip the optional API calls made while planning, such as comparing the SSH key of an infrastructure environment with its cluster, and report a warning instead. Only plan-time validation is skipped: refreshing resources, reading data sources and applying changes still call the API, so run `terraform plan -refresh=false` without access to the API.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system roots, for self-hosted Assisted Service deployments behind a corporate or self-signed certificate",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy the provider sends API requests through, such as `http://proxy.example.com:3128`. Defaults to the proxy given by the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables. Unrelated to the proxy configured for the installed cluster.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable TLS certificate verification. Only use this for testing, prefer `ca_cert_pem` for self-signed certificates. Defaults to false.",
+				Optional:            true,
+			},
+			"token_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory to cache the access tokens exchanged for the offline token in, so that consecutive Terraform runs reuse them until they expire instead of each contacting Red Hat SSO. Cache files are named after a hash of the offline token and only readable by the current user. Tokens are only cached in memory when not set.",
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Log the API requests that would create, change or delete objects instead of sending them, and treat them as successful. Reads are still sent. The state written by an apply is synthetic: created objects get placeholder IDs and hold the values sent rather than those the API would return. Use a separate workspace and discard its state afterwards. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -94,11 +179,80 @@ func (p *OAIProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		}
 	}
 
+	maxRetries := client.DefaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	var extraHeaders map[string]string
+	if !data.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	caCertPEM := data.CACertPEM.ValueString()
+	if caCertPEM != "" {
+		if _, err := client.CACertPool(caCertPEM); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid CA Certificate",
+				fmt.Sprintf("Unable to load \"ca_cert_pem\": %s.", err),
+			)
+			return
+		}
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Verification Disabled",
+			"The provider does not verify the certificate of the Assisted Service API, which exposes credentials to interception. Use \"ca_cert_pem\" to trust a self-signed certificate instead.",
+		)
+	}
+
+	if data.DryRun.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("dry_run"),
+			"Dry Run Enabled",
+			"The provider logs the API requests that would change objects instead of sending them. Changes are not applied and the resulting state is synthetic, discard it afterwards.",
+		)
+	}
+
+	var proxyURL *url.URL
+	if !data.ProxyURL.IsNull() {
+		parsed, err := url.Parse(data.ProxyURL.ValueString())
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("\"proxy_url\" %q must be an absolute URL such as http://proxy.example.com:3128.", data.ProxyURL.ValueString()),
+			)
+			return
+		}
+		proxyURL = parsed
+	}
+
 	// Create OAI API client with OAuth2 support
 	oaiClient := client.NewClient(client.ClientConfig{
-		BaseURL:      endpoint,
-		OfflineToken: offlineToken,
-		Timeout:      timeout,
+		BaseURL:           endpoint,
+		OfflineToken:      offlineToken,
+		AccessToken:       data.AccessToken.ValueString(),
+		TokenEndpoint:     data.TokenEndpoint.ValueString(),
+		TokenClientID:     data.TokenClientID.ValueString(),
+		Timeout:           timeout,
+		MaxRetries:        maxRetries,
+		OrgID:             data.OrgID.ValueString(),
+		ExtraHeaders:      extraHeaders,
+		RequestsPerSecond: data.RequestsPerSecond.ValueFloat64(),
+		Offline:           data.Offline.ValueBool(),
+
+		CACertPEM:          caCertPEM,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           proxyURL,
+		DryRun:             data.DryRun.ValueBool(),
+		TokenCacheDir:      data.TokenCacheDir.ValueString(),
 	})
 
 	resp.DataSourceData = oaiClient
@@ -110,8 +264,10 @@ func (p *OAIProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewClusterResource,
 		NewClusterInstallationResource,
 		NewInfraEnvResource,
+		NewInfraEnvImageResource,
 		NewHostResource,
 		NewManifestResource,
+		NewClusterNTPResource,
 	}
 }
 
@@ -121,17 +277,26 @@ func (p *OAIProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewSupportedOperatorsDataSource,
 		NewOperatorBundlesDataSource,
 		NewSupportLevelsDataSource,
+		NewSupportedArchitecturesDataSource,
+		NewSupportedFeaturesDataSource,
 		NewClusterCredentialsDataSource,
+		NewClusterDNSRecordsDataSource,
+		NewClusterInstallationStatusDataSource,
 		NewClusterEventsDataSource,
+		NewInfraEnvEventsDataSource,
 		NewClusterLogsDataSource,
 		NewClusterFilesDataSource,
+		NewClusterArtifactsDataSource,
 		NewClusterValidationsDataSource,
 		NewHostValidationsDataSource,
 		// New data sources for comprehensive resource coverage - All Swagger compliant
 		NewClusterDataSource,
 		NewInfraEnvDataSource,
 		NewHostDataSource,
+		NewHostsDataSource,
+		NewClusterAllHostsDataSource,
 		NewManifestDataSource,
+		NewManifestsDataSource,
 	}
 }
 
@@ -141,6 +306,14 @@ func (p *OAIProvider) Functions(ctx context.Context) []func() function.Function
 	}
 }
 
+// addDeprecationWarnings surfaces the deprecation notices collected with
+// client.CollectDeprecations as warning diagnostics
+func addDeprecationWarnings(deprecations func() []string, diags *diag.Diagnostics) {
+	for _, notice := range deprecations() {
+		diags.AddWarning("Assisted Service API Deprecation", notice)
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &OAIProvider{
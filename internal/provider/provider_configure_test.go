@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOAIProvider_Configure_TokenEndpoint(t *testing.T) {
+	var tokenRequests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if r.URL.Path != "/realms/assisted/token" {
+			t.Errorf("Expected token path /realms/assisted/token, got %s", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Failed to parse token request: %v", err)
+		}
+		if got := r.PostForm.Get("client_id"); got != "assisted-client" {
+			t.Errorf("client_id = %q, want assisted-client", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.TokenResponse{AccessToken: "realm-token", ExpiresIn: 900})
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer realm-token" {
+			t.Errorf("Authorization = %q, want Bearer realm-token", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer apiServer.Close()
+
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"endpoint":        tftypes.NewValue(tftypes.String, apiServer.URL),
+		"offline_token":   tftypes.NewValue(tftypes.String, "offline-token"),
+		"token_endpoint":  tftypes.NewValue(tftypes.String, tokenServer.URL+"/realms/assisted/token"),
+		"token_client_id": tftypes.NewValue(tftypes.String, "assisted-client"),
+	})
+
+	oaiClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+
+	if _, err := oaiClient.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if tokenRequests != 1 {
+		t.Errorf("Expected the configured token endpoint to be used once, got %d requests", tokenRequests)
+	}
+}
+
+func TestOAIProvider_Configure_Offline(t *testing.T) {
+	tests := []struct {
+		name     string
+		offline  tftypes.Value
+		expected bool
+	}{
+		{name: "default", offline: tftypes.NewValue(tftypes.Bool, nil)},
+		{name: "offline", offline: tftypes.NewValue(tftypes.Bool, true), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := configureTestProvider(t, map[string]tftypes.Value{
+				"offline": tt.offline,
+			})
+
+			oaiClient, ok := resp.ResourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+			}
+			if oaiClient.Offline() != tt.expected {
+				t.Errorf("Expected Offline() %v, got %v", tt.expected, oaiClient.Offline())
+			}
+		})
+	}
+}
+
+func TestOAIProvider_Configure_CACertPEM(t *testing.T) {
+	apiServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer apiServer.Close()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: apiServer.Certificate().Raw,
+	})
+
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"endpoint":     tftypes.NewValue(tftypes.String, apiServer.URL),
+		"access_token": tftypes.NewValue(tftypes.String, "access-token"),
+		"ca_cert_pem":  tftypes.NewValue(tftypes.String, string(caCertPEM)),
+	})
+
+	oaiClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+	if _, err := oaiClient.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+}
+
+func TestOAIProvider_Configure_InsecureSkipVerify(t *testing.T) {
+	apiServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer apiServer.Close()
+
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"endpoint":             tftypes.NewValue(tftypes.String, apiServer.URL),
+		"access_token":         tftypes.NewValue(tftypes.String, "access-token"),
+		"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning about disabled TLS verification, got %+v", resp.Diagnostics)
+	}
+
+	oaiClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+	if _, err := oaiClient.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+}
+
+func TestOAIProvider_Configure_ProxyURL(t *testing.T) {
+	var proxiedHosts []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHosts = append(proxiedHosts, r.URL.Host)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer proxy.Close()
+
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"endpoint":     tftypes.NewValue(tftypes.String, "http://assisted.example.com/api/assisted-install"),
+		"access_token": tftypes.NewValue(tftypes.String, "access-token"),
+		"proxy_url":    tftypes.NewValue(tftypes.String, proxy.URL),
+	})
+
+	oaiClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+	if _, err := oaiClient.GetCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("GetCluster() error = %v", err)
+	}
+	if len(proxiedHosts) != 1 || proxiedHosts[0] != "assisted.example.com" {
+		t.Errorf("Expected the API request to go through the proxy, got %v", proxiedHosts)
+	}
+}
+
+// configureTestProvider configures the provider with the given attributes, all
+// others null, and fails the test on error diagnostics.
+func configureTestProvider(t *testing.T, values map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Provider schema is not an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objType, attrs),
+		},
+	}
+	resp := &provider.ConfigureResponse{}
+
+	p.Configure(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure() returned diagnostics: %+v", resp.Diagnostics)
+	}
+
+	return resp
+}
+
+func TestOAIProvider_Configure_DryRun(t *testing.T) {
+	var methods []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.Cluster{ID: "test-cluster-id"})
+	}))
+	defer apiServer.Close()
+
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"endpoint":     tftypes.NewValue(tftypes.String, apiServer.URL),
+		"access_token": tftypes.NewValue(tftypes.String, "access-token"),
+		"dry_run":      tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning about dry-run mode, got %+v", resp.Diagnostics)
+	}
+
+	oaiClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+	if err := oaiClient.InstallCluster(context.Background(), "test-cluster-id"); err != nil {
+		t.Fatalf("InstallCluster() error = %v", err)
+	}
+	if len(methods) != 0 {
+		t.Errorf("Expected no requests in dry-run mode, got %v", methods)
+	}
+}
+
+func TestOAIProvider_Configure_SharedClient(t *testing.T) {
+	resp := configureTestProvider(t, map[string]tftypes.Value{
+		"access_token":    tftypes.NewValue(tftypes.String, "access-token"),
+		"token_cache_dir": tftypes.NewValue(tftypes.String, t.TempDir()),
+	})
+
+	// Resources and data sources share one client, and with it the access token
+	resourceClient, ok := resp.ResourceData.(*client.Client)
+	if !ok {
+		t.Fatalf("Expected *client.Client resource data, got %T", resp.ResourceData)
+	}
+	if dataSourceClient, ok := resp.DataSourceData.(*client.Client); !ok || dataSourceClient != resourceClient {
+		t.Errorf("Expected data sources to share the resource client, got %v", resp.DataSourceData)
+	}
+}
+
+func TestOAIProvider_Schema_ExtraHeadersRejectAuthorization(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &provider.SchemaResponse{}
+	New("test")().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name        string
+		header      string
+		expectError bool
+	}{
+		{name: "custom header", header: "X-Tenant"},
+		{name: "authorization", header: "Authorization", expectError: true},
+		{name: "lowercase authorization", header: "authorization", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.MapValueMust(types.StringType, map[string]attr.Value{tt.header: types.StringValue("value")})
+			resp := &validator.MapResponse{}
+			for _, v := range schemaResp.Schema.Attributes["extra_headers"].(schema.MapAttribute).Validators {
+				v.ValidateMap(ctx, validator.MapRequest{Path: path.Root("extra_headers"), ConfigValue: value}, resp)
+			}
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error: %v, got %+v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
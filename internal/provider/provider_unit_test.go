@@ -2,8 +2,13 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
@@ -214,3 +219,43 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestAddDeprecationWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cluster-id"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	ctx, deprecations := client.CollectDeprecations(context.Background())
+	if _, err := c.GetCluster(ctx, "cluster-id"); err != nil {
+		t.Fatalf("GetCluster failed: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	addDeprecationWarnings(deprecations, &diags)
+
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("Expected 1 warning, got %+v", diags)
+	}
+	if diags[0].Summary() != "Assisted Service API Deprecation" || !strings.Contains(diags[0].Detail(), "/v2/clusters/cluster-id") {
+		t.Errorf("Unexpected warning: %s: %s", diags[0].Summary(), diags[0].Detail())
+	}
+
+	// Requests made for another resource are not attributed to this one
+	if _, err := c.GetCluster(context.Background(), "cluster-id"); err != nil {
+		t.Fatalf("GetCluster failed: %v", err)
+	}
+	_, otherDeprecations := client.CollectDeprecations(context.Background())
+	diags = nil
+	addDeprecationWarnings(otherDeprecations, &diags)
+	if len(diags) != 0 {
+		t.Errorf("Expected no warnings for requests made with another context, got %+v", diags)
+	}
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceRead_NotFoundRemovesFromState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"404","reason":"not found"}`))
+	}))
+	defer server.Close()
+
+	testClient := client.NewClient(client.ClientConfig{
+		BaseURL:      server.URL,
+		OfflineToken: "test-token",
+	})
+
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		values   map[string]tftypes.Value
+	}{
+		{
+			name:     "cluster",
+			resource: &ClusterResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+			},
+		},
+		{
+			name:     "infra_env",
+			resource: &InfraEnvResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "deleted-infra-env-id"),
+			},
+		},
+		{
+			name:     "host",
+			resource: &HostResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, "deleted-host-id"),
+				"infra_env_id": tftypes.NewValue(tftypes.String, "deleted-infra-env-id"),
+			},
+		},
+		{
+			name:     "manifest",
+			resource: &ManifestResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+				"file_name":  tftypes.NewValue(tftypes.String, "custom.yaml"),
+				"folder":     tftypes.NewValue(tftypes.String, "manifests"),
+			},
+		},
+		{
+			name:     "cluster_installation",
+			resource: &ClusterInstallationResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+				"cluster_id": tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+			},
+		},
+		{
+			name:     "cluster_ntp",
+			resource: &ClusterNTPResource{client: testClient},
+			values: map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+				"cluster_id": tftypes.NewValue(tftypes.String, "deleted-cluster-id"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := newResourceState(t, tt.resource, tt.values)
+
+			req := resource.ReadRequest{State: state}
+			resp := &resource.ReadResponse{State: state}
+
+			tt.resource.Read(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned errors on 404: %+v", resp.Diagnostics)
+			}
+			if resp.Diagnostics.WarningsCount() == 0 {
+				t.Error("Expected a warning when the resource is removed from state")
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Error("Expected resource to be removed from state on 404")
+			}
+		})
+	}
+}
+
+// newResourceState builds a state for the given resource where every attribute
+// not present in values is null.
+func newResourceState(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Resource schema is not an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attrs),
+	}
+}
+
+// newDataSourceReadRequest builds a Read request for the given data source
+// where every attribute not present in values is null.
+func newDataSourceReadRequest(t *testing.T, ds datasource.DataSource, values map[string]tftypes.Value) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Data source schema is not an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objType, attrs),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objType, nil),
+		},
+	}
+
+	return req, resp
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SupportedArchitecturesDataSource{}
+
+// architectureFeatureIDs maps the support level feature IDs the API reports
+// for architectures to the matching cpu_architecture value
+var architectureFeatureIDs = map[string]string{
+	"X86_64_ARCHITECTURE":     "x86_64",
+	"ARM64_ARCHITECTURE":      "arm64",
+	"PPC64LE_ARCHITECTURE":    "ppc64le",
+	"S390X_ARCHITECTURE":      "s390x",
+	"MULTIARCH_RELEASE_IMAGE": "multi",
+}
+
+func NewSupportedArchitecturesDataSource() datasource.DataSource {
+	return &SupportedArchitecturesDataSource{}
+}
+
+// SupportedArchitecturesDataSource defines the data source implementation.
+type SupportedArchitecturesDataSource struct {
+	client *client.Client
+}
+
+// SupportedArchitecturesDataSourceModel describes the data source data model.
+type SupportedArchitecturesDataSourceModel struct {
+	ID               types.String      `tfsdk:"id"`
+	OpenShiftVersion types.String      `tfsdk:"openshift_version"`
+	Architectures    map[string]string `tfsdk:"architectures"`
+	Available        []types.String    `tfsdk:"available"`
+}
+
+func (d *SupportedArchitecturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supported_architectures"
+}
+
+func (d *SupportedArchitecturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Supported architectures data source lists the CPU architectures an OpenShift version can be installed on, with their support levels.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Data source identifier.",
+			},
+			"openshift_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the OpenShift cluster (required).",
+				Required:            true,
+			},
+			"architectures": schema.MapAttribute{
+				MarkdownDescription: "Map of CPU architectures (as used by `cpu_architecture`) to their support levels (supported, tech-preview, dev-preview, unsupported, unavailable).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"available": schema.ListAttribute{
+				MarkdownDescription: "Sorted list of CPU architectures that can be installed, i.e. whose support level is not `unsupported` or `unavailable`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SupportedArchitecturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SupportedArchitecturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupportedArchitecturesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openshiftVersion := data.OpenShiftVersion.ValueString()
+
+	tflog.Info(ctx, "Fetching supported architectures", map[string]any{
+		"data_source":       "oai_supported_architectures",
+		"openshift_version": openshiftVersion,
+	})
+
+	architectures, err := d.client.GetSupportedArchitectures(ctx, openshiftVersion)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching supported architectures", fmt.Sprintf("Could not read supported architectures: %s", err))
+		return
+	}
+
+	// Convert to Terraform model
+	data.ID = types.StringValue(fmt.Sprintf("supported_architectures_%s", openshiftVersion))
+	data.Architectures = cpuArchitectureSupportLevels(*architectures)
+
+	available := make([]string, 0, len(data.Architectures))
+	for arch, level := range data.Architectures {
+		if level != "unsupported" && level != "unavailable" {
+			available = append(available, arch)
+		}
+	}
+	sort.Strings(available)
+
+	data.Available = make([]types.String, len(available))
+	for i, arch := range available {
+		data.Available[i] = types.StringValue(arch)
+	}
+
+	tflog.Info(ctx, "Successfully fetched supported architectures", map[string]any{
+		"architecture_count": len(data.Architectures),
+		"available_count":    len(data.Available),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// cpuArchitectureSupportLevels keys the architecture support levels by
+// cpu_architecture value. Unrecognised feature IDs are kept as reported.
+func cpuArchitectureSupportLevels(architectures models.SupportedArchitectures) map[string]string {
+	levels := make(map[string]string, len(architectures))
+	for featureID, level := range architectures {
+		if arch, ok := architectureFeatureIDs[featureID]; ok {
+			levels[arch] = level
+		} else {
+			levels[featureID] = level
+		}
+	}
+	return levels
+}
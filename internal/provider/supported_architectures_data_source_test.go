@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedArchitecturesDataSource_Metadata(t *testing.T) {
+	ds := NewSupportedArchitecturesDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	assert.Equal(t, "openshift_assisted_installer_supported_architectures", metadataResp.TypeName)
+}
+
+func TestSupportedArchitecturesDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/assisted-install/v2/support-levels/architectures", r.URL.Path)
+		assert.Equal(t, "4.16", r.URL.Query().Get("openshift_version"))
+		assert.Contains(t, r.Header.Get("Authorization"), "Bearer")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"architectures": {
+				"X86_64_ARCHITECTURE": "supported",
+				"ARM64_ARCHITECTURE": "supported",
+				"PPC64LE_ARCHITECTURE": "tech-preview",
+				"S390X_ARCHITECTURE": "unavailable",
+				"MULTIARCH_RELEASE_IMAGE": "tech-preview"
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	ds := &SupportedArchitecturesDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL + "/api/assisted-install",
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+	})
+	ds.Read(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state SupportedArchitecturesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	assert.Equal(t, "supported_architectures_4.16", state.ID.ValueString())
+	assert.Equal(t, map[string]string{
+		"x86_64":  "supported",
+		"arm64":   "supported",
+		"ppc64le": "tech-preview",
+		"s390x":   "unavailable",
+		"multi":   "tech-preview",
+	}, state.Architectures)
+
+	available := make([]string, len(state.Available))
+	for i, arch := range state.Available {
+		available[i] = arch.ValueString()
+	}
+	assert.Equal(t, []string{"arm64", "multi", "ppc64le", "x86_64"}, available)
+}
+
+func TestSupportedArchitecturesDataSource_ReadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"reason": "invalid openshift_version"}`))
+	}))
+	defer server.Close()
+
+	ds := &SupportedArchitecturesDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL + "/api/assisted-install",
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"openshift_version": tftypes.NewValue(tftypes.String, "3.11"),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error fetching supported architectures", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestCPUArchitectureSupportLevels_UnknownFeatureID(t *testing.T) {
+	levels := cpuArchitectureSupportLevels(map[string]string{
+		"X86_64_ARCHITECTURE":  "supported",
+		"RISCV64_ARCHITECTURE": "dev-preview",
+	})
+
+	assert.Equal(t, map[string]string{
+		"x86_64":               "supported",
+		"RISCV64_ARCHITECTURE": "dev-preview",
+	}, levels)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SupportedFeaturesDataSource{}
+
+func NewSupportedFeaturesDataSource() datasource.DataSource {
+	return &SupportedFeaturesDataSource{}
+}
+
+// SupportedFeaturesDataSource defines the data source implementation.
+type SupportedFeaturesDataSource struct {
+	client *client.Client
+}
+
+// SupportedFeaturesDataSourceModel describes the data source data model.
+type SupportedFeaturesDataSourceModel struct {
+	ID               types.String                  `tfsdk:"id"`
+	OpenShiftVersion types.String                  `tfsdk:"openshift_version"`
+	CPUArchitecture  types.String                  `tfsdk:"cpu_architecture"`
+	PlatformType     types.String                  `tfsdk:"platform_type"`
+	Detailed         types.Bool                    `tfsdk:"detailed"`
+	Features         map[string]string             `tfsdk:"features"`
+	FeatureDetails   map[string]FeatureDetailModel `tfsdk:"feature_details"`
+}
+
+// FeatureDetailModel describes the detailed support information of a feature.
+type FeatureDetailModel struct {
+	SupportLevel      types.String `tfsdk:"support_level"`
+	Incompatibilities []string     `tfsdk:"incompatibilities"`
+	Dependencies      []string     `tfsdk:"dependencies"`
+}
+
+func (d *SupportedFeaturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supported_features"
+}
+
+func (d *SupportedFeaturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Supported features data source reports the support level of each feature for an OpenShift version, CPU architecture and platform, optionally with the features each one is incompatible with or depends on.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Data source identifier.",
+			},
+			"openshift_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the OpenShift cluster (required).",
+				Required:            true,
+			},
+			"cpu_architecture": schema.StringAttribute{
+				MarkdownDescription: "CPU architecture filter (optional). Examples: x86_64, arm64, ppc64le, s390x.",
+				Optional:            true,
+			},
+			"platform_type": schema.StringAttribute{
+				MarkdownDescription: "Platform type filter (optional). Examples: baremetal, nutanix, vsphere.",
+				Optional:            true,
+			},
+			"detailed": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fetch detailed support information into `feature_details`. Defaults to false.",
+				Optional:            true,
+			},
+			"features": schema.MapAttribute{
+				MarkdownDescription: "Map of feature IDs to their support levels (supported, tech-preview, dev-preview, unsupported, unavailable).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"feature_details": schema.MapNestedAttribute{
+				MarkdownDescription: "Detailed support information keyed by feature ID. Only populated when `detailed` is true.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"support_level": schema.StringAttribute{
+							MarkdownDescription: "Support level of the feature.",
+							Computed:            true,
+						},
+						"incompatibilities": schema.ListAttribute{
+							MarkdownDescription: "Feature IDs that cannot be used together with this feature.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"dependencies": schema.ListAttribute{
+							MarkdownDescription: "Feature IDs this feature requires.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SupportedFeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SupportedFeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupportedFeaturesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openshiftVersion := data.OpenShiftVersion.ValueString()
+	cpuArchitecture := data.CPUArchitecture.ValueString()
+	platformType := data.PlatformType.ValueString()
+	detailed := data.Detailed.ValueBool()
+
+	tflog.Info(ctx, "Fetching supported features", map[string]any{
+		"data_source":       "oai_supported_features",
+		"openshift_version": openshiftVersion,
+		"cpu_architecture":  cpuArchitecture,
+		"platform_type":     platformType,
+		"detailed":          detailed,
+	})
+
+	if detailed {
+		details, err := d.client.GetDetailedSupportedFeatures(ctx, openshiftVersion, cpuArchitecture, platformType)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching detailed supported features", fmt.Sprintf("Could not read detailed supported features: %s", err))
+			return
+		}
+
+		// The detailed response carries the support levels as well
+		data.Features = make(map[string]string, len(*details))
+		data.FeatureDetails = make(map[string]FeatureDetailModel, len(*details))
+		for featureID, feature := range *details {
+			data.Features[featureID] = feature.SupportLevel
+			data.FeatureDetails[featureID] = FeatureDetailModel{
+				SupportLevel:      types.StringValue(feature.SupportLevel),
+				Incompatibilities: append([]string{}, feature.Incompatibilities...),
+				Dependencies:      append([]string{}, feature.Dependencies...),
+			}
+		}
+	} else {
+		features, err := d.client.GetSupportedFeatures(ctx, openshiftVersion, cpuArchitecture, platformType)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching supported features", fmt.Sprintf("Could not read supported features: %s", err))
+			return
+		}
+
+		data.Features = *features
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("supported_features_%s_%s_%s", openshiftVersion, cpuArchitecture, platformType))
+
+	tflog.Info(ctx, "Successfully fetched supported features", map[string]any{
+		"feature_count": len(data.Features),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
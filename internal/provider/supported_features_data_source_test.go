@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedFeaturesDataSource_Metadata(t *testing.T) {
+	ds := NewSupportedFeaturesDataSource()
+
+	metadataReq := datasource.MetadataRequest{
+		ProviderTypeName: "openshift_assisted_installer",
+	}
+	metadataResp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), metadataReq, metadataResp)
+
+	assert.Equal(t, "openshift_assisted_installer_supported_features", metadataResp.TypeName)
+}
+
+func TestSupportedFeaturesDataSource_ReadSimple(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/assisted-install/v2/support-levels/features", r.URL.Path)
+		assert.Equal(t, "4.16", r.URL.Query().Get("openshift_version"))
+		assert.Equal(t, "x86_64", r.URL.Query().Get("cpu_architecture"))
+		assert.Equal(t, "baremetal", r.URL.Query().Get("platform_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"features": {
+				"SNO": "supported",
+				"LVM": "tech-preview",
+				"NUTANIX_INTEGRATION": "unavailable"
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	ds := &SupportedFeaturesDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL + "/api/assisted-install",
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+		"cpu_architecture":  tftypes.NewValue(tftypes.String, "x86_64"),
+		"platform_type":     tftypes.NewValue(tftypes.String, "baremetal"),
+	})
+	ds.Read(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state SupportedFeaturesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	assert.Equal(t, "supported_features_4.16_x86_64_baremetal", state.ID.ValueString())
+	assert.Equal(t, map[string]string{
+		"SNO":                 "supported",
+		"LVM":                 "tech-preview",
+		"NUTANIX_INTEGRATION": "unavailable",
+	}, state.Features)
+	assert.Nil(t, state.FeatureDetails)
+}
+
+func TestSupportedFeaturesDataSource_ReadDetailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/assisted-install/v2/support-levels/features/detailed", r.URL.Path)
+		assert.Equal(t, "4.16", r.URL.Query().Get("openshift_version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"features": [
+				{
+					"feature-support-level-id": "SNO",
+					"support_level": "supported",
+					"incompatibilities": ["USER_MANAGED_NETWORKING"],
+					"dependencies": []
+				},
+				{
+					"feature-support-level-id": "LVM",
+					"support_level": "tech-preview",
+					"dependencies": ["SNO"]
+				}
+			],
+			"operators": []
+		}`))
+	}))
+	defer server.Close()
+
+	ds := &SupportedFeaturesDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL + "/api/assisted-install",
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"openshift_version": tftypes.NewValue(tftypes.String, "4.16"),
+		"detailed":          tftypes.NewValue(tftypes.Bool, true),
+	})
+	ds.Read(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read() returned diagnostics: %+v", resp.Diagnostics)
+
+	var state SupportedFeaturesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	require.False(t, resp.Diagnostics.HasError(), "Failed to read state: %+v", resp.Diagnostics)
+
+	assert.Equal(t, map[string]string{
+		"SNO": "supported",
+		"LVM": "tech-preview",
+	}, state.Features)
+
+	require.Len(t, state.FeatureDetails, 2)
+	sno := state.FeatureDetails["SNO"]
+	assert.Equal(t, "supported", sno.SupportLevel.ValueString())
+	assert.Equal(t, []string{"USER_MANAGED_NETWORKING"}, sno.Incompatibilities)
+	assert.Empty(t, sno.Dependencies)
+
+	lvm := state.FeatureDetails["LVM"]
+	assert.Equal(t, "tech-preview", lvm.SupportLevel.ValueString())
+	assert.Empty(t, lvm.Incompatibilities)
+	assert.Equal(t, []string{"SNO"}, lvm.Dependencies)
+}
+
+func TestSupportedFeaturesDataSource_ReadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"reason": "invalid openshift_version"}`))
+	}))
+	defer server.Close()
+
+	ds := &SupportedFeaturesDataSource{
+		client: client.NewClient(client.ClientConfig{
+			BaseURL:      server.URL + "/api/assisted-install",
+			OfflineToken: "test-token",
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+		"openshift_version": tftypes.NewValue(tftypes.String, "3.11"),
+		"detailed":          tftypes.NewValue(tftypes.Bool, true),
+	})
+	ds.Read(context.Background(), req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Error fetching detailed supported features", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,6 +25,7 @@ type SupportedOperatorsDataSource struct {
 
 type SupportedOperatorsDataSourceModel struct {
 	ID        types.String `tfsdk:"id"`
+	Filter    types.String `tfsdk:"filter"`
 	Operators types.List   `tfsdk:"operators"`
 }
 
@@ -40,6 +42,10 @@ func (d *SupportedOperatorsDataSource) Schema(ctx context.Context, req datasourc
 				MarkdownDescription: "Data source identifier",
 				Computed:            true,
 			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Only return operators whose name contains this substring (case-insensitive)",
+				Optional:            true,
+			},
 			"operators": schema.ListAttribute{
 				MarkdownDescription: "List of supported operator names",
 				Computed:            true,
@@ -86,6 +92,10 @@ func (d *SupportedOperatorsDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	if filter := data.Filter.ValueString(); filter != "" {
+		operators = filterOperatorNames(operators, filter)
+	}
+
 	// Convert to terraform list
 	if len(operators) > 0 {
 		operatorElements := make([]types.String, len(operators))
@@ -113,3 +123,17 @@ func (d *SupportedOperatorsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// filterOperatorNames returns the operators whose name contains filter, ignoring case
+func filterOperatorNames(operators []string, filter string) []string {
+	filter = strings.ToLower(filter)
+
+	filtered := make([]string, 0, len(operators))
+	for _, operator := range operators {
+		if strings.Contains(strings.ToLower(operator), filter) {
+			filtered = append(filtered, operator)
+		}
+	}
+
+	return filtered
+}
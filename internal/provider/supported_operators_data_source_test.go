@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/client"
 )
@@ -125,6 +126,80 @@ func TestSupportedOperatorsDataSource_Read(t *testing.T) {
 	}
 }
 
+func TestSupportedOperatorsDataSource_ReadState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v2/supported-operators" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`["odf", "cnv", "lso", "mce"]`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		filter   tftypes.Value
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			filter:   tftypes.NewValue(tftypes.String, nil),
+			expected: []string{"odf", "cnv", "lso", "mce"},
+		},
+		{
+			name:     "substring filter",
+			filter:   tftypes.NewValue(tftypes.String, "C"),
+			expected: []string{"cnv", "mce"},
+		},
+		{
+			name:     "filter with no matches",
+			filter:   tftypes.NewValue(tftypes.String, "lvm"),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &SupportedOperatorsDataSource{
+				client: client.NewClient(client.ClientConfig{
+					BaseURL:      server.URL,
+					OfflineToken: "test-token",
+				}),
+			}
+
+			req, resp := newDataSourceReadRequest(t, ds, map[string]tftypes.Value{
+				"filter": tt.filter,
+			})
+			ds.Read(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() returned diagnostics: %+v", resp.Diagnostics)
+			}
+
+			var state SupportedOperatorsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Failed to read state: %+v", resp.Diagnostics)
+			}
+
+			var operators []string
+			if !state.Operators.IsNull() {
+				resp.Diagnostics.Append(state.Operators.ElementsAs(context.Background(), &operators, false)...)
+			}
+			if len(operators) != len(tt.expected) {
+				t.Fatalf("Expected operators %v, got %v", tt.expected, operators)
+			}
+			for i := range tt.expected {
+				if operators[i] != tt.expected[i] {
+					t.Errorf("Expected operators %v, got %v", tt.expected, operators)
+					break
+				}
+			}
+		})
+	}
+}
+
 func TestSupportedOperatorsDataSource_Schema(t *testing.T) {
 	dataSource := NewSupportedOperatorsDataSource()
 
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/benemon/terraform-provider-openshift-assisted-installer/internal/models"
+)
+
+// validationFilters holds the filter arguments shared by the cluster and host
+// validations data sources. An empty filter matches everything.
+type validationFilters struct {
+	types      []string
+	statuses   []string
+	names      []string
+	categories []string
+}
+
+func newValidationFilters(validationTypes, statuses, names, categories []types.String) validationFilters {
+	return validationFilters{
+		types:      knownStrings(validationTypes),
+		statuses:   knownStrings(statuses),
+		names:      knownStrings(names),
+		categories: knownStrings(categories),
+	}
+}
+
+// validationResult is a validation classified by the blocking and category helpers
+type validationResult struct {
+	ValidationID   string
+	ValidationType string
+	Category       string
+}
+
+// classifyValidation resolves the validation ID, which older API versions
+// only report as id, and derives its type and category from it
+func classifyValidation(validation models.ValidationInfo) validationResult {
+	validationID := validation.ValidationID
+	if validationID == "" {
+		validationID = validation.ID
+	}
+
+	validationType := "non-blocking"
+	if models.IsBlockingValidation(validationID) {
+		validationType = "blocking"
+	}
+
+	return validationResult{
+		ValidationID:   validationID,
+		ValidationType: validationType,
+		Category:       string(models.GetValidationCategory(validationID)),
+	}
+}
+
+// matches reports whether the validation passes all filters. Types, statuses
+// and categories are compared case-insensitively, names must match exactly.
+func (f validationFilters) matches(validation models.ValidationInfo, result validationResult) bool {
+	if len(f.types) > 0 && !containsFold(f.types, result.ValidationType) {
+		return false
+	}
+	if len(f.statuses) > 0 && !containsFold(f.statuses, validation.Status) {
+		return false
+	}
+	if len(f.names) > 0 && !slices.Contains(f.names, result.ValidationID) && !slices.Contains(f.names, validation.ID) {
+		return false
+	}
+	if len(f.categories) > 0 && !containsFold(f.categories, result.Category) {
+		return false
+	}
+	return true
+}
+
+// sortedValidationGroups returns the group names of the validations in a
+// stable order, so the data sources do not report spurious changes
+func sortedValidationGroups(groups models.ValidationGroups) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// knownStrings returns the known, non-null values of a list attribute
+func knownStrings(values []types.String) []string {
+	var result []string
+	for _, value := range values {
+		if !value.IsNull() && !value.IsUnknown() {
+			result = append(result, value.ValueString())
+		}
+	}
+	return result
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+}
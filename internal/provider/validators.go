@@ -0,0 +1,562 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"gopkg.in/yaml.v3"
+)
+
+var _ validator.String = proxyURLValidator{}
+var _ validator.String = noProxyValidator{}
+var _ validator.String = clusterTagsValidator{}
+var _ validator.String = jsonValidator{}
+var _ validator.String = releaseImageValidator{}
+var _ validator.String = durationValidator{}
+var _ validator.String = nmstateValidator{}
+var _ validator.String = macAddressValidator{}
+var _ validator.String = cidrValidator{}
+
+const (
+	// maxClusterTags and maxClusterTagLength match the limits the Assisted
+	// Service enforces on the comma-separated cluster tags
+	maxClusterTags      = 10
+	maxClusterTagLength = 255
+)
+
+// proxyURLValidator checks that a proxy setting is a well-formed absolute URL
+// using one of the supported schemes
+type proxyURLValidator struct {
+	schemes []string
+}
+
+func (v proxyURLValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a well-formed proxy URL with scheme %s and a host", strings.Join(v.schemes, " or "))
+}
+
+func (v proxyURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v proxyURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Proxy URL",
+			fmt.Sprintf("Attribute %s must be a well-formed URL such as http://proxy.example.com:3128, got: %q", req.Path, value),
+		)
+		return
+	}
+
+	if !slices.Contains(v.schemes, strings.ToLower(u.Scheme)) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported Proxy Scheme",
+			fmt.Sprintf("Attribute %s must use the %s scheme, got: %q", req.Path, strings.Join(v.schemes, ":// or ")+"://", value),
+		)
+	}
+}
+
+// validProxyURL returns a validator which ensures a proxy URL is well-formed
+// and uses one of the given schemes. The cluster proxy is reached over plain
+// HTTP, so http_proxy only supports http while https_proxy also allows https.
+func validProxyURL(schemes ...string) validator.String {
+	return proxyURLValidator{schemes: schemes}
+}
+
+// noProxyValidator checks that no_proxy is a comma-separated list of domains,
+// IP addresses and CIDRs, or "*" to bypass the proxy for all destinations
+type noProxyValidator struct{}
+
+func (v noProxyValidator) Description(ctx context.Context) string {
+	return "value must be \"*\" or a comma-separated list of domain names, IP addresses and CIDRs"
+}
+
+func (v noProxyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v noProxyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "*" {
+		return
+	}
+
+	for i, entry := range strings.Split(value, ",") {
+		if !validNoProxyEntry(entry) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid No Proxy Entry",
+				fmt.Sprintf("Attribute %s entry %d (%q) must be a domain name such as .example.com, an IP address or a CIDR.", req.Path, i+1, entry),
+			)
+		}
+	}
+}
+
+// validNoProxyEntry reports whether a single no_proxy entry is an IP address,
+// a CIDR, or a domain name optionally prefixed with "." to match subdomains
+func validNoProxyEntry(entry string) bool {
+	if net.ParseIP(entry) != nil {
+		return true
+	}
+	if _, _, err := net.ParseCIDR(entry); err == nil {
+		return true
+	}
+
+	domain := strings.TrimPrefix(entry, ".")
+	if domain == "" || len(domain) > maxDomainLength {
+		return false
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if !dnsLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// validNoProxy returns a validator which ensures no_proxy entries are well-formed
+func validNoProxy() validator.String {
+	return noProxyValidator{}
+}
+
+// clusterTagsValidator checks the comma-separated cluster tags against API limits
+type clusterTagsValidator struct{}
+
+func (v clusterTagsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a comma-separated list of at most %d non-empty tags of at most %d characters each", maxClusterTags, maxClusterTagLength)
+}
+
+func (v clusterTagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v clusterTagsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	tags := strings.Split(req.ConfigValue.ValueString(), ",")
+	if len(tags) > maxClusterTags {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Too Many Cluster Tags",
+			fmt.Sprintf("Attribute %s has %d tags, the Assisted Service allows at most %d.", req.Path, len(tags), maxClusterTags),
+		)
+	}
+
+	for i, tag := range tags {
+		switch {
+		case strings.TrimSpace(tag) == "":
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Cluster Tag",
+				fmt.Sprintf("Attribute %s contains an empty tag at position %d.", req.Path, i+1),
+			)
+		case len(tag) > maxClusterTagLength:
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Cluster Tag Too Long",
+				fmt.Sprintf("Attribute %s tag %q is %d characters long, the Assisted Service allows at most %d.", req.Path, tag[:32]+"...", len(tag), maxClusterTagLength),
+			)
+		}
+	}
+}
+
+// validClusterTags returns a validator which enforces the API limits on cluster tags
+func validClusterTags() validator.String {
+	return clusterTagsValidator{}
+}
+
+// jsonValidator checks that a string attribute holds a valid JSON document
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(ctx context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var document any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &document); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Attribute %s must be a valid JSON document: %s", req.Path, err),
+		)
+	}
+}
+
+// validJSON returns a validator which ensures a string is a valid JSON document
+func validJSON() validator.String {
+	return jsonValidator{}
+}
+
+// releaseImagePattern matches a container image reference with a registry
+// host, a repository path and a tag, a sha256 digest or both
+var releaseImagePattern = regexp.MustCompile(`^` +
+	// Registry host, e.g. quay.io or registry.example.com:5000
+	`(?P<registry>[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?)` +
+	// Repository path, e.g. /openshift-release-dev/ocp-release
+	`(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)+` +
+	`(?P<tag>:[A-Za-z0-9_][A-Za-z0-9_.-]{0,127})?` +
+	`(?P<digest>@sha256:[a-f0-9]{64})?$`)
+
+// releaseImageValidator checks that a string attribute holds a release image
+// reference pinned by tag or digest
+type releaseImageValidator struct{}
+
+func (v releaseImageValidator) Description(ctx context.Context) string {
+	return "value must be an image reference of the form registry/repository:tag or registry/repository@sha256:digest"
+}
+
+func (v releaseImageValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an image reference of the form `registry/repository:tag` or `registry/repository@sha256:digest`"
+}
+
+func (v releaseImageValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	image := req.ConfigValue.ValueString()
+	match := releaseImagePattern.FindStringSubmatch(image)
+
+	// Like container tools, only treat the first component as a registry when
+	// it has a dot or a port, or is localhost
+	if match != nil {
+		registry := match[releaseImagePattern.SubexpIndex("registry")]
+		if !strings.ContainsAny(registry, ".:") && registry != "localhost" {
+			match = nil
+		}
+	}
+
+	if match == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Release Image",
+			fmt.Sprintf("Attribute %s %q is not a valid image reference. Use the form registry/repository:tag or registry/repository@sha256:digest, "+
+				"e.g. quay.io/openshift-release-dev/ocp-release:4.15.20-x86_64.", req.Path, image),
+		)
+		return
+	}
+
+	// Without a tag or digest the image would resolve to "latest"
+	if match[releaseImagePattern.SubexpIndex("tag")] == "" && match[releaseImagePattern.SubexpIndex("digest")] == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Release Image",
+			fmt.Sprintf("Attribute %s %q must be pinned by a tag or a sha256 digest.", req.Path, image),
+		)
+	}
+}
+
+// validReleaseImage returns a validator which ensures a string is a release
+// image reference pinned by tag or digest
+func validReleaseImage() validator.String {
+	return releaseImageValidator{}
+}
+
+// durationValidator checks that a string attribute holds a Go duration, such
+// as "30s" or "2m", of at least min
+type durationValidator struct {
+	min time.Duration
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a duration such as 30s or 2m, of at least %s", v.min)
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be a duration such as `30s` or `2m`, of at least `%s`", v.min)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s must be a duration such as 30s or 2m: %s", req.Path, err),
+		)
+		return
+	}
+
+	if duration < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s must be at least %s, got %s.", req.Path, v.min, duration),
+		)
+	}
+}
+
+// validDuration returns a validator which ensures a string is a duration of at least min
+func validDuration(min time.Duration) validator.String {
+	return durationValidator{min: min}
+}
+
+// nmstateSections are the top-level keys of an nmstate network state, at
+// least one of which a static network configuration must set
+var nmstateSections = []string{"interfaces", "routes", "dns-resolver"}
+
+// nmstateValidator checks that a string attribute holds an nmstate network
+// state in YAML. The service only parses it while generating the discovery
+// ISO, where mistakes surface as an opaque image generation failure.
+type nmstateValidator struct{}
+
+func (v nmstateValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be an nmstate network state in YAML, setting at least one of %s", strings.Join(nmstateSections, ", "))
+}
+
+func (v nmstateValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be an nmstate network state in YAML, setting at least one of `%s`", strings.Join(nmstateSections, "`, `"))
+}
+
+func (v nmstateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var state map[string]any
+	if err := yaml.Unmarshal([]byte(req.ConfigValue.ValueString()), &state); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network YAML",
+			fmt.Sprintf("Attribute %s must be an nmstate network state in YAML: %s", req.Path, err),
+		)
+		return
+	}
+
+	if !slices.ContainsFunc(nmstateSections, func(section string) bool { return state[section] != nil }) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network YAML",
+			fmt.Sprintf("Attribute %s must set at least one of the nmstate sections %s.", req.Path, strings.Join(nmstateSections, ", ")),
+		)
+		return
+	}
+
+	for _, problem := range nmstateProblems(state) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network YAML",
+			fmt.Sprintf("Attribute %s is not a valid nmstate network state: %s.", req.Path, problem),
+		)
+	}
+}
+
+// nmstateProblems describes where the sections of an nmstate network state do
+// not have the shape nmstate expects
+func nmstateProblems(state map[string]any) []string {
+	var problems []string
+
+	if interfaces, ok := state["interfaces"]; ok && interfaces != nil {
+		entries, ok := interfaces.([]any)
+		if !ok {
+			problems = append(problems, "interfaces must be a list")
+		}
+		for i, entry := range entries {
+			iface, ok := entry.(map[string]any)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("interfaces[%d] must be a mapping", i))
+				continue
+			}
+			if name, _ := iface["name"].(string); name == "" {
+				problems = append(problems, fmt.Sprintf("interfaces[%d] must have a name", i))
+			}
+		}
+	}
+
+	if routes, ok := state["routes"]; ok && routes != nil {
+		sections, ok := routes.(map[string]any)
+		if !ok {
+			problems = append(problems, "routes must be a mapping with a config list")
+		} else if config, ok := sections["config"]; ok && config != nil {
+			if _, ok := config.([]any); !ok {
+				problems = append(problems, "routes.config must be a list")
+			}
+		}
+	}
+
+	if resolver, ok := state["dns-resolver"]; ok && resolver != nil {
+		if _, ok := resolver.(map[string]any); !ok {
+			problems = append(problems, "dns-resolver must be a mapping with a config section")
+		}
+	}
+
+	return problems
+}
+
+// validNMState returns a validator which ensures a string is an nmstate
+// network state in YAML
+func validNMState() validator.String {
+	return nmstateValidator{}
+}
+
+// macAddressPattern matches a MAC address in the colon or hyphen separated
+// form the service accepts
+var macAddressPattern = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`)
+
+// macAddressValidator checks that a string attribute holds a MAC address
+type macAddressValidator struct{}
+
+func (v macAddressValidator) Description(ctx context.Context) string {
+	return "value must be a MAC address such as 52:54:00:12:34:56"
+}
+
+func (v macAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a MAC address such as `52:54:00:12:34:56`"
+}
+
+func (v macAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !macAddressPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MAC Address",
+			fmt.Sprintf("Attribute %s must be a MAC address such as 52:54:00:12:34:56, got: %q", req.Path, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// validMACAddress returns a validator which ensures a string is a MAC address
+func validMACAddress() validator.String {
+	return macAddressValidator{}
+}
+
+// cidrValidator checks that a string attribute holds an IPv4 or IPv6 network
+// in CIDR notation, given by its network address
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be a network in CIDR notation such as 10.128.0.0/14"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a network in CIDR notation such as `10.128.0.0/14`"
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s must be a network in CIDR notation such as 10.128.0.0/14, got: %q", req.Path, value),
+		)
+		return
+	}
+
+	// The installer rejects networks given by an address inside them
+	if masked := prefix.Masked(); masked != prefix {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s %q has host bits set, use its network address %s.", req.Path, value, masked),
+		)
+	}
+}
+
+// validCIDR returns a validator which ensures a string is a network in CIDR notation
+func validCIDR() validator.String {
+	return cidrValidator{}
+}
+
+// namespacePattern matches a Kubernetes namespace name, a lowercase DNS-1123 label
+var namespacePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// olmOperatorPropertiesValidator checks that non-empty OLM operator properties
+// are a JSON object, and that the version and namespace settings common to
+// several operators are well-formed. The service only parses the properties
+// once the installation starts.
+type olmOperatorPropertiesValidator struct{}
+
+func (v olmOperatorPropertiesValidator) Description(ctx context.Context) string {
+	return "value must be empty or a JSON object, with a non-empty string version and a valid namespace name when set"
+}
+
+func (v olmOperatorPropertiesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v olmOperatorPropertiesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Empty properties are omitted from requests, like null ones
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	var properties map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &properties); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Operator Properties",
+			fmt.Sprintf("Attribute %s must be a JSON object such as jsonencode({ version = \"4.16\" }): %s", req.Path, err),
+		)
+		return
+	}
+
+	if version, ok := properties["version"]; ok {
+		if s, isString := version.(string); !isString || strings.TrimSpace(s) == "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Operator Version",
+				fmt.Sprintf("Attribute %s sets \"version\" to %v, it must be a non-empty string such as \"4.16\".", req.Path, version),
+			)
+		}
+	}
+
+	if namespace, ok := properties["namespace"]; ok {
+		if s, isString := namespace.(string); !isString || !namespacePattern.MatchString(s) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Operator Namespace",
+				fmt.Sprintf("Attribute %s sets \"namespace\" to %v, it must be a namespace name of up to 63 lowercase letters, digits and hyphens.", req.Path, namespace),
+			)
+		}
+	}
+}
+
+// validOLMOperatorProperties returns a validator which ensures OLM operator
+// properties are a JSON object with well-formed common settings
+func validOLMOperatorProperties() validator.String {
+	return olmOperatorPropertiesValidator{}
+}